@@ -118,6 +118,15 @@ func (app *BaseApp) SetCommitMultiStoreTracer(w io.Writer) {
 	app.cms.SetTracer(w)
 }
 
+// SetCommitMultiStoreListeners sets the write listeners of the KVStore
+// belonging to the given key on the BaseApp's underlying CommitMultiStore.
+func (app *BaseApp) SetCommitMultiStoreListeners(key sdk.StoreKey, listeners ...sdk.WriteListener) {
+	if app.sealed {
+		panic("SetCommitMultiStoreListeners() on sealed BaseApp")
+	}
+	app.cms.AddListeners(key, listeners)
+}
+
 // SetStoreLoader allows us to customize the rootMultiStore initialization.
 func (app *BaseApp) SetStoreLoader(loader StoreLoader) {
 	if app.sealed {
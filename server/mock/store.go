@@ -67,6 +67,14 @@ func (ms multiStore) MountStoreWithDB(key sdk.StoreKey, typ sdk.StoreType, db db
 	ms.kv[key] = kvStore{store: make(map[string][]byte)}
 }
 
+func (ms multiStore) AddListeners(key sdk.StoreKey, listeners []sdk.WriteListener) {
+	panic("not implemented")
+}
+
+func (ms multiStore) ListeningEnabled(key sdk.StoreKey) bool {
+	panic("not implemented")
+}
+
 func (ms multiStore) LoadLatestVersion() error {
 	return nil
 }
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"encoding/json"
 	"io"
 	"os"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/token"
 )
 
 const appName = "SimApp"
@@ -49,11 +51,13 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler, distr.RecurringSpendProposalHandler,
+			distr.CancelSpendStreamProposalHandler, supply.ProposalHandler, supply.ModuleAccountPermissionsProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
+		token.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -64,6 +68,8 @@ var (
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
+		token.ModuleName:          {supply.Minter, supply.Burner},
+		bank.EscrowPoolName:       nil,
 	}
 )
 
@@ -100,6 +106,7 @@ type SimApp struct {
 	GovKeeper      gov.Keeper
 	CrisisKeeper   crisis.Keeper
 	ParamsKeeper   params.Keeper
+	TokenKeeper    token.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -108,12 +115,32 @@ type SimApp struct {
 	sm *module.SimulationManager
 }
 
+// CrisisOptions are the node-local options of the crisis module, such as the
+// values of the flags added by crisis.AddModuleInitFlags
+type CrisisOptions struct {
+	InvCheckMode       string // invariant check mode, the full mode if empty
+	InvCheckSampleSize int    // number of invariants checked per block in the sampled mode
+	HaltDumpDir        string // directory the halt report and the state are dumped to before exiting on a halt, if any
+}
+
 // NewSimApp returns a reference to an initialized SimApp.
 func NewSimApp(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 	invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp),
 ) *SimApp {
 
+	return NewSimAppWithCrisisOptions(logger, db, traceStore, loadLatest, invCheckPeriod,
+		CrisisOptions{}, baseAppOptions...)
+}
+
+// NewSimAppWithCrisisOptions returns a reference to an initialized SimApp
+// using the given crisis options. Without a halt dump directory the node
+// panics when the chain halts on broken invariants.
+func NewSimAppWithCrisisOptions(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
+	invCheckPeriod uint, crisisOpts CrisisOptions, baseAppOptions ...func(*bam.BaseApp),
+) *SimApp {
+
 	cdc := MakeCodec()
 
 	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
@@ -122,7 +149,7 @@ func NewSimApp(
 
 	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey)
+		gov.StoreKey, params.StoreKey, token.StoreKey, bank.StoreKey, crisis.StoreKey)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
 	app := &SimApp{
@@ -143,25 +170,56 @@ func NewSimApp(
 	slashingSubspace := app.ParamsKeeper.Subspace(slashing.DefaultParamspace)
 	govSubspace := app.ParamsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
 	crisisSubspace := app.ParamsKeeper.Subspace(crisis.DefaultParamspace)
+	tokenSubspace := app.ParamsKeeper.Subspace(token.DefaultParamspace)
 
 	// add keepers
 	app.AccountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
-	app.BankKeeper = bank.NewBaseKeeper(app.AccountKeeper, bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
+	app.BankKeeper = bank.NewBaseKeeperWithLockedTransfers(app.cdc, keys[bank.StoreKey], app.AccountKeeper, bankSubspace,
+		bank.DefaultCodespace, app.ModuleAccountAddrs())
 	app.SupplyKeeper = supply.NewKeeper(app.cdc, keys[supply.StoreKey], app.AccountKeeper, app.BankKeeper, maccPerms)
 	stakingKeeper := staking.NewKeeper(app.cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey],
 		app.SupplyKeeper, stakingSubspace, staking.DefaultCodespace)
-	app.MintKeeper = mint.NewKeeper(app.cdc, keys[mint.StoreKey], mintSubspace, &stakingKeeper, app.SupplyKeeper, auth.FeeCollectorName)
+	app.MintKeeper = mint.NewKeeper(app.cdc, keys[mint.StoreKey], mintSubspace, &stakingKeeper,
+		app.SupplyKeeper, auth.FeeCollectorName, nil, app.ModuleAccountAddrs())
 	app.DistrKeeper = distr.NewKeeper(app.cdc, keys[distr.StoreKey], distrSubspace, &stakingKeeper,
 		app.SupplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName, app.ModuleAccountAddrs())
 	app.SlashingKeeper = slashing.NewKeeper(app.cdc, keys[slashing.StoreKey], &stakingKeeper,
 		slashingSubspace, slashing.DefaultCodespace)
-	app.CrisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.SupplyKeeper, auth.FeeCollectorName)
+	app.CrisisKeeper = crisis.NewKeeper(app.cdc, keys[crisis.StoreKey], crisisSubspace, invCheckPeriod,
+		app.SupplyKeeper, auth.FeeCollectorName)
+	if crisisOpts.InvCheckMode != "" {
+		app.CrisisKeeper.SetCheckMode(crisisOpts.InvCheckMode, crisisOpts.InvCheckSampleSize)
+	}
+	if crisisOpts.HaltDumpDir != "" {
+		app.CrisisKeeper.SetHaltHandler(crisis.NewStateDumpHaltHandler(crisisOpts.HaltDumpDir,
+			func() (json.RawMessage, error) {
+				appState, _, err := app.ExportAppStateAndValidators(false, nil)
+				return appState, err
+			},
+		))
+	}
+
+	// record the keys written to the stores for the incremental invariant checks
+	for _, key := range keys {
+		bApp.SetCommitMultiStoreListeners(key, app.CrisisKeeper.WriteListener())
+	}
+	app.TokenKeeper = token.NewKeeper(app.cdc, keys[token.StoreKey], tokenSubspace, app.SupplyKeeper,
+		token.DefaultCodespace, auth.FeeCollectorName, app.ModuleAccountAddrs())
+
+	// map the modules registering invariants to the message routes disabled
+	// under the disable_routes invariant break policy
+	app.CrisisKeeper.SetModuleRouterKeys(bank.ModuleName, bank.RouterKey)
+	app.CrisisKeeper.SetModuleRouterKeys(staking.ModuleName, staking.RouterKey)
+	app.CrisisKeeper.SetModuleRouterKeys(distr.ModuleName, distr.RouterKey)
+	app.CrisisKeeper.SetModuleRouterKeys(token.ModuleName, token.RouterKey)
+	app.CrisisKeeper.SetModuleRouterKeys(supply.ModuleName, bank.RouterKey, token.RouterKey)
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper))
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
+		AddRoute(supply.RouterKey, supply.NewProposalHandler(app.SupplyKeeper))
 	app.GovKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], govSubspace,
 		app.SupplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
 
@@ -180,26 +238,33 @@ func NewSimApp(
 		bank.NewAppModule(app.BankKeeper, app.AccountKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
-		distr.NewAppModule(app.DistrKeeper, app.SupplyKeeper),
+		distr.NewAppModule(app.DistrKeeper, app.SupplyKeeper, nil),
 		gov.NewAppModule(app.GovKeeper, app.SupplyKeeper),
-		mint.NewAppModule(app.MintKeeper),
+		mint.NewAppModule(app.MintKeeper, nil),
 		slashing.NewAppModule(app.SlashingKeeper, app.StakingKeeper),
 		staking.NewAppModule(app.StakingKeeper, app.DistrKeeper, app.AccountKeeper, app.SupplyKeeper),
+		token.NewAppModule(app.TokenKeeper),
+		params.NewAppModule(app.ParamsKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
-	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	// CanWithdrawInvariant invariant. The crisis module goes first so that the
+	// chain halts before any state transition on broken invariants, followed by
+	// the params module so that scheduled parameter changes apply to the whole
+	// block.
+	app.mm.SetOrderBeginBlockers(crisis.ModuleName, params.ModuleName, mint.ModuleName, distr.ModuleName,
+		slashing.ModuleName)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, bank.ModuleName, staking.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
 		genaccounts.ModuleName, distr.ModuleName, staking.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
+		mint.ModuleName, supply.ModuleName, token.ModuleName, params.ModuleName, crisis.ModuleName,
+		genutil.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -215,7 +280,8 @@ func NewSimApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(crisis.NewDisabledRoutesAnteHandler(app.CrisisKeeper,
+		auth.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer)))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
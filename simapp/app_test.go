@@ -9,6 +9,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -50,3 +51,18 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestCrisisOptions(t *testing.T) {
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+	require.Equal(t, crisis.CheckModeFull, app.CrisisKeeper.CheckMode())
+	require.Nil(t, app.CrisisKeeper.HaltHandler())
+
+	opts := CrisisOptions{
+		InvCheckMode:       crisis.CheckModeSampled,
+		InvCheckSampleSize: 2,
+		HaltDumpDir:        t.Name(),
+	}
+	app = NewSimAppWithCrisisOptions(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, opts)
+	require.Equal(t, crisis.CheckModeSampled, app.CrisisKeeper.CheckMode())
+	require.NotNil(t, app.CrisisKeeper.HaltHandler())
+}
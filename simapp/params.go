@@ -7,11 +7,18 @@ const (
 	OpWeightDeductFee                                  = "op_weight_deduct_fee"
 	OpWeightMsgSend                                    = "op_weight_msg_send"
 	OpWeightSingleInputMsgMultiSend                    = "op_weight_single_input_msg_multisend"
+	OpWeightMsgSendLocked                              = "op_weight_msg_send_locked"
+	OpWeightMsgCancelLockedTransfer                    = "op_weight_msg_cancel_locked_transfer"
 	OpWeightMsgSetWithdrawAddress                      = "op_weight_msg_set_withdraw_address"
 	OpWeightMsgWithdrawDelegationReward                = "op_weight_msg_withdraw_delegation_reward"
+	OpWeightMsgWithdrawAllDelegatorRewards             = "op_weight_msg_withdraw_all_delegator_rewards"
 	OpWeightMsgWithdrawValidatorCommission             = "op_weight_msg_withdraw_validator_commission"
+	OpWeightMsgSetAutoCompound                         = "op_weight_msg_set_auto_compound"
+	OpWeightMsgSetRewardDestinations                   = "op_weight_msg_set_reward_destinations"
+	OpWeightMsgClaimDelegatorReward                    = "op_weight_msg_claim_delegator_reward"
 	OpWeightSubmitVotingSlashingTextProposal           = "op_weight_submit_voting_slashing_text_proposal"
 	OpWeightSubmitVotingSlashingCommunitySpendProposal = "op_weight_submit_voting_slashing_community_spend_proposal"
+	OpWeightSubmitVotingSlashingRecurringSpendProposal = "op_weight_submit_voting_slashing_recurring_spend_proposal"
 	OpWeightSubmitVotingSlashingParamChangeProposal    = "op_weight_submit_voting_slashing_param_change_proposal"
 	OpWeightMsgDeposit                                 = "op_weight_msg_deposit"
 	OpWeightMsgCreateValidator                         = "op_weight_msg_create_validator"
@@ -20,4 +27,8 @@ const (
 	OpWeightMsgUndelegate                              = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate                         = "op_weight_msg_begin_redelegate"
 	OpWeightMsgUnjail                                  = "op_weight_msg_unjail"
+	OpWeightMsgIssueToken                              = "op_weight_msg_issue_token"
+	OpWeightMsgMintTokens                              = "op_weight_msg_mint_tokens"
+	OpWeightMsgBurnTokens                              = "op_weight_msg_burn_tokens"
+	OpWeightMsgTransferTokenAdmin                      = "op_weight_msg_transfer_token_admin"
 )
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,7 +19,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authsimops "github.com/cosmos/cosmos-sdk/x/auth/simulation/operations"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	banksimops "github.com/cosmos/cosmos-sdk/x/bank/simulation/operations"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrsimops "github.com/cosmos/cosmos-sdk/x/distribution/simulation/operations"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -32,6 +35,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingsimops "github.com/cosmos/cosmos-sdk/x/staking/simulation/operations"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/token"
+	tokensimops "github.com/cosmos/cosmos-sdk/x/token/simulation/operations"
 )
 
 func init() {
@@ -87,6 +92,28 @@ func testAndRunTxs(app *SimApp, config simulation.Config) []simulation.WeightedO
 			}(nil),
 			banksimops.SimulateSingleInputMsgMultiSend(app.AccountKeeper, app.BankKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgSendLocked, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			banksimops.SimulateMsgSendLocked(app.AccountKeeper, app.BankKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgCancelLockedTransfer, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			banksimops.SimulateMsgCancelLockedTransfer(app.BankKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -109,6 +136,17 @@ func testAndRunTxs(app *SimApp, config simulation.Config) []simulation.WeightedO
 			}(nil),
 			distrsimops.SimulateMsgWithdrawDelegatorReward(app.DistrKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgWithdrawAllDelegatorRewards, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			distrsimops.SimulateMsgWithdrawAllDelegatorRewards(app.DistrKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -120,6 +158,39 @@ func testAndRunTxs(app *SimApp, config simulation.Config) []simulation.WeightedO
 			}(nil),
 			distrsimops.SimulateMsgWithdrawValidatorCommission(app.DistrKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgSetAutoCompound, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			distrsimops.SimulateMsgSetAutoCompound(app.DistrKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgSetRewardDestinations, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			distrsimops.SimulateMsgSetRewardDestinations(app.DistrKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgClaimDelegatorReward, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			distrsimops.SimulateMsgClaimDelegatorReward(app.DistrKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -142,6 +213,17 @@ func testAndRunTxs(app *SimApp, config simulation.Config) []simulation.WeightedO
 			}(nil),
 			govsimops.SimulateSubmittingVotingAndSlashingForProposal(app.GovKeeper, distrsimops.SimulateCommunityPoolSpendProposalContent(app.DistrKeeper)),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightSubmitVotingSlashingRecurringSpendProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsimops.SimulateSubmittingVotingAndSlashingForProposal(app.GovKeeper, distrsimops.SimulateCommunityPoolRecurringSpendProposalContent(app.DistrKeeper)),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -230,6 +312,50 @@ func testAndRunTxs(app *SimApp, config simulation.Config) []simulation.WeightedO
 			}(nil),
 			slashingsimops.SimulateMsgUnjail(app.SlashingKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgIssueToken, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			tokensimops.SimulateMsgIssueToken(app.TokenKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgMintTokens, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			tokensimops.SimulateMsgMintTokens(app.TokenKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgBurnTokens, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			tokensimops.SimulateMsgBurnTokens(app.AccountKeeper, app.TokenKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgTransferTokenAdmin, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			tokensimops.SimulateMsgTransferTokenAdmin(app.TokenKeeper),
+		},
 	}
 }
 
@@ -484,6 +610,9 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[supply.StoreKey], newApp.keys[supply.StoreKey], [][]byte{}},
 		{app.keys[params.StoreKey], newApp.keys[params.StoreKey], [][]byte{}},
 		{app.keys[gov.StoreKey], newApp.keys[gov.StoreKey], [][]byte{}},
+		{app.keys[token.StoreKey], newApp.keys[token.StoreKey], [][]byte{}},
+		{app.keys[bank.StoreKey], newApp.keys[bank.StoreKey], [][]byte{}},
+		{app.keys[crisis.StoreKey], newApp.keys[crisis.StoreKey], [][]byte{}},
 	}
 
 	for _, storeKeysPrefix := range storeKeysPrefixes {
@@ -648,6 +777,128 @@ func TestAppStateDeterminism(t *testing.T) {
 	}
 }
 
+// newSimulationApp creates a fresh SimApp for every run of a replayed or
+// bisected simulation
+func newSimulationApp(config simulation.Config) (simulation.AppInputs, func()) {
+	logger := log.NewNopLogger()
+	if flagVerboseValue {
+		logger = log.TestingLogger()
+	}
+
+	db := dbm.NewMemDB()
+	app := NewSimApp(logger, db, nil, true, 0, fauxMerkleModeOpt)
+	return newSimulationInputs(app, config), func() { db.Close() }
+}
+
+// newSimulationInputs returns the inputs needed to simulate a SimApp and to
+// replay its operation logs
+func newSimulationInputs(app *SimApp, config simulation.Config) simulation.AppInputs {
+	router := baseapp.NewRouter()
+	app.mm.RegisterRoutes(router, baseapp.NewQueryRouter())
+
+	return simulation.AppInputs{
+		App:             app.BaseApp,
+		Codec:           app.cdc,
+		Router:          router,
+		AppStateFn:      AppStateFn,
+		Operations:      testAndRunTxs(app, config),
+		Invariants:      invariants(app),
+		BlackListedAccs: app.ModuleAccountAddrs(),
+		OperationReplayers: map[string]simulation.OperationReplayer{
+			simulation.OperationReplayerKey(auth.ModuleName, authsimops.DeductFeeOperationName): authsimops.ReplayDeductFee(app.SupplyKeeper),
+		},
+	}
+}
+
+// Replay a recorded simulation with:
+// go test ./simapp -run TestReplayOperationLog -ReplayLog=<operation log> [-HaltStep=<step>]
+func TestReplayOperationLog(t *testing.T) {
+	if flagReplayLogValue == "" {
+		t.Skip("Skipping simulation replay")
+	}
+
+	err := simulation.ReplayOperationLog(os.Stdout, flagReplayLogValue, flagHaltStepValue, newSimulationApp)
+	require.NoError(t, err)
+}
+
+// Find the first operation breaking an invariant in a recorded simulation with:
+// go test ./simapp -run TestBisectOperationLog -BisectLog=<operation log>
+func TestBisectOperationLog(t *testing.T) {
+	if flagBisectLogValue == "" {
+		t.Skip("Skipping simulation bisection")
+	}
+
+	report, err := simulation.BisectOperationLog(os.Stdout, flagBisectLogValue, newSimulationApp)
+	require.NoError(t, err)
+	t.Log(report)
+}
+
+func TestOperationLogReplayAndBisection(t *testing.T) {
+	if !flagEnabledValue {
+		t.Skip("Skipping application simulation")
+	}
+
+	dir, err := ioutil.TempDir("", "simulation-operation-log")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config := NewConfigFromFlags()
+	config.NumBlocks = 10
+	config.Commit = true
+	config.ExportParamsPath = ""
+	config.ExportStatePath = ""
+	config.ExportStatsPath = ""
+	config.OperationLogPath = filepath.Join(dir, "operations.log")
+	config.HaltStep = 0
+
+	// record a simulation
+	recorded := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, fauxMerkleModeOpt)
+	inputs := newSimulationInputs(recorded, config)
+	_, _, err = simulation.SimulateFromSeed(
+		t, os.Stdout, inputs.App, inputs.AppStateFn, inputs.Operations, inputs.Invariants,
+		inputs.BlackListedAccs, config,
+	)
+	require.NoError(t, err)
+
+	_, entries, err := simulation.ReadOperationLog(config.OperationLogPath)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+
+	// the replay delivers the recorded operations rather than generating
+	// them, so it reproduces the recorded state without any operations
+	var replayed *SimApp
+	newReplayApp := func(config simulation.Config) (simulation.AppInputs, func()) {
+		replayed = NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, fauxMerkleModeOpt)
+		inputs := newSimulationInputs(replayed, config)
+		inputs.AppStateFn, inputs.Operations = nil, nil
+		return inputs, func() {}
+	}
+	require.NoError(t, simulation.ReplayOperationLog(os.Stdout, config.OperationLogPath, 0, newReplayApp))
+
+	// the last replayed block is not committed
+	recordedCtx := recorded.NewContext(true, abci.Header{})
+	replayedCtx := replayed.NewContext(false, abci.Header{})
+	require.Equal(t, recorded.AccountKeeper.GetAllAccounts(recordedCtx), replayed.AccountKeeper.GetAllAccounts(replayedCtx))
+	require.Equal(t, recorded.StakingKeeper.GetAllValidators(recordedCtx), replayed.StakingKeeper.GetAllValidators(replayedCtx))
+	require.Equal(t, recorded.StakingKeeper.GetAllDelegations(recordedCtx), replayed.StakingKeeper.GetAllDelegations(replayedCtx))
+
+	// the bisection finds the first operation after which an invariant broken
+	// from block 5 onwards fails, i.e. the BeginBlock of block 5
+	brokenFromBlock5 := func(ctx sdk.Context) (string, bool) {
+		return "broken from block 5", ctx.BlockHeight() >= 5
+	}
+	newBrokenApp := func(config simulation.Config) (simulation.AppInputs, func()) {
+		inputs, cleanup := newSimulationApp(config)
+		inputs.Invariants = append(inputs.Invariants, brokenFromBlock5)
+		return inputs, cleanup
+	}
+	report, err := simulation.BisectOperationLog(os.Stdout, config.OperationLogPath, newBrokenApp)
+	require.NoError(t, err)
+	require.Equal(t, simulation.BeginBlockEntryKind, report.Entry.EntryKind)
+	require.Equal(t, int64(5), report.Entry.Height)
+	require.Equal(t, []string{"broken from block 5"}, report.Broken)
+}
+
 func BenchmarkInvariants(b *testing.B) {
 	logger := log.NewNopLogger()
 	config := NewConfigFromFlags()
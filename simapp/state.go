@@ -96,6 +96,7 @@ func AppStateRandomizedFn(
 	GenGovGenesisState(cdc, r, appParams, genesisState)
 	GenMintGenesisState(cdc, r, appParams, genesisState)
 	GenDistrGenesisState(cdc, r, appParams, genesisState)
+	GenTokenGenesisState(cdc, r, appParams, genesisState)
 	stakingGen := GenStakingGenesisState(cdc, r, accs, amount, numAccs, numInitiallyBonded, appParams, genesisState)
 	GenSlashingGenesisState(cdc, r, stakingGen, appParams, genesisState)
 
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/token"
 )
 
 //---------------------------------------------------------------------
@@ -41,13 +42,17 @@ var (
 	flagBlockSizeValue          int
 	flagLeanValue               bool
 	flagCommitValue             bool
-	flagOnOperationValue        bool // TODO: Remove in favor of binary search for invariant violation
+	flagOnOperationValue        bool
 	flagAllInvariantsValue      bool
+	flagOperationLogValue       string
+	flagHaltStepValue           int
 
 	flagEnabledValue     bool
 	flagVerboseValue     bool
 	flagPeriodValue      int
 	flagGenesisTimeValue int64
+	flagReplayLogValue   string
+	flagBisectLogValue   string
 )
 
 // GetSimulatorFlags gets the values of all the available simulation flags
@@ -68,12 +73,16 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&flagCommitValue, "Commit", false, "have the simulation commit")
 	flag.BoolVar(&flagOnOperationValue, "SimulateEveryOperation", false, "run slow invariants every operation")
 	flag.BoolVar(&flagAllInvariantsValue, "PrintAllInvariants", false, "print all invariants if a broken invariant is found")
+	flag.StringVar(&flagOperationLogValue, "OperationLog", "", "custom file path to record every executed operation to")
+	flag.IntVar(&flagHaltStepValue, "HaltStep", 0, "stop the simulation once this many operations were executed")
 
 	// SimApp flags
 	flag.BoolVar(&flagEnabledValue, "Enabled", false, "enable the simulation")
 	flag.BoolVar(&flagVerboseValue, "Verbose", false, "verbose log output")
 	flag.IntVar(&flagPeriodValue, "Period", 1, "run slow invariants only once every period assertions")
 	flag.Int64Var(&flagGenesisTimeValue, "GenesisTime", 0, "override genesis UNIX time instead of using a random UNIX time")
+	flag.StringVar(&flagReplayLogValue, "ReplayLog", "", "operation log of the simulation to replay")
+	flag.StringVar(&flagBisectLogValue, "BisectLog", "", "operation log in which to find the first operation breaking an invariant")
 }
 
 // NewConfigFromFlags creates a simulation from the retrieved values of the flags
@@ -93,6 +102,8 @@ func NewConfigFromFlags() simulation.Config {
 		Commit:             flagCommitValue,
 		OnOperation:        flagOnOperationValue,
 		AllInvariants:      flagAllInvariantsValue,
+		OperationLogPath:   flagOperationLogValue,
+		HaltStep:           flagHaltStepValue,
 	}
 }
 
@@ -158,6 +169,14 @@ func GenBankGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 				})
 			return v
 		}(r),
+		func(r *rand.Rand) []bank.SendEnabled {
+			var v bool
+			ap.GetOrGenerate(cdc, simulation.SendEnabledBondDenom, &v, r,
+				func(r *rand.Rand) {
+					v = simulation.ModuleParamSimulator[simulation.SendEnabledBondDenom](r).(bool)
+				})
+			return []bank.SendEnabled{bank.NewSendEnabled(sdk.DefaultBondDenom, v)}
+		}(r),
 	)
 
 	fmt.Printf("Selected randomly generated bank parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, bankGenesis))
@@ -169,6 +188,8 @@ func GenSupplyGenesisState(cdc *codec.Codec, amount, numInitiallyBonded, numAccs
 	totalSupply := sdk.NewInt(amount * (numAccs + numInitiallyBonded))
 	supplyGenesis := supply.NewGenesisState(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply)),
+		[]supply.DenomMetadata{},
+		[]supply.ModuleAccountPermissions{},
 	)
 
 	fmt.Printf("Generated supply parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, supplyGenesis))
@@ -336,6 +357,22 @@ func GenMintGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 				return v
 			}(r),
 			uint64(60*60*8766/5),
+			func(r *rand.Rand) []mint.MintDestination {
+				var share sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.MintFeeCollectorShare, &share, r,
+					func(r *rand.Rand) {
+						share = simulation.ModuleParamSimulator[simulation.MintFeeCollectorShare](r).(sdk.Dec)
+					})
+
+				// send the rest of the provisions to a random address outside
+				// of the simulated accounts
+				destinations := []mint.MintDestination{mint.NewModuleMintDestination(auth.FeeCollectorName, share)}
+				if share.LT(sdk.OneDec()) {
+					addr := simulation.RandomAccounts(r, 1)[0].Address
+					destinations = append(destinations, mint.NewAddressMintDestination(addr, sdk.OneDec().Sub(share)))
+				}
+				return destinations
+			}(r),
 		),
 	)
 
@@ -371,12 +408,49 @@ func GenDistrGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParam
 				})
 			return v
 		}(r),
+		AutoCompoundInterval: func(r *rand.Rand) int64 {
+			var v int64
+			ap.GetOrGenerate(cdc, simulation.AutoCompoundInterval, &v, r,
+				func(r *rand.Rand) {
+					v = simulation.ModuleParamSimulator[simulation.AutoCompoundInterval](r).(int64)
+				})
+			return v
+		}(r),
+		MaxAutoCompoundsPerBlock: func(r *rand.Rand) uint32 {
+			var v uint32
+			ap.GetOrGenerate(cdc, simulation.MaxAutoCompoundsPerBlock, &v, r,
+				func(r *rand.Rand) {
+					v = simulation.ModuleParamSimulator[simulation.MaxAutoCompoundsPerBlock](r).(uint32)
+				})
+			return v
+		}(r),
+		NextSpendStreamID: 1,
 	}
 
 	fmt.Printf("Selected randomly generated distribution parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, distrGenesis))
 	genesisState[distribution.ModuleName] = cdc.MustMarshalJSON(distrGenesis)
 }
 
+// GenTokenGenesisState generates a random GenesisState for token
+func GenTokenGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams, genesisState map[string]json.RawMessage) {
+	tokenGenesis := token.NewGenesisState(
+		token.NewParams(
+			func(r *rand.Rand) sdk.Coins {
+				var v sdk.Coins
+				ap.GetOrGenerate(cdc, simulation.TokenIssueFee, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.TokenIssueFee](r).(sdk.Coins)
+					})
+				return v
+			}(r),
+		),
+		token.Tokens{},
+	)
+
+	fmt.Printf("Selected randomly generated token parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, tokenGenesis.Params))
+	genesisState[token.ModuleName] = cdc.MustMarshalJSON(tokenGenesis)
+}
+
 // GenSlashingGenesisState generates a random GenesisState for slashing
 func GenSlashingGenesisState(
 	cdc *codec.Codec, r *rand.Rand, stakingGen staking.GenesisState,
@@ -425,6 +499,30 @@ func GenSlashingGenesisState(
 					})
 				return v
 			}(r),
+			func(r *rand.Rand) time.Duration {
+				var v time.Duration
+				ap.GetOrGenerate(cdc, simulation.DowntimePenaltyWindow, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.DowntimePenaltyWindow](r).(time.Duration)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.DowntimePenaltyMultiplier, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.DowntimePenaltyMultiplier](r).(sdk.Dec)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, simulation.DowntimePenaltyMaxTier, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.DowntimePenaltyMaxTier](r).(int64)
+					})
+				return v
+			}(r),
 		),
 		nil,
 		nil,
@@ -460,6 +558,8 @@ func GenStakingGenesisState(
 			}(r),
 			7,
 			sdk.DefaultBondDenom,
+			staking.DefaultMinCommissionRate,
+			staking.DefaultCommissionChangeDelay,
 		),
 		nil,
 		nil,
@@ -0,0 +1,73 @@
+package listenkv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+type write struct {
+	storeKey types.StoreKey
+	key      []byte
+	value    []byte
+	delete   bool
+}
+
+type testListener struct {
+	writes []write
+}
+
+func (l *testListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) {
+	l.writes = append(l.writes, write{storeKey, key, value, delete})
+}
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore(listener types.WriteListener) *listenkv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	listener := &testListener{}
+	store := newListenKVStore(listener)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.True(t, store.Has([]byte("key1")))
+
+	store.Delete([]byte("key1"))
+	require.Nil(t, store.Get([]byte("key1")))
+
+	require.Equal(t, []write{
+		{testStoreKey, []byte("key1"), []byte("value1"), false},
+		{testStoreKey, []byte("key1"), nil, true},
+	}, listener.writes)
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	listener := &testListener{}
+	store := newListenKVStore(listener)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Empty(t, listener.writes)
+
+	// the listeners are notified when the cache is written
+	cache.Write()
+	require.Equal(t, []write{
+		{testStoreKey, []byte("key1"), []byte("value1"), false},
+	}, listener.writes)
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := listenkv.NewStore(memDB, testStoreKey, nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
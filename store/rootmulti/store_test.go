@@ -342,6 +342,35 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+type writeRecorder struct {
+	keys [][]byte
+}
+
+func (wr *writeRecorder) OnWrite(_ types.StoreKey, key []byte, _ []byte, _ bool) {
+	wr.keys = append(wr.keys, key)
+}
+
+func TestMultiStoreListeners(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key1, key2 := ms.keysByName["store1"], ms.keysByName["store2"]
+	recorder := &writeRecorder{}
+	ms.AddListeners(key1, []types.WriteListener{recorder})
+	require.True(t, ms.ListeningEnabled(key1))
+	require.False(t, ms.ListeningEnabled(key2))
+
+	cms := ms.CacheMultiStore()
+	cms.GetKVStore(key1).Set([]byte("key1"), []byte("value1"))
+	cms.GetKVStore(key2).Set([]byte("key2"), []byte("value2"))
+	require.Empty(t, recorder.keys)
+
+	// only the writes to the listened store are notified once written
+	cms.Write()
+	require.Equal(t, [][]byte{[]byte("key1")}, recorder.keys)
+}
+
 //-----------------------------------------------------------------------
 // utils
 
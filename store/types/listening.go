@@ -0,0 +1,8 @@
+package types
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// OnWrite is called for every write to the store, with delete set to true
+	// if the key was deleted
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool)
+}
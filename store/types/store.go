@@ -132,6 +132,15 @@ type CommitMultiStore interface {
 	// Panics on a nil key.
 	GetCommitKVStore(key StoreKey) CommitKVStore
 
+	// AddListeners adds WriteListeners for the KVStore belonging to the
+	// provided StoreKey. They are notified of the writes to the store when
+	// its cache-wrapped stores are written.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore
+	// belonging to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
+
 	// Load the latest persisted version. Called once after all calls to
 	// Mount*Store() are complete.
 	LoadLatestVersion() error
@@ -61,6 +61,7 @@ func TestCoinIsValid(t *testing.T) {
 		{Coin{"a", NewInt(1)}, false},
 		{Coin{"a very long coin denom", NewInt(1)}, false},
 		{Coin{"atOm", NewInt(1)}, false},
+		{Coin{"token/addr/atom", NewInt(1)}, true},
 		{Coin{"     ", NewInt(1)}, false},
 	}
 
@@ -404,6 +405,7 @@ func TestParse(t *testing.T) {
 		{"11me coin, 12you coin", false, nil}, // no spaces in coin names
 		{"1.2btc", false, nil},                // amount must be integer
 		{"5foo-bar", false, nil},              // once more, only letters in coin name
+		{"7token/addr/foo", true, Coins{{"token/addr/foo", NewInt(7)}}},
 	}
 
 	for tcIndex, tc := range cases {
@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
 
 // An Invariant is a function which tests a particular invariant.
 // The invariant returns a descriptive message about what happened
@@ -11,11 +15,59 @@ type Invariant func(ctx Context) (string, bool)
 // Invariants defines a group of invariants
 type Invariants []Invariant
 
+// An IncrementalInvariant is a function which tests a particular invariant
+// only on the state written since its last run, as given by the write set. It
+// returns the same message and boolean as an Invariant.
+type IncrementalInvariant func(ctx Context, writeSet WriteSet) (string, bool)
+
+// WriteSet holds the keys written since the last invariant check, indexed by
+// the name of their store.
+type WriteSet map[string][][]byte
+
+// Keys returns the keys written in the store with the given name
+func (ws WriteSet) Keys(storeName string) [][]byte {
+	return ws[storeName]
+}
+
+// KeysWithPrefix returns the keys with the given prefix written in the store
+// with the given name
+func (ws WriteSet) KeysWithPrefix(storeName string, prefix []byte) [][]byte {
+	var keys [][]byte
+	for _, key := range ws[storeName] {
+		if bytes.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// NewWriteSet creates a WriteSet from the sets of keys written in each store,
+// sorting the keys of each store
+func NewWriteSet(keysByStore map[string]map[string]struct{}) WriteSet {
+	ws := make(WriteSet, len(keysByStore))
+	for storeName, keySet := range keysByStore {
+		keys := make([][]byte, 0, len(keySet))
+		for key := range keySet {
+			keys = append(keys, []byte(key))
+		}
+		sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+		ws[storeName] = keys
+	}
+	return ws
+}
+
 // expected interface for registering invariants
 type InvariantRegistry interface {
 	RegisterRoute(moduleName, route string, invar Invariant)
 }
 
+// expected interface for registering invariants which can also be tested
+// incrementally
+type IncrementalInvariantRegistry interface {
+	InvariantRegistry
+	RegisterIncrementalRoute(moduleName, route string, invar Invariant, incrInvar IncrementalInvariant)
+}
+
 // FormatInvariant returns a standardized invariant message.
 func FormatInvariant(module, name, msg string) string {
 	return fmt.Sprintf("%s: %s invariant\n%s\n", module, name, msg)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSet(t *testing.T) {
+	ws := NewWriteSet(map[string]map[string]struct{}{
+		"store1": {"b": {}, "a1": {}, "a2": {}},
+		"store2": {},
+	})
+
+	require.Equal(t, [][]byte{[]byte("a1"), []byte("a2"), []byte("b")}, ws.Keys("store1"))
+	require.Equal(t, [][]byte{[]byte("a1"), []byte("a2")}, ws.KeysWithPrefix("store1", []byte("a")))
+	require.Empty(t, ws.Keys("store2"))
+	require.Nil(t, ws.Keys("store3"))
+	require.Nil(t, ws.KeysWithPrefix("store3", []byte("a")))
+}
@@ -70,6 +70,7 @@ type (
 	StoreKey          = types.StoreKey
 	KVStoreKey        = types.KVStoreKey
 	TransientStoreKey = types.TransientStoreKey
+	WriteListener     = types.WriteListener
 )
 
 // NewKVStoreKey returns a new pointer to a KVStoreKey.
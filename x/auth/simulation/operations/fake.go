@@ -13,6 +13,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
+// DeductFeeOperationName is the name of the operations recorded by
+// SimulateDeductFee
+const DeductFeeOperationName = "deduct_fee"
+
+// deductFee is the message recorded by SimulateDeductFee for the fees it
+// deducted
+type deductFee struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Fees    sdk.Coins      `json:"fees" yaml:"fees"`
+}
+
 // SimulateDeductFee
 func SimulateDeductFee(ak auth.AccountKeeper, supplyKeeper types.SupplyKeeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
@@ -22,7 +33,7 @@ func SimulateDeductFee(ak auth.AccountKeeper, supplyKeeper types.SupplyKeeper) s
 		account := simulation.RandomAcc(r, accs)
 		stored := ak.GetAccount(ctx, account.Address)
 		initCoins := stored.GetCoins()
-		opMsg = simulation.NewOperationMsgBasic(types.ModuleName, "deduct_fee", "", false, nil)
+		opMsg = simulation.NewOperationMsgBasic(types.ModuleName, DeductFeeOperationName, "", false, nil)
 
 		feeCollector := ak.GetAccount(ctx, supplyKeeper.GetModuleAddress(types.FeeCollectorName))
 		if feeCollector == nil {
@@ -61,10 +72,26 @@ func SimulateDeductFee(ak auth.AccountKeeper, supplyKeeper types.SupplyKeeper) s
 		}
 
 		opMsg.OK = true
+		opMsg.Msg = types.ModuleCdc.MustMarshalJSON(deductFee{Address: stored.GetAddress(), Fees: fees})
 		return opMsg, nil, nil
 	}
 }
 
+// ReplayDeductFee replays the fees deducted by SimulateDeductFee when an
+// operation log is replayed
+func ReplayDeductFee(supplyKeeper types.SupplyKeeper) simulation.OperationReplayer {
+	return func(app *baseapp.BaseApp, ctx sdk.Context, opMsg simulation.OperationMsg) error {
+		var msg deductFee
+		if err := types.ModuleCdc.UnmarshalJSON(opMsg.Msg, &msg); err != nil {
+			return err
+		}
+		if err := supplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Address, types.FeeCollectorName, msg.Fees); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
 func randPositiveInt(r *rand.Rand, max sdk.Int) (sdk.Int, error) {
 	if !max.GT(sdk.OneInt()) {
 		return sdk.Int{}, errors.New("max too small")
@@ -63,11 +63,11 @@ func ParamKeyTable() subspace.KeyTable {
 // nolint
 func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
-		{KeyMaxMemoCharacters, &p.MaxMemoCharacters},
-		{KeyTxSigLimit, &p.TxSigLimit},
-		{KeyTxSizeCostPerByte, &p.TxSizeCostPerByte},
-		{KeySigVerifyCostED25519, &p.SigVerifyCostED25519},
-		{KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1},
+		subspace.NewParamSetPair(KeyMaxMemoCharacters, &p.MaxMemoCharacters, validateMaxMemoCharacters),
+		subspace.NewParamSetPair(KeyTxSigLimit, &p.TxSigLimit, validateTxSigLimit),
+		subspace.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
+		subspace.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
+		subspace.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
 	}
 }
 
@@ -100,3 +100,34 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256k1: %d\n", p.SigVerifyCostSecp256k1))
 	return sb.String()
 }
+
+func validatePositiveUint64(name string, i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("auth parameter %s must be positive", name)
+	}
+	return nil
+}
+
+func validateMaxMemoCharacters(i interface{}) error {
+	return validatePositiveUint64("MaxMemoCharacters", i)
+}
+
+func validateTxSigLimit(i interface{}) error {
+	return validatePositiveUint64("TxSigLimit", i)
+}
+
+func validateTxSizeCostPerByte(i interface{}) error {
+	return validatePositiveUint64("TxSizeCostPerByte", i)
+}
+
+func validateSigVerifyCostED25519(i interface{}) error {
+	return validatePositiveUint64("SigVerifyCostED25519", i)
+}
+
+func validateSigVerifyCostSecp256k1(i interface{}) error {
+	return validatePositiveUint64("SigVerifyCostSecp256k1", i)
+}
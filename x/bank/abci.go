@@ -0,0 +1,11 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker called every block, releases the locked transfers whose release
+// time has passed.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.ReleaseLockedTransfers(ctx)
+}
@@ -17,23 +17,52 @@ const (
 	RouterKey                = types.RouterKey
 	QuerierRoute             = types.QuerierRoute
 	DefaultParamspace        = types.DefaultParamspace
+
+	CodeInvalidReleaseTime      = types.CodeInvalidReleaseTime
+	CodeUnknownLockedTransfer   = types.CodeUnknownLockedTransfer
+	CodeLockedTransfersDisabled = types.CodeLockedTransfersDisabled
+	CodeInvalidCanceller        = types.CodeInvalidCanceller
+	StoreKey                    = types.StoreKey
+	EscrowPoolName              = types.EscrowPoolName
+	QueryLockedTransfer         = keeper.QueryLockedTransfer
+	QueryLockedTransfers        = keeper.QueryLockedTransfers
 )
 
 var (
 	// functions aliases
-	RegisterCodec          = types.RegisterCodec
-	ErrNoInputs            = types.ErrNoInputs
-	ErrNoOutputs           = types.ErrNoOutputs
-	ErrInputOutputMismatch = types.ErrInputOutputMismatch
-	ErrSendDisabled        = types.ErrSendDisabled
-	NewBaseKeeper          = keeper.NewBaseKeeper
-	NewInput               = types.NewInput
-	NewOutput              = types.NewOutput
-	ParamKeyTable          = types.ParamKeyTable
+	RegisterCodec                    = types.RegisterCodec
+	ErrNoInputs                      = types.ErrNoInputs
+	ErrNoOutputs                     = types.ErrNoOutputs
+	ErrInputOutputMismatch           = types.ErrInputOutputMismatch
+	ErrSendDisabled                  = types.ErrSendDisabled
+	ErrSendDisabledDenom             = types.ErrSendDisabledDenom
+	NewBaseKeeper                    = keeper.NewBaseKeeper
+	NewBaseKeeperWithLockedTransfers = keeper.NewBaseKeeperWithLockedTransfers
+	NewInput                         = types.NewInput
+	NewOutput                        = types.NewOutput
+	ParamKeyTable                    = types.ParamKeyTable
+
+	NewSendEnabled            = types.NewSendEnabled
+	ValidateSendEnabledDenoms = types.ValidateSendEnabledDenoms
+	NewMultiSendRestrictions  = types.NewMultiSendRestrictions
+
+	ErrInvalidReleaseTime         = types.ErrInvalidReleaseTime
+	ErrUnknownLockedTransfer      = types.ErrUnknownLockedTransfer
+	ErrLockedTransfersDisabled    = types.ErrLockedTransfersDisabled
+	ErrInvalidCanceller           = types.ErrInvalidCanceller
+	NewMsgSendLocked              = types.NewMsgSendLocked
+	NewMsgCancelLockedTransfer    = types.NewMsgCancelLockedTransfer
+	NewLockedTransfer             = types.NewLockedTransfer
+	NewQueryLockedTransferParams  = types.NewQueryLockedTransferParams
+	NewQueryLockedTransfersParams = types.NewQueryLockedTransfersParams
+	GetLockedTransferIDBytes      = types.GetLockedTransferIDBytes
+	GetLockedTransferIDFromBytes  = types.GetLockedTransferIDFromBytes
 
 	// variable aliases
 	ModuleCdc                = types.ModuleCdc
 	ParamStoreKeySendEnabled = types.ParamStoreKeySendEnabled
+
+	ParamStoreKeySendEnabledDenoms = types.ParamStoreKeySendEnabledDenoms
 )
 
 type (
@@ -43,4 +72,16 @@ type (
 	MsgMultiSend = types.MsgMultiSend
 	Input        = types.Input
 	Output       = types.Output
+	SendEnabled  = types.SendEnabled
+
+	SendRestriction       = types.SendRestriction
+	SendRestrictionFn     = types.SendRestrictionFn
+	MultiSendRestrictions = types.MultiSendRestrictions
+
+	MsgSendLocked              = types.MsgSendLocked
+	MsgCancelLockedTransfer    = types.MsgCancelLockedTransfer
+	LockedTransfer             = types.LockedTransfer
+	LockedTransfers            = types.LockedTransfers
+	QueryLockedTransferParams  = types.QueryLockedTransferParams
+	QueryLockedTransfersParams = types.QueryLockedTransfersParams
 )
@@ -3,8 +3,10 @@ package bank_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 
@@ -354,3 +356,23 @@ func TestMsgMultiSendDependent(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSendDisabledDenom(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := bank.NewHandler(app.BankKeeper)
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	require.NoError(t, app.BankKeeper.SetCoins(ctx, addr1, sdk.NewCoins(manyCoins...)))
+	app.BankKeeper.SetSendEnabledDenoms(ctx, []types.SendEnabled{types.NewSendEnabled("foocoin", false)})
+
+	res := handler(ctx, types.NewMsgSend(addr1, addr2, coins))
+	require.Equal(t, types.CodeSendDisabled, res.Code)
+	res = handler(ctx, multiSendMsg5)
+	require.Equal(t, types.CodeSendDisabled, res.Code)
+
+	barCoins := sdk.NewCoins(sdk.NewInt64Coin("barcoin", 1))
+	res = handler(ctx, types.NewMsgSend(addr1, addr2, barCoins))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, barCoins, app.BankKeeper.GetCoins(ctx, addr2))
+}
@@ -45,7 +45,10 @@ func getBenchmarkMockApp() (*mock.App, error) {
 	blacklistedAddrs := make(map[string]bool)
 	blacklistedAddrs[moduleAccAddr.String()] = true
 
-	bankKeeper := keeper.NewBaseKeeper(
+	keyBank := sdk.NewKVStoreKey(types.StoreKey)
+	bankKeeper := keeper.NewBaseKeeperWithLockedTransfers(
+		mapp.Cdc,
+		keyBank,
 		mapp.AccountKeeper,
 		mapp.ParamsKeeper.Subspace(types.DefaultParamspace),
 		types.DefaultCodespace,
@@ -54,7 +57,7 @@ func getBenchmarkMockApp() (*mock.App, error) {
 	mapp.Router().AddRoute(types.RouterKey, bank.NewHandler(bankKeeper))
 	mapp.SetInitChainer(getInitChainer(mapp, bankKeeper))
 
-	err := mapp.CompleteSetup()
+	err := mapp.CompleteSetup(keyBank)
 	return mapp, err
 }
 
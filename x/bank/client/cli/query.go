@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+const (
+	flagSender    = "sender"
+	flagRecipient = "recipient"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryLockedTransfer(cdc),
+		GetCmdQueryLockedTransfers(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryLockedTransfer implements the query locked transfer command.
+func GetCmdQueryLockedTransfer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "locked-transfer [locked-transfer-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a pending locked transfer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the details of a pending locked transfer.
+
+Example:
+$ %s query bank locked-transfer 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("locked transfer id %s not a valid uint, please input a valid locked transfer id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryLockedTransferParams(id))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData("custom/bank/locked_transfer", bz)
+			if err != nil {
+				return err
+			}
+
+			var lockedTransfer types.LockedTransfer
+			cdc.MustUnmarshalJSON(res, &lockedTransfer)
+			return cliCtx.PrintOutput(lockedTransfer)
+		},
+	}
+}
+
+// GetCmdQueryLockedTransfers implements the query locked transfers command.
+func GetCmdQueryLockedTransfers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locked-transfers",
+		Args:  cobra.NoArgs,
+		Short: "Query the pending locked transfers with optional filters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending locked transfers. You can filter them by sender and recipient.
+
+Example:
+$ %s query bank locked-transfers --sender cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %s query bank locked-transfers --recipient cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var sender, recipient sdk.AccAddress
+			if s := viper.GetString(flagSender); s != "" {
+				addr, err := sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+				sender = addr
+			}
+			if s := viper.GetString(flagRecipient); s != "" {
+				addr, err := sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+				recipient = addr
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryLockedTransfersParams(sender, recipient))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData("custom/bank/locked_transfers", bz)
+			if err != nil {
+				return err
+			}
+
+			var lockedTransfers types.LockedTransfers
+			cdc.MustUnmarshalJSON(res, &lockedTransfers)
+			return cliCtx.PrintOutput(lockedTransfers)
+		},
+	}
+
+	cmd.Flags().String(flagSender, "", "(optional) filter by locked transfers sent by the address")
+	cmd.Flags().String(flagRecipient, "", "(optional) filter by locked transfers sent to the address")
+
+	return cmd
+}
@@ -1,17 +1,26 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
+const flagCanceller = "canceller"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
@@ -23,6 +32,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(
 		SendTxCmd(cdc),
+		SendLockedTxCmd(cdc),
+		CancelLockedTransferTxCmd(cdc),
 	)
 	return txCmd
 }
@@ -58,3 +69,84 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// SendLockedTxCmd will create a locked send tx and sign it with the given key.
+func SendLockedTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-locked [from_key_or_address] [to_address] [amount] [release_time]",
+		Short: "Create and sign a send tx whose coins are held in escrow until a release time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send coins that are held in escrow until the release time, given in RFC3339
+format, when they are sent to the recipient. The optional canceller can cancel
+the transfer before the release time, returning the coins to the sender.
+
+Example:
+$ %s tx bank send-locked mykey cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 1000stake 2020-01-01T00:00:00Z --canceller cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			releaseTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			var canceller sdk.AccAddress
+			if s := viper.GetString(flagCanceller); s != "" {
+				canceller, err = sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSendLocked(cliCtx.GetFromAddress(), to, coins, releaseTime, canceller)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagCanceller, "", "(optional) address allowed to cancel the transfer before its release time")
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CancelLockedTransferTxCmd will create a locked transfer cancellation tx and
+// sign it with the given key.
+func CancelLockedTransferTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-locked [locked_transfer_id]",
+		Short: "Cancel a pending locked transfer, returning its coins to the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("locked transfer id %s not a valid uint, please input a valid locked transfer id", args[0])
+			}
+
+			msg := types.NewMsgCancelLockedTransfer(cliCtx.GetFromAddress(), id)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -17,6 +18,10 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/accounts/{address}/locked_transfers", SendLockedRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/locked_transfers", QueryLockedTransfersRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/locked_transfers/{lockedTransferID}", QueryLockedTransferRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/locked_transfers/{lockedTransferID}/cancel", CancelLockedTransferRequestHandlerFn(cliCtx)).Methods("POST")
 }
 
 // SendReq defines the properties of a send request's body.
@@ -57,3 +62,87 @@ func SendRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// SendLockedReq defines the properties of a locked send request's body.
+type SendLockedReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	ReleaseTime time.Time      `json:"release_time" yaml:"release_time"`
+	Canceller   sdk.AccAddress `json:"canceller" yaml:"canceller"`
+}
+
+// CancelLockedTransferReq defines the properties of a locked transfer
+// cancellation request's body.
+type CancelLockedTransferReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+// SendLockedRequestHandlerFn - http request handler to send coins held in
+// escrow until a release time to a address.
+func SendLockedRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32Addr := vars["address"]
+
+		toAddr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req SendLockedReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSendLocked(fromAddr, toAddr, req.Amount, req.ReleaseTime, req.Canceller)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CancelLockedTransferRequestHandlerFn - http request handler to cancel a
+// pending locked transfer.
+func CancelLockedTransferRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["lockedTransferID"])
+		if !ok {
+			return
+		}
+
+		var req CancelLockedTransferReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		canceller, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCancelLockedTransfer(canceller, id)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -1,32 +1,83 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabled              bool            `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabledDenoms        []SendEnabled   `json:"send_enabled_denoms" yaml:"send_enabled_denoms"`
+	StartingLockedTransferID uint64          `json:"starting_locked_transfer_id" yaml:"starting_locked_transfer_id"`
+	LockedTransfers          LockedTransfers `json:"locked_transfers" yaml:"locked_transfers"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(sendEnabled bool, sendEnabledDenoms []SendEnabled) GenesisState {
+	return GenesisState{
+		SendEnabled:              sendEnabled,
+		SendEnabledDenoms:        sendEnabledDenoms,
+		StartingLockedTransferID: 1,
+		LockedTransfers:          LockedTransfers{},
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+func DefaultGenesisState() GenesisState { return NewGenesisState(true, []SendEnabled{}) }
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	keeper.SetSendEnabledDenoms(ctx, data.SendEnabledDenoms)
+
+	if !keeper.LockedTransfersEnabled() {
+		if len(data.LockedTransfers) != 0 {
+			panic("locked transfers in genesis but the bank keeper has no locked transfer store")
+		}
+		return
+	}
+
+	keeper.SetNextLockedTransferID(ctx, data.StartingLockedTransferID)
+	for _, lockedTransfer := range data.LockedTransfers {
+		keeper.SetLockedTransfer(ctx, lockedTransfer)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	data := NewGenesisState(keeper.GetSendEnabled(ctx), keeper.GetSendEnabledDenoms(ctx))
+	data.StartingLockedTransferID = keeper.GetNextLockedTransferID(ctx)
+	data.LockedTransfers = append(data.LockedTransfers, keeper.GetAllLockedTransfers(ctx)...)
+	return data
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	if err := ValidateSendEnabledDenoms(data.SendEnabledDenoms); err != nil {
+		return err
+	}
+
+	if data.StartingLockedTransferID == 0 {
+		return fmt.Errorf("starting locked transfer id must be positive")
+	}
+
+	seen := make(map[uint64]bool)
+	for _, lockedTransfer := range data.LockedTransfers {
+		if err := lockedTransfer.Validate(); err != nil {
+			return err
+		}
+		if lockedTransfer.ID == 0 || lockedTransfer.ID >= data.StartingLockedTransferID {
+			return fmt.Errorf("locked transfer id %d must be positive and lower than the starting locked transfer id %d",
+				lockedTransfer.ID, data.StartingLockedTransferID)
+		}
+		if seen[lockedTransfer.ID] {
+			return fmt.Errorf("duplicate locked transfer id %d", lockedTransfer.ID)
+		}
+		seen[lockedTransfer.ID] = true
+	}
+
+	return nil
+}
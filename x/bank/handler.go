@@ -20,6 +20,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgMultiSend:
 			return handleMsgMultiSend(ctx, k, msg)
 
+		case types.MsgSendLocked:
+			return handleMsgSendLocked(ctx, k, msg)
+
+		case types.MsgCancelLockedTransfer:
+			return handleMsgCancelLockedTransfer(ctx, k, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized bank message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -29,8 +35,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 // Handle MsgSend.
 func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) sdk.Result {
-	if !k.GetSendEnabled(ctx) {
-		return types.ErrSendDisabled(k.Codespace()).Result()
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount); err != nil {
+		return err.Result()
 	}
 
 	if k.BlacklistedAddr(msg.ToAddress) {
@@ -55,8 +61,10 @@ func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) sdk.Resu
 // Handle MsgMultiSend.
 func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend) sdk.Result {
 	// NOTE: totalIn == totalOut should already have been checked
-	if !k.GetSendEnabled(ctx) {
-		return types.ErrSendDisabled(k.Codespace()).Result()
+	for _, in := range msg.Inputs {
+		if err := k.IsSendEnabledCoins(ctx, in.Coins); err != nil {
+			return err.Result()
+		}
 	}
 
 	for _, out := range msg.Outputs {
@@ -79,3 +87,49 @@ func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Handle MsgSendLocked.
+func handleMsgSendLocked(ctx sdk.Context, k keeper.Keeper, msg types.MsgSendLocked) sdk.Result {
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	id, err := k.SendLockedCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, msg.ReleaseTime, msg.Canceller)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return sdk.Result{
+		Data:   types.GetLockedTransferIDBytes(id),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// Handle MsgCancelLockedTransfer.
+func handleMsgCancelLockedTransfer(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelLockedTransfer) sdk.Result {
+	err := k.CancelLockedTransfer(ctx, msg.Canceller, msg.LockedTransferID)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Canceller.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -4,13 +4,21 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
 // RegisterInvariants registers the bank module invariants
-func RegisterInvariants(ir sdk.InvariantRegistry, ak types.AccountKeeper) {
-	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding",
-		NonnegativeBalanceInvariant(ak))
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper, ak types.AccountKeeper) {
+	if incrIR, ok := ir.(sdk.IncrementalInvariantRegistry); ok {
+		incrIR.RegisterIncrementalRoute(types.ModuleName, "nonnegative-outstanding",
+			NonnegativeBalanceInvariant(ak), IncrementalNonnegativeBalanceInvariant(ak))
+	} else {
+		ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding",
+			NonnegativeBalanceInvariant(ak))
+	}
+	ir.RegisterRoute(types.ModuleName, "escrow-pool",
+		EscrowPoolInvariant(k, ak))
 }
 
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
@@ -35,3 +43,54 @@ func NonnegativeBalanceInvariant(ak types.AccountKeeper) sdk.Invariant {
 			fmt.Sprintf("amount of negative accounts found %d\n%s", count, msg)), broken
 	}
 }
+
+// IncrementalNonnegativeBalanceInvariant checks that the accounts written
+// since the last check have non-negative balances
+func IncrementalNonnegativeBalanceInvariant(ak types.AccountKeeper) sdk.IncrementalInvariant {
+	return func(ctx sdk.Context, writeSet sdk.WriteSet) (string, bool) {
+		var msg string
+		var count int
+
+		keys := writeSet.KeysWithPrefix(authtypes.StoreKey, authtypes.AddressStoreKeyPrefix)
+		for _, key := range keys {
+			acc := ak.GetAccount(ctx, sdk.AccAddress(key[len(authtypes.AddressStoreKeyPrefix):]))
+			if acc == nil {
+				// deleted account
+				continue
+			}
+			coins := acc.GetCoins()
+			if coins.IsAnyNegative() {
+				count++
+				msg += fmt.Sprintf("\t%s has a negative denomination of %s\n",
+					acc.GetAddress().String(),
+					coins.String())
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "nonnegative-outstanding",
+			fmt.Sprintf("amount of negative accounts found %d among %d written accounts\n%s",
+				count, len(keys), msg)), broken
+	}
+}
+
+// EscrowPoolInvariant checks that the escrow pool holds exactly the coins of
+// the pending locked transfers
+func EscrowPoolInvariant(k Keeper, ak types.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expected sdk.Coins
+		k.IterateLockedTransfers(ctx, func(lockedTransfer types.LockedTransfer) bool {
+			expected = expected.Add(lockedTransfer.Amount)
+			return false
+		})
+
+		var balance sdk.Coins
+		if acc := ak.GetAccount(ctx, k.GetEscrowPoolAddress()); acc != nil {
+			balance = acc.GetCoins()
+		}
+		broken := !balance.IsAllGTE(expected) || !expected.IsAllGTE(balance)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-pool",
+			fmt.Sprintf("\tescrow pool balance: %s\n\tsum of locked transfers: %s\n", balance, expected)), broken
+	}
+}
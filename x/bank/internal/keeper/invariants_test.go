@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestIncrementalNonnegativeBalanceInvariant(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 10))))
+	input.ak.SetAccount(ctx, acc1)
+
+	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
+	require.NoError(t, acc2.SetCoins(sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-10)}}))
+	input.ak.SetAccount(ctx, acc2)
+
+	invar := IncrementalNonnegativeBalanceInvariant(input.ak)
+	writeSet := func(addrs ...sdk.AccAddress) sdk.WriteSet {
+		keys := make(map[string]struct{})
+		for _, addr := range addrs {
+			keys[string(auth.AddressStoreKey(addr))] = struct{}{}
+		}
+		return sdk.NewWriteSet(map[string]map[string]struct{}{auth.StoreKey: keys})
+	}
+
+	// only the written accounts are checked, deleted accounts are skipped
+	_, broken := invar(ctx, writeSet(addr1, addr3))
+	require.False(t, broken)
+
+	_, broken = invar(ctx, writeSet(addr1, addr2))
+	require.True(t, broken)
+
+	// the full invariant catches the negative balance in any case
+	_, broken = NonnegativeBalanceInvariant(input.ak)(ctx)
+	require.True(t, broken)
+}
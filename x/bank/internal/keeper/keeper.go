@@ -6,10 +6,12 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
 var _ Keeper = (*BaseKeeper)(nil)
@@ -21,6 +23,21 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+
+	SendLockedCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+		releaseTime time.Time, canceller sdk.AccAddress) (uint64, sdk.Error)
+	CancelLockedTransfer(ctx sdk.Context, canceller sdk.AccAddress, id uint64) sdk.Error
+	ReleaseLockedTransfers(ctx sdk.Context)
+
+	GetLockedTransfer(ctx sdk.Context, id uint64) (types.LockedTransfer, bool)
+	SetLockedTransfer(ctx sdk.Context, lockedTransfer types.LockedTransfer)
+	GetLockedTransfers(ctx sdk.Context, sender, recipient sdk.AccAddress) types.LockedTransfers
+	GetAllLockedTransfers(ctx sdk.Context) types.LockedTransfers
+	IterateLockedTransfers(ctx sdk.Context, cb func(lockedTransfer types.LockedTransfer) (stop bool))
+	GetNextLockedTransferID(ctx sdk.Context) uint64
+	SetNextLockedTransferID(ctx sdk.Context, id uint64)
+	GetEscrowPoolAddress() sdk.AccAddress
+	LockedTransfersEnabled() bool
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
@@ -29,9 +46,13 @@ type BaseKeeper struct {
 
 	ak         types.AccountKeeper
 	paramSpace params.Subspace
+
+	// store of the locked transfers, which are disabled if it is not set
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
 }
 
-// NewBaseKeeper returns a new BaseKeeper
+// NewBaseKeeper returns a new BaseKeeper, without locked transfers
 func NewBaseKeeper(ak types.AccountKeeper,
 	paramSpace params.Subspace,
 	codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseKeeper {
@@ -44,6 +65,18 @@ func NewBaseKeeper(ak types.AccountKeeper,
 	}
 }
 
+// NewBaseKeeperWithLockedTransfers returns a new BaseKeeper storing the locked
+// transfers under the given store key
+func NewBaseKeeperWithLockedTransfers(cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper,
+	paramSpace params.Subspace,
+	codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseKeeper {
+
+	keeper := NewBaseKeeper(ak, paramSpace, codespace, blacklistedAddrs)
+	keeper.cdc = cdc
+	keeper.storeKey = key
+	return keeper
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins.
@@ -145,6 +178,10 @@ type SendKeeper interface {
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetSendEnabledDenoms(ctx sdk.Context) []types.SendEnabled
+	SetSendEnabledDenoms(ctx sdk.Context, sendEnabledDenoms []types.SendEnabled)
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+	IsSendEnabledCoins(ctx sdk.Context, coins sdk.Coins) sdk.Error
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
 }
@@ -161,6 +198,9 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blacklistedAddrs map[string]bool
+
+	// hook inspecting every transfer of coins between accounts
+	sendRestriction types.SendRestriction
 }
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
@@ -175,6 +215,37 @@ func NewBaseSendKeeper(ak types.AccountKeeper,
 	}
 }
 
+// SetSendRestriction adds a hook inspecting every transfer of coins made with
+// SendCoins or InputOutputCoins. Restrictions set by successive calls are run
+// in order, each one receiving the recipient returned by the previous one. It
+// must be called before the keeper is passed on to other keepers, as these
+// hold copies of it.
+func (keeper *BaseSendKeeper) SetSendRestriction(sr types.SendRestriction) {
+	if keeper.sendRestriction == nil {
+		keeper.sendRestriction = sr
+		return
+	}
+	keeper.sendRestriction = types.NewMultiSendRestrictions(keeper.sendRestriction, sr)
+}
+
+// restrictSend runs the send restriction, if any, on a transfer and returns
+// the address the coins are to be sent to. A transfer cannot be redirected to
+// a blacklisted address.
+func (keeper BaseSendKeeper) restrictSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, sdk.Error) {
+	if keeper.sendRestriction == nil {
+		return toAddr, nil
+	}
+
+	restricted, err := keeper.sendRestriction.RestrictSend(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+	if !restricted.Equals(toAddr) && keeper.BlacklistedAddr(restricted) {
+		return nil, sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", restricted))
+	}
+	return restricted, nil
+}
+
 // InputOutputCoins handles a list of inputs and outputs
 func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) sdk.Error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
@@ -183,6 +254,31 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 		return err
 	}
 
+	// transfers from or to module accounts only are not subject to the per-denom
+	// send restrictions
+	if !keeper.allModuleAccounts(ctx, inputAddresses(inputs)) && !keeper.allModuleAccounts(ctx, outputAddresses(outputs)) {
+		for _, in := range inputs {
+			if err := keeper.IsSendEnabledCoins(ctx, in.Coins); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the coins of an output cannot be attributed to a single input, so every
+	// output is checked against each of the inputs in turn
+	restricted := make([]types.Output, len(outputs))
+	for i, out := range outputs {
+		toAddr := out.Address
+		for _, in := range inputs {
+			var err sdk.Error
+			toAddr, err = keeper.restrictSend(ctx, in.Address, toAddr, out.Coins)
+			if err != nil {
+				return err
+			}
+		}
+		restricted[i] = types.NewOutput(toAddr, out.Coins)
+	}
+
 	for _, in := range inputs {
 		_, err := keeper.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -197,7 +293,7 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 		)
 	}
 
-	for _, out := range outputs {
+	for _, out := range restricted {
 		_, err := keeper.AddCoins(ctx, out.Address, out.Coins)
 		if err != nil {
 			return err
@@ -216,6 +312,25 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 
 // SendCoins moves coins from one account to another
 func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	// transfers from or to a module account, e.g. fees, deposits or rewards, are
+	// not subject to the per-denom send restrictions
+	if !keeper.allModuleAccounts(ctx, []sdk.AccAddress{fromAddr}) && !keeper.allModuleAccounts(ctx, []sdk.AccAddress{toAddr}) {
+		if err := keeper.IsSendEnabledCoins(ctx, amt); err != nil {
+			return err
+		}
+	}
+
+	toAddr, err := keeper.restrictSend(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	return keeper.sendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// sendCoins moves coins from one account to another without running the send
+// restriction
+func (keeper BaseSendKeeper) sendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	_, err := keeper.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -327,6 +442,67 @@ func (keeper BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
 }
 
+// GetSendEnabledDenoms returns the per-denom send enabled table. The table is
+// empty if it has never been set.
+func (keeper BaseSendKeeper) GetSendEnabledDenoms(ctx sdk.Context) []types.SendEnabled {
+	var sendEnabledDenoms []types.SendEnabled
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeySendEnabledDenoms, &sendEnabledDenoms)
+	return sendEnabledDenoms
+}
+
+// SetSendEnabledDenoms sets the per-denom send enabled table
+func (keeper BaseSendKeeper) SetSendEnabledDenoms(ctx sdk.Context, sendEnabledDenoms []types.SendEnabled) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabledDenoms, &sendEnabledDenoms)
+}
+
+// IsSendEnabledDenom returns whether the coins of a denom can be sent. Denoms
+// without an entry in the per-denom table follow SendEnabled.
+func (keeper BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	for _, se := range keeper.GetSendEnabledDenoms(ctx) {
+		if se.Denom == denom {
+			return se.Enabled
+		}
+	}
+	return keeper.GetSendEnabled(ctx)
+}
+
+// IsSendEnabledCoins returns an error if the coins of any of the given denoms
+// cannot be sent.
+func (keeper BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins sdk.Coins) sdk.Error {
+	for _, coin := range coins {
+		if !keeper.IsSendEnabledDenom(ctx, coin.Denom) {
+			return types.ErrSendDisabledDenom(keeper.Codespace(), coin.Denom)
+		}
+	}
+	return nil
+}
+
+// allModuleAccounts returns true if all the given addresses are module accounts
+func (keeper BaseSendKeeper) allModuleAccounts(ctx sdk.Context, addrs []sdk.AccAddress) bool {
+	for _, addr := range addrs {
+		if _, ok := keeper.ak.GetAccount(ctx, addr).(supplyexported.ModuleAccountI); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func inputAddresses(inputs []types.Input) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
+		addrs[i] = in.Address
+	}
+	return addrs
+}
+
+func outputAddresses(outputs []types.Output) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		addrs[i] = out.Address
+	}
+	return addrs
+}
+
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
 // receiving funds)
 func (keeper BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
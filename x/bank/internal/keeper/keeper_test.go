@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 func TestKeeper(t *testing.T) {
@@ -98,9 +99,9 @@ func TestSendKeeper(t *testing.T) {
 
 	blacklistedAddrs := make(map[string]bool)
 
-	paramSpace := input.pk.Subspace("newspace")
+	paramSpace := input.pk.Subspace("newspace").WithKeyTable(types.ParamKeyTable())
 	sendKeeper := NewBaseSendKeeper(input.ak, paramSpace, types.DefaultCodespace, blacklistedAddrs)
-	input.k.SetSendEnabled(ctx, true)
+	sendKeeper.SetSendEnabled(ctx, true)
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -143,6 +144,132 @@ func TestSendKeeper(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSendEnabledDenoms(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	require.Empty(t, input.k.GetSendEnabledDenoms(ctx))
+	require.True(t, input.k.IsSendEnabledDenom(ctx, "foocoin"))
+
+	input.k.SetSendEnabledDenoms(ctx, []types.SendEnabled{
+		types.NewSendEnabled("foocoin", false),
+		types.NewSendEnabled("barcoin", true),
+	})
+	require.False(t, input.k.IsSendEnabledDenom(ctx, "foocoin"))
+	require.True(t, input.k.IsSendEnabledDenom(ctx, "barcoin"))
+	require.True(t, input.k.IsSendEnabledDenom(ctx, "bazcoin"))
+
+	// denoms without an entry follow the global flag
+	input.k.SetSendEnabled(ctx, false)
+	require.True(t, input.k.IsSendEnabledDenom(ctx, "barcoin"))
+	require.False(t, input.k.IsSendEnabledDenom(ctx, "bazcoin"))
+
+	require.NoError(t, input.k.IsSendEnabledCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 1))))
+	err := input.k.IsSendEnabledCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 1), sdk.NewInt64Coin("foocoin", 1)))
+	require.Error(t, err)
+	require.Equal(t, types.CodeSendDisabled, err.Code())
+}
+
+func TestSendCoinsDisabledDenom(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	moduleAddr := supply.NewModuleAddress("moduleAcc")
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	input.ak.SetAccount(ctx, supply.NewEmptyModuleAccount("moduleAcc"))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin("barcoin", 10))
+	input.k.SetCoins(ctx, addr, coins)
+	input.k.SetSendEnabledDenoms(ctx, []types.SendEnabled{types.NewSendEnabled("foocoin", false)})
+
+	// transfers between user accounts are blocked
+	err := input.k.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5)))
+	require.Error(t, err)
+	require.Equal(t, types.CodeSendDisabled, err.Code())
+	require.NoError(t, input.k.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5))))
+
+	inputs := []types.Input{types.NewInput(addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 2)))}
+	outputs := []types.Output{types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 2)))}
+	require.Error(t, input.k.InputOutputCoins(ctx, inputs, outputs))
+
+	// transfers from or to module accounts are allowed
+	require.NoError(t, input.k.SendCoins(ctx, addr, moduleAddr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.NoError(t, input.k.SendCoins(ctx, moduleAddr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.True(t, input.k.GetCoins(ctx, addr2).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5), sdk.NewInt64Coin("foocoin", 5))))
+
+	outputs = []types.Output{types.NewOutput(moduleAddr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 2)))}
+	require.NoError(t, input.k.InputOutputCoins(ctx, inputs, outputs))
+}
+
+func TestSendRestriction(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	frozen := sdk.AccAddress([]byte("frozen"))
+	sink := sdk.AccAddress([]byte("sink"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	input.k.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+
+	reject := types.SendRestrictionFn(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, sdk.Error) {
+		if fromAddr.Equals(frozen) || toAddr.Equals(frozen) {
+			return nil, sdk.ErrUnauthorized("account is frozen")
+		}
+		return toAddr, nil
+	})
+	redirect := types.SendRestrictionFn(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, sdk.Error) {
+		if toAddr.Equals(addr2) {
+			return sink, nil
+		}
+		return toAddr, nil
+	})
+
+	keeper := input.k.(BaseKeeper)
+	keeper.SetSendRestriction(redirect)
+	keeper.SetSendRestriction(reject)
+
+	// rejected transfers leave the balances untouched
+	err := keeper.SendCoins(ctx, addr, frozen, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5)))
+	require.Error(t, err)
+	require.Equal(t, sdk.CodeUnauthorized, err.Code())
+	require.True(t, keeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+
+	inputs := []types.Input{types.NewInput(addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 2)))}
+	outputs := []types.Output{types.NewOutput(frozen, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 2)))}
+	require.Error(t, keeper.InputOutputCoins(ctx, inputs, outputs))
+	require.True(t, keeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+
+	// redirected transfers are received by the returned address
+	require.NoError(t, keeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.True(t, keeper.GetCoins(ctx, addr2).IsZero())
+	require.True(t, keeper.GetCoins(ctx, sink).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+
+	outputs = []types.Output{types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 2)))}
+	require.NoError(t, keeper.InputOutputCoins(ctx, inputs, outputs))
+	require.True(t, keeper.GetCoins(ctx, addr2).IsZero())
+	require.True(t, keeper.GetCoins(ctx, sink).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 7))))
+	require.Equal(t, addr2, outputs[0].Address)
+
+	// transfers cannot be redirected to a blacklisted address
+	moduleAcc := sdk.AccAddress([]byte("moduleAcc"))
+	keeper.SetSendRestriction(types.SendRestrictionFn(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, sdk.Error) {
+		if toAddr.Equals(sink) {
+			return moduleAcc, nil
+		}
+		return toAddr, nil
+	}))
+	err = keeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1)))
+	require.Error(t, err)
+	require.Equal(t, sdk.CodeUnauthorized, err.Code())
+	require.True(t, keeper.GetCoins(ctx, moduleAcc).IsZero())
+
+	// but the coins can still be sent to the blacklisted address directly
+	require.NoError(t, keeper.SendCoins(ctx, addr, moduleAcc, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1))))
+}
+
 func TestViewKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
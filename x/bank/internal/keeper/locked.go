@@ -0,0 +1,337 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// LockedTransfersEnabled returns true if the keeper has a store for the locked
+// transfers, i.e. if it was created with NewBaseKeeperWithLockedTransfers
+func (keeper BaseKeeper) LockedTransfersEnabled() bool {
+	return keeper.storeKey != nil
+}
+
+// GetEscrowPoolAddress returns the address of the module account holding the
+// coins of the pending locked transfers
+func (keeper BaseKeeper) GetEscrowPoolAddress() sdk.AccAddress {
+	return supply.NewModuleAddress(types.EscrowPoolName)
+}
+
+// SendLockedCoins moves coins from the sender to the escrow pool and queues
+// their release to the recipient at releaseTime. It returns the id of the new
+// locked transfer.
+func (keeper BaseKeeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+	releaseTime time.Time, canceller sdk.AccAddress) (uint64, sdk.Error) {
+
+	if !keeper.LockedTransfersEnabled() {
+		return 0, types.ErrLockedTransfersDisabled(keeper.codespace)
+	}
+
+	if !releaseTime.After(ctx.BlockHeader().Time) {
+		return 0, types.ErrInvalidReleaseTime(keeper.codespace,
+			fmt.Sprintf("release time %s must be after the block time", releaseTime))
+	}
+
+	// the send enabled table and the restriction apply to the actual recipient
+	// rather than to the escrow pool the coins are held by
+	if !keeper.allModuleAccounts(ctx, []sdk.AccAddress{fromAddr}) && !keeper.allModuleAccounts(ctx, []sdk.AccAddress{toAddr}) {
+		if err := keeper.IsSendEnabledCoins(ctx, amt); err != nil {
+			return 0, err
+		}
+	}
+
+	toAddr, err := keeper.restrictSend(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return 0, err
+	}
+
+	err = keeper.sendCoins(ctx, fromAddr, keeper.getOrCreateEscrowPool(ctx), amt)
+	if err != nil {
+		return 0, err
+	}
+
+	id := keeper.GetNextLockedTransferID(ctx)
+	lockedTransfer := types.NewLockedTransfer(id, fromAddr, toAddr, amt, releaseTime, canceller)
+	keeper.SetLockedTransfer(ctx, lockedTransfer)
+	keeper.SetNextLockedTransferID(ctx, id+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSendLocked,
+			sdk.NewAttribute(types.AttributeKeyLockedTransferID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+			sdk.NewAttribute(types.AttributeKeyReleaseTime, releaseTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+		),
+	})
+
+	return id, nil
+}
+
+// CancelLockedTransfer cancels a pending locked transfer, returning its coins
+// to the sender. Only the canceller of the locked transfer may cancel it.
+func (keeper BaseKeeper) CancelLockedTransfer(ctx sdk.Context, canceller sdk.AccAddress, id uint64) sdk.Error {
+	lockedTransfer, found := keeper.GetLockedTransfer(ctx, id)
+	if !found {
+		return types.ErrUnknownLockedTransfer(keeper.codespace, id)
+	}
+
+	if lockedTransfer.Canceller.Empty() || !lockedTransfer.Canceller.Equals(canceller) {
+		return types.ErrInvalidCanceller(keeper.codespace, canceller, id)
+	}
+
+	if err := keeper.payOutLockedTransfer(ctx, lockedTransfer, lockedTransfer.Sender); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelLockedTransfer,
+			sdk.NewAttribute(types.AttributeKeyLockedTransferID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeySender, lockedTransfer.Sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, lockedTransfer.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ReleaseLockedTransfers sends the coins of the locked transfers whose release
+// time has passed to their recipients. A transfer which cannot be paid out,
+// e.g. because a send restriction rejects it, is refunded to its sender.
+func (keeper BaseKeeper) ReleaseLockedTransfers(ctx sdk.Context) {
+	// collect the matured transfers before releasing them, as releasing deletes
+	// them from the queue being iterated
+	var matured types.LockedTransfers
+	keeper.IterateLockedTransferQueue(ctx, ctx.BlockHeader().Time, func(lockedTransfer types.LockedTransfer) bool {
+		matured = append(matured, lockedTransfer)
+		return false
+	})
+
+	for _, lockedTransfer := range matured {
+		// discard the writes of a failed payout
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := keeper.payOutLockedTransfer(cacheCtx, lockedTransfer, lockedTransfer.Recipient); err != nil {
+			keeper.refundLockedTransfer(ctx, lockedTransfer, err)
+			continue
+		}
+		writeCache()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseLockedTransfer,
+				sdk.NewAttribute(types.AttributeKeyLockedTransferID, fmt.Sprintf("%d", lockedTransfer.ID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, lockedTransfer.Recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, lockedTransfer.Amount.String()),
+			),
+		)
+
+		keeper.Logger(ctx).Info(fmt.Sprintf("released locked transfer %d of %s to %s",
+			lockedTransfer.ID, lockedTransfer.Amount, lockedTransfer.Recipient))
+	}
+}
+
+// payOutLockedTransfer sends the coins of a locked transfer from the escrow
+// pool to the given address, subject to the blacklist and the send
+// restriction, and deletes the locked transfer
+func (keeper BaseKeeper) payOutLockedTransfer(ctx sdk.Context, lockedTransfer types.LockedTransfer, addr sdk.AccAddress) sdk.Error {
+	if keeper.BlacklistedAddr(addr) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", addr))
+	}
+
+	err := keeper.SendCoins(ctx, keeper.GetEscrowPoolAddress(), addr, lockedTransfer.Amount)
+	if err != nil {
+		return err
+	}
+
+	keeper.deleteLockedTransfer(ctx, lockedTransfer)
+	return nil
+}
+
+// refundLockedTransfer returns the coins of a locked transfer which could not
+// be paid out to its sender and deletes the locked transfer. The refund skips
+// the send restriction, as the coins are returned to where they came from.
+func (keeper BaseKeeper) refundLockedTransfer(ctx sdk.Context, lockedTransfer types.LockedTransfer, reason sdk.Error) {
+	err := keeper.sendCoins(ctx, keeper.GetEscrowPoolAddress(), lockedTransfer.Sender, lockedTransfer.Amount)
+	if err != nil {
+		// the escrow pool holds the coins of all the pending locked transfers
+		panic(fmt.Sprintf("failed to refund locked transfer %d: %s", lockedTransfer.ID, err))
+	}
+	keeper.deleteLockedTransfer(ctx, lockedTransfer)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundLockedTransfer,
+			sdk.NewAttribute(types.AttributeKeyLockedTransferID, fmt.Sprintf("%d", lockedTransfer.ID)),
+			sdk.NewAttribute(types.AttributeKeySender, lockedTransfer.Sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, lockedTransfer.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.ABCILog()),
+		),
+	)
+
+	keeper.Logger(ctx).Info(fmt.Sprintf("refunded locked transfer %d of %s to %s: %s",
+		lockedTransfer.ID, lockedTransfer.Amount, lockedTransfer.Sender, reason.ABCILog()))
+}
+
+// getOrCreateEscrowPool returns the address of the escrow pool, creating its
+// module account if it doesn't exist yet
+func (keeper BaseKeeper) getOrCreateEscrowPool(ctx sdk.Context) sdk.AccAddress {
+	addr := keeper.GetEscrowPoolAddress()
+	if keeper.ak.GetAccount(ctx, addr) == nil {
+		acc := keeper.ak.NewAccount(ctx, supply.NewEmptyModuleAccount(types.EscrowPoolName))
+		keeper.ak.SetAccount(ctx, acc)
+	}
+	return addr
+}
+
+// GetLockedTransfer gets a locked transfer from the store
+func (keeper BaseKeeper) GetLockedTransfer(ctx sdk.Context, id uint64) (lockedTransfer types.LockedTransfer, found bool) {
+	if !keeper.LockedTransfersEnabled() {
+		return lockedTransfer, false
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.LockedTransferKey(id))
+	if bz == nil {
+		return lockedTransfer, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &lockedTransfer)
+	return lockedTransfer, true
+}
+
+// SetLockedTransfer sets a locked transfer along with its queue and sender and
+// recipient index entries
+func (keeper BaseKeeper) SetLockedTransfer(ctx sdk.Context, lockedTransfer types.LockedTransfer) {
+	store := ctx.KVStore(keeper.storeKey)
+	id := lockedTransfer.ID
+	store.Set(types.LockedTransferKey(id), keeper.cdc.MustMarshalBinaryLengthPrefixed(lockedTransfer))
+	store.Set(types.LockedTransferQueueKey(id, lockedTransfer.ReleaseTime), types.GetLockedTransferIDBytes(id))
+	store.Set(types.LockedTransferBySenderKey(lockedTransfer.Sender, id), []byte{})
+	store.Set(types.LockedTransferByRecipientKey(lockedTransfer.Recipient, id), []byte{})
+}
+
+// deleteLockedTransfer deletes a locked transfer along with its queue and
+// sender and recipient index entries
+func (keeper BaseKeeper) deleteLockedTransfer(ctx sdk.Context, lockedTransfer types.LockedTransfer) {
+	store := ctx.KVStore(keeper.storeKey)
+	id := lockedTransfer.ID
+	store.Delete(types.LockedTransferKey(id))
+	store.Delete(types.LockedTransferQueueKey(id, lockedTransfer.ReleaseTime))
+	store.Delete(types.LockedTransferBySenderKey(lockedTransfer.Sender, id))
+	store.Delete(types.LockedTransferByRecipientKey(lockedTransfer.Recipient, id))
+}
+
+// IterateLockedTransfers iterates over all the pending locked transfers
+func (keeper BaseKeeper) IterateLockedTransfers(ctx sdk.Context, cb func(lockedTransfer types.LockedTransfer) (stop bool)) {
+	if !keeper.LockedTransfersEnabled() {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LockedTransfersKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lockedTransfer types.LockedTransfer
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &lockedTransfer)
+		if cb(lockedTransfer) {
+			break
+		}
+	}
+}
+
+// IterateLockedTransferQueue iterates over the locked transfers in the queue
+// that are released by releaseTime
+func (keeper BaseKeeper) IterateLockedTransferQueue(ctx sdk.Context, releaseTime time.Time,
+	cb func(lockedTransfer types.LockedTransfer) (stop bool)) {
+
+	if !keeper.LockedTransfersEnabled() {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.LockedTransferQueuePrefix,
+		sdk.PrefixEndBytes(types.LockedTransferByTimeKey(releaseTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id, _ := types.SplitLockedTransferQueueKey(iterator.Key())
+		lockedTransfer, found := keeper.GetLockedTransfer(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("locked transfer %d does not exist", id))
+		}
+		if cb(lockedTransfer) {
+			break
+		}
+	}
+}
+
+// GetAllLockedTransfers returns all the pending locked transfers
+func (keeper BaseKeeper) GetAllLockedTransfers(ctx sdk.Context) (lockedTransfers types.LockedTransfers) {
+	keeper.IterateLockedTransfers(ctx, func(lockedTransfer types.LockedTransfer) bool {
+		lockedTransfers = append(lockedTransfers, lockedTransfer)
+		return false
+	})
+	return
+}
+
+// GetLockedTransfers returns the pending locked transfers sent by sender and
+// to recipient. An empty address matches any sender or recipient.
+func (keeper BaseKeeper) GetLockedTransfers(ctx sdk.Context, sender, recipient sdk.AccAddress) types.LockedTransfers {
+	lockedTransfers := types.LockedTransfers{}
+
+	var prefix []byte
+	switch {
+	case !sender.Empty():
+		prefix = types.LockedTransfersBySenderKey(sender)
+	case !recipient.Empty():
+		prefix = types.LockedTransfersByRecipientKey(recipient)
+	default:
+		return append(lockedTransfers, keeper.GetAllLockedTransfers(ctx)...)
+	}
+	if !keeper.LockedTransfersEnabled() {
+		return lockedTransfers
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := types.SplitLockedTransferIndexKey(iterator.Key())
+		lockedTransfer, found := keeper.GetLockedTransfer(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("locked transfer %d does not exist", id))
+		}
+		if !recipient.Empty() && !lockedTransfer.Recipient.Equals(recipient) {
+			continue
+		}
+		lockedTransfers = append(lockedTransfers, lockedTransfer)
+	}
+
+	return lockedTransfers
+}
+
+// GetNextLockedTransferID gets the id of the next locked transfer
+func (keeper BaseKeeper) GetNextLockedTransferID(ctx sdk.Context) uint64 {
+	if !keeper.LockedTransfersEnabled() {
+		return 1
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.LockedTransferIDKey)
+	if bz == nil {
+		return 1
+	}
+	return types.GetLockedTransferIDFromBytes(bz)
+}
+
+// SetNextLockedTransferID sets the id of the next locked transfer
+func (keeper BaseKeeper) SetNextLockedTransferID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.LockedTransferIDKey, types.GetLockedTransferIDBytes(id))
+}
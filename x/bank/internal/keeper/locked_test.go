@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+func TestSendLockedCoins(t *testing.T) {
+	input := setupTestInput()
+	now := time.Now().UTC()
+	ctx := input.ctx.WithBlockTime(now)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	input.k.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 4))
+
+	// the release time must be in the future
+	_, err := input.k.SendLockedCoins(ctx, addr, addr2, coins, now, nil)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidReleaseTime, err.Code())
+
+	// the sender must hold the coins
+	_, err = input.k.SendLockedCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 11)), now.Add(time.Hour), nil)
+	require.Error(t, err)
+
+	id, err := input.k.SendLockedCoins(ctx, addr, addr2, coins, now.Add(time.Hour), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, uint64(2), input.k.GetNextLockedTransferID(ctx))
+
+	require.True(t, input.k.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 6))))
+	require.True(t, input.k.GetCoins(ctx, addr2).IsZero())
+	require.True(t, input.k.GetCoins(ctx, input.k.GetEscrowPoolAddress()).IsEqual(coins))
+
+	lockedTransfer, found := input.k.GetLockedTransfer(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.NewLockedTransfer(id, addr, addr2, coins, now.Add(time.Hour), nil), lockedTransfer)
+
+	_, broken := EscrowPoolInvariant(input.k, input.ak)(ctx)
+	require.False(t, broken)
+
+	// nothing is released before the release time
+	input.k.ReleaseLockedTransfers(ctx.WithBlockTime(now.Add(time.Minute)))
+	require.True(t, input.k.GetCoins(ctx, addr2).IsZero())
+
+	input.k.ReleaseLockedTransfers(ctx.WithBlockTime(now.Add(time.Hour)))
+	require.True(t, input.k.GetCoins(ctx, addr2).IsEqual(coins))
+	require.True(t, input.k.GetCoins(ctx, input.k.GetEscrowPoolAddress()).IsZero())
+	_, found = input.k.GetLockedTransfer(ctx, id)
+	require.False(t, found)
+	require.Empty(t, input.k.GetLockedTransfers(ctx, addr, nil))
+}
+
+func TestCancelLockedTransfer(t *testing.T) {
+	input := setupTestInput()
+	now := time.Now().UTC()
+	ctx := input.ctx.WithBlockTime(now)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	canceller := sdk.AccAddress([]byte("canceller"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	input.k.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 4))
+
+	id, err := input.k.SendLockedCoins(ctx, addr, addr2, coins, now.Add(time.Hour), nil)
+	require.NoError(t, err)
+	id2, err := input.k.SendLockedCoins(ctx, addr, addr2, coins, now.Add(time.Hour), canceller)
+	require.NoError(t, err)
+
+	// transfers without a canceller cannot be cancelled
+	err = input.k.CancelLockedTransfer(ctx, canceller, id)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidCanceller, err.Code())
+
+	err = input.k.CancelLockedTransfer(ctx, addr, id2)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidCanceller, err.Code())
+
+	err = input.k.CancelLockedTransfer(ctx, canceller, 3)
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnknownLockedTransfer, err.Code())
+
+	require.NoError(t, input.k.CancelLockedTransfer(ctx, canceller, id2))
+	require.True(t, input.k.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 6))))
+	require.True(t, input.k.GetCoins(ctx, input.k.GetEscrowPoolAddress()).IsEqual(coins))
+
+	_, broken := EscrowPoolInvariant(input.k, input.ak)(ctx)
+	require.False(t, broken)
+
+	// the cancelled transfer is not released
+	input.k.ReleaseLockedTransfers(ctx.WithBlockTime(now.Add(time.Hour)))
+	require.True(t, input.k.GetCoins(ctx, addr2).IsEqual(coins))
+}
+
+func TestReleaseLockedTransferRefunded(t *testing.T) {
+	input := setupTestInput()
+	now := time.Now().UTC()
+	ctx := input.ctx.WithBlockTime(now)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	input.k.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 4))
+
+	// the restriction is only run on the recipient of the locked transfer,
+	// not on the escrow pool, and rejects every payout to it
+	var checked []sdk.AccAddress
+	frozen := false
+	keeper := input.k.(BaseKeeper)
+	keeper.SetSendRestriction(types.SendRestrictionFn(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, sdk.Error) {
+		checked = append(checked, toAddr)
+		if frozen && toAddr.Equals(addr2) {
+			return nil, sdk.ErrUnauthorized("account is frozen")
+		}
+		return toAddr, nil
+	}))
+
+	id, err := keeper.SendLockedCoins(ctx, addr, addr2, coins, now.Add(time.Hour), nil)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{addr2}, checked)
+	require.True(t, keeper.GetCoins(ctx, keeper.GetEscrowPoolAddress()).IsEqual(coins))
+
+	// the failed payout is refunded to the sender and the transfer deleted
+	frozen = true
+	releaseCtx := ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	keeper.ReleaseLockedTransfers(releaseCtx)
+	require.True(t, keeper.GetCoins(ctx, addr2).IsZero())
+	require.True(t, keeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+	require.True(t, keeper.GetCoins(ctx, keeper.GetEscrowPoolAddress()).IsZero())
+	_, found := keeper.GetLockedTransfer(ctx, id)
+	require.False(t, found)
+	require.Empty(t, keeper.GetLockedTransfers(ctx, addr, nil))
+
+	events := releaseCtx.EventManager().Events()
+	require.NotEmpty(t, events)
+	refund := events[len(events)-1]
+	require.Equal(t, types.EventTypeRefundLockedTransfer, refund.Type)
+	require.Equal(t, fmt.Sprintf("%d", id), string(refund.Attributes[0].Value))
+	require.Equal(t, addr.String(), string(refund.Attributes[1].Value))
+
+	_, broken := EscrowPoolInvariant(keeper, input.ak)(ctx)
+	require.False(t, broken)
+
+	// nothing is left to release
+	keeper.ReleaseLockedTransfers(ctx.WithBlockTime(now.Add(2 * time.Hour)))
+	require.True(t, keeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+}
+
+func TestLockedTransfersDisabled(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	input.k.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+
+	keeper := NewBaseKeeper(input.ak, input.pk.Subspace("bankdisabled"), types.DefaultCodespace, nil)
+	require.False(t, keeper.LockedTransfersEnabled())
+
+	_, err := keeper.SendLockedCoins(ctx, addr, sdk.AccAddress([]byte("addr2")),
+		sdk.NewCoins(sdk.NewInt64Coin("foocoin", 4)), ctx.BlockHeader().Time.Add(time.Hour), nil)
+	require.Error(t, err)
+	require.Equal(t, types.CodeLockedTransfersDisabled, err.Code())
+	require.True(t, keeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+
+	require.Empty(t, keeper.GetAllLockedTransfers(ctx))
+	require.Equal(t, uint64(1), keeper.GetNextLockedTransferID(ctx))
+	require.NotPanics(t, func() { keeper.ReleaseLockedTransfers(ctx) })
+}
+
+func TestGetLockedTransfers(t *testing.T) {
+	input := setupTestInput()
+	now := time.Now().UTC()
+	ctx := input.ctx.WithBlockTime(now)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr2))
+	input.k.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	input.k.SetCoins(ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1))
+
+	id1, err := input.k.SendLockedCoins(ctx, addr, addr2, coins, now.Add(time.Hour), nil)
+	require.NoError(t, err)
+	id2, err := input.k.SendLockedCoins(ctx, addr, addr3, coins, now.Add(time.Hour), nil)
+	require.NoError(t, err)
+	id3, err := input.k.SendLockedCoins(ctx, addr2, addr3, coins, now.Add(time.Hour), nil)
+	require.NoError(t, err)
+
+	ids := func(lockedTransfers types.LockedTransfers) (ids []uint64) {
+		for _, lockedTransfer := range lockedTransfers {
+			ids = append(ids, lockedTransfer.ID)
+		}
+		return
+	}
+
+	require.Equal(t, []uint64{id1, id2, id3}, ids(input.k.GetLockedTransfers(ctx, nil, nil)))
+	require.Equal(t, []uint64{id1, id2}, ids(input.k.GetLockedTransfers(ctx, addr, nil)))
+	require.Equal(t, []uint64{id2, id3}, ids(input.k.GetLockedTransfers(ctx, nil, addr3)))
+	require.Equal(t, []uint64{id2}, ids(input.k.GetLockedTransfers(ctx, addr, addr3)))
+	require.Empty(t, input.k.GetLockedTransfers(ctx, addr3, nil))
+}
@@ -13,6 +13,10 @@ import (
 const (
 	// query balance path
 	QueryBalance = "balances"
+
+	// query locked transfers paths
+	QueryLockedTransfer  = "locked_transfer"
+	QueryLockedTransfers = "locked_transfers"
 )
 
 // NewQuerier returns a new sdk.Keeper instance.
@@ -22,6 +26,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryBalance:
 			return queryBalance(ctx, req, k)
 
+		case QueryLockedTransfer:
+			return queryLockedTransfer(ctx, req, k)
+
+		case QueryLockedTransfers:
+			return queryLockedTransfers(ctx, req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown bank query endpoint")
 		}
@@ -44,3 +54,41 @@ func queryBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk
 
 	return bz, nil
 }
+
+// queryLockedTransfer fetches a pending locked transfer by id.
+func queryLockedTransfer(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryLockedTransferParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	lockedTransfer, found := k.GetLockedTransfer(ctx, params.LockedTransferID)
+	if !found {
+		return nil, types.ErrUnknownLockedTransfer(types.DefaultCodespace, params.LockedTransferID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, lockedTransfer)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryLockedTransfers fetches the pending locked transfers, optionally
+// filtered by sender and recipient.
+func queryLockedTransfers(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryLockedTransfersParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetLockedTransfers(ctx, params.Sender, params.Recipient))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -46,6 +47,55 @@ func TestBalances(t *testing.T) {
 	require.True(t, coins.AmountOf("foo").Equal(sdk.NewInt(10)))
 }
 
+func TestLockedTransfers(t *testing.T) {
+	input := setupTestInput()
+	now := time.Now().UTC()
+	ctx := input.ctx.WithBlockTime(now)
+	querier := NewQuerier(input.k)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, addr2 := authtypes.KeyTestPubAddr()
+	acc := input.ak.NewAccountWithAddress(ctx, addr)
+	acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))
+	input.ak.SetAccount(ctx, acc)
+
+	id, err := input.k.SendLockedCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foo", 5)), now.Add(time.Hour), nil)
+	require.NoError(t, err)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", QueryLockedTransfer),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryLockedTransferParams(id + 1)),
+	}
+	_, err = querier(ctx, []string{QueryLockedTransfer}, req)
+	require.Error(t, err)
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryLockedTransferParams(id))
+	res, err := querier(ctx, []string{QueryLockedTransfer}, req)
+	require.NoError(t, err)
+
+	var lockedTransfer types.LockedTransfer
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &lockedTransfer))
+	require.Equal(t, id, lockedTransfer.ID)
+	require.Equal(t, addr2, lockedTransfer.Recipient)
+
+	req = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", QueryLockedTransfers),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryLockedTransfersParams(nil, addr2)),
+	}
+	res, err = querier(ctx, []string{QueryLockedTransfers}, req)
+	require.NoError(t, err)
+
+	var lockedTransfers types.LockedTransfers
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &lockedTransfers))
+	require.Len(t, lockedTransfers, 1)
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryLockedTransfersParams(addr2, nil))
+	res, err = querier(ctx, []string{QueryLockedTransfers}, req)
+	require.NoError(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &lockedTransfers))
+	require.Empty(t, lockedTransfers)
+}
+
 func TestQuerierRouteNotFound(t *testing.T) {
 	input := setupTestInput()
 	req := abci.RequestQuery{
@@ -8,6 +8,8 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(MsgSendLocked{}, "cosmos-sdk/MsgSendLocked", nil)
+	cdc.RegisterConcrete(MsgCancelLockedTransfer{}, "cosmos-sdk/MsgCancelLockedTransfer", nil)
 }
 
 // module codec
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,8 +10,12 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = ModuleName
 
-	CodeSendDisabled         sdk.CodeType = 101
-	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeSendDisabled            sdk.CodeType = 101
+	CodeInvalidInputsOutputs    sdk.CodeType = 102
+	CodeInvalidReleaseTime      sdk.CodeType = 103
+	CodeUnknownLockedTransfer   sdk.CodeType = 104
+	CodeInvalidCanceller        sdk.CodeType = 105
+	CodeLockedTransfersDisabled sdk.CodeType = 106
 )
 
 // ErrNoInputs is an error
@@ -31,3 +37,28 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrSendDisabledDenom is an error
+func ErrSendDisabledDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeSendDisabled, fmt.Sprintf("%s transfers are currently disabled", denom))
+}
+
+// ErrInvalidReleaseTime is an error
+func ErrInvalidReleaseTime(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidReleaseTime, msg)
+}
+
+// ErrUnknownLockedTransfer is an error
+func ErrUnknownLockedTransfer(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownLockedTransfer, fmt.Sprintf("unknown locked transfer %d", id))
+}
+
+// ErrInvalidCanceller is an error
+func ErrInvalidCanceller(codespace sdk.CodespaceType, canceller sdk.AccAddress, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCanceller, fmt.Sprintf("%s cannot cancel locked transfer %d", canceller, id))
+}
+
+// ErrLockedTransfersDisabled is an error
+func ErrLockedTransfersDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeLockedTransfersDisabled, "locked transfers are not enabled")
+}
@@ -2,10 +2,17 @@ package types
 
 // Bank module event types
 var (
-	EventTypeTransfer = "transfer"
+	EventTypeTransfer              = "transfer"
+	EventTypeSendLocked            = "send_locked"
+	EventTypeCancelLockedTransfer  = "cancel_locked_transfer"
+	EventTypeReleaseLockedTransfer = "release_locked_transfer"
+	EventTypeRefundLockedTransfer  = "refund_locked_transfer"
 
-	AttributeKeyRecipient = "recipient"
-	AttributeKeySender    = "sender"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeySender           = "sender"
+	AttributeKeyLockedTransferID = "locked_transfer_id"
+	AttributeKeyReleaseTime      = "release_time"
+	AttributeKeyReason           = "reason"
 
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestriction is a hook that inspects every transfer of coins between
+// accounts. It returns the address the coins are to be sent to, which may
+// differ from the given recipient to redirect the transfer, or an error to
+// reject it.
+type SendRestriction interface {
+	RestrictSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, sdk.Error)
+}
+
+// SendRestrictionFn is a function implementing the SendRestriction interface
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, sdk.Error)
+
+var _ SendRestriction = SendRestrictionFn(nil)
+
+// RestrictSend implements SendRestriction
+func (fn SendRestrictionFn) RestrictSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, sdk.Error) {
+	return fn(ctx, fromAddr, toAddr, amt)
+}
+
+// MultiSendRestrictions combines multiple send restrictions. They are run in
+// array sequence, each one receiving the recipient returned by the previous
+// one, and the first error rejects the transfer.
+type MultiSendRestrictions []SendRestriction
+
+// NewMultiSendRestrictions creates a new MultiSendRestrictions instance
+func NewMultiSendRestrictions(restrictions ...SendRestriction) MultiSendRestrictions {
+	return restrictions
+}
+
+// RestrictSend implements SendRestriction
+func (m MultiSendRestrictions) RestrictSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, sdk.Error) {
+	for i := range m {
+		var err sdk.Error
+		toAddr, err = m[i].RestrictSend(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return toAddr, nil
+}
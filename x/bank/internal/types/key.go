@@ -1,7 +1,119 @@
 package types
 
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// module name
 	ModuleName   = "bank"
 	QuerierRoute = ModuleName
+
+	// StoreKey is the store key string for bank
+	StoreKey = ModuleName
+
+	// EscrowPoolName is the name of the module account holding the coins of
+	// the pending locked transfers
+	EscrowPoolName = "bank_escrow_pool"
 )
+
+// Keys for bank store
+// Items are stored with the following key: values
+//
+// - 0x00<lockedTransferID_Bytes>: LockedTransfer
+//
+// - 0x01<releaseTime_Bytes><lockedTransferID_Bytes>: lockedTransferID
+//
+// - 0x02<senderAddr_Bytes><lockedTransferID_Bytes>: []byte{}
+//
+// - 0x03<recipientAddr_Bytes><lockedTransferID_Bytes>: []byte{}
+//
+// - 0x04: nextLockedTransferID
+var (
+	LockedTransfersKeyPrefix         = []byte{0x00}
+	LockedTransferQueuePrefix        = []byte{0x01}
+	LockedTransfersBySenderPrefix    = []byte{0x02}
+	LockedTransfersByRecipientPrefix = []byte{0x03}
+	LockedTransferIDKey              = []byte{0x04}
+)
+
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
+// GetLockedTransferIDBytes returns the byte representation of the lockedTransferID
+func GetLockedTransferIDBytes(id uint64) (idBz []byte) {
+	idBz = make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+	return
+}
+
+// GetLockedTransferIDFromBytes returns lockedTransferID in uint64 format from a byte array
+func GetLockedTransferIDFromBytes(bz []byte) (id uint64) {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// LockedTransferKey gets a specific locked transfer from the store
+func LockedTransferKey(id uint64) []byte {
+	return append(LockedTransfersKeyPrefix, GetLockedTransferIDBytes(id)...)
+}
+
+// LockedTransferByTimeKey gets the locked transfer queue key by release time
+func LockedTransferByTimeKey(releaseTime time.Time) []byte {
+	return append(LockedTransferQueuePrefix, sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// LockedTransferQueueKey returns the key for a lockedTransferID in the locked transfer queue
+func LockedTransferQueueKey(id uint64, releaseTime time.Time) []byte {
+	return append(LockedTransferByTimeKey(releaseTime), GetLockedTransferIDBytes(id)...)
+}
+
+// LockedTransfersBySenderKey gets the prefix of the locked transfers sent by an address
+func LockedTransfersBySenderKey(sender sdk.AccAddress) []byte {
+	return append(LockedTransfersBySenderPrefix, sender.Bytes()...)
+}
+
+// LockedTransferBySenderKey gets the sender index key of a locked transfer
+func LockedTransferBySenderKey(sender sdk.AccAddress, id uint64) []byte {
+	return append(LockedTransfersBySenderKey(sender), GetLockedTransferIDBytes(id)...)
+}
+
+// LockedTransfersByRecipientKey gets the prefix of the locked transfers sent to an address
+func LockedTransfersByRecipientKey(recipient sdk.AccAddress) []byte {
+	return append(LockedTransfersByRecipientPrefix, recipient.Bytes()...)
+}
+
+// LockedTransferByRecipientKey gets the recipient index key of a locked transfer
+func LockedTransferByRecipientKey(recipient sdk.AccAddress, id uint64) []byte {
+	return append(LockedTransfersByRecipientKey(recipient), GetLockedTransferIDBytes(id)...)
+}
+
+// SplitLockedTransferQueueKey split the locked transfer queue key and returns
+// the locked transfer id and release time
+func SplitLockedTransferQueueKey(key []byte) (id uint64, releaseTime time.Time) {
+	if len(key[1:]) != 8+lenTime {
+		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key[1:]), lenTime+8))
+	}
+
+	releaseTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	id = GetLockedTransferIDFromBytes(key[1+lenTime:])
+	return
+}
+
+// SplitLockedTransferIndexKey split a sender or recipient index key and
+// returns the address and the locked transfer id
+func SplitLockedTransferIndexKey(key []byte) (addr sdk.AccAddress, id uint64) {
+	if len(key[1:]) <= 8 {
+		panic(fmt.Sprintf("unexpected key length (%d ≤ 8)", len(key[1:])))
+	}
+
+	addr = sdk.AccAddress(key[1 : len(key)-8])
+	id = GetLockedTransferIDFromBytes(key[len(key)-8:])
+	return
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LockedTransfer defines a transfer of coins held in escrow until its release
+// time, when they are sent to the recipient. A locked transfer with a
+// canceller can be cancelled by it before the release time, returning the
+// coins to the sender.
+type LockedTransfer struct {
+	ID          uint64         `json:"id" yaml:"id"`
+	Sender      sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	ReleaseTime time.Time      `json:"release_time" yaml:"release_time"`
+	Canceller   sdk.AccAddress `json:"canceller" yaml:"canceller"`
+}
+
+// NewLockedTransfer creates a new LockedTransfer instance
+func NewLockedTransfer(id uint64, sender, recipient sdk.AccAddress, amount sdk.Coins,
+	releaseTime time.Time, canceller sdk.AccAddress) LockedTransfer {
+
+	return LockedTransfer{
+		ID:          id,
+		Sender:      sender,
+		Recipient:   recipient,
+		Amount:      amount,
+		ReleaseTime: releaseTime,
+		Canceller:   canceller,
+	}
+}
+
+// Validate performs a basic validation of the locked transfer fields
+func (lt LockedTransfer) Validate() error {
+	if lt.Sender.Empty() {
+		return fmt.Errorf("locked transfer %d: missing sender address", lt.ID)
+	}
+	if lt.Recipient.Empty() {
+		return fmt.Errorf("locked transfer %d: missing recipient address", lt.ID)
+	}
+	if !lt.Amount.IsValid() || !lt.Amount.IsAllPositive() {
+		return fmt.Errorf("locked transfer %d: invalid amount %s", lt.ID, lt.Amount)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (lt LockedTransfer) String() string {
+	return fmt.Sprintf(`Locked Transfer %d:
+  Sender:       %s
+  Recipient:    %s
+  Amount:       %s
+  Release Time: %s
+  Canceller:    %s`,
+		lt.ID, lt.Sender, lt.Recipient, lt.Amount, lt.ReleaseTime, lt.Canceller,
+	)
+}
+
+// LockedTransfers is an array of locked transfers
+type LockedTransfers []LockedTransfer
+
+// String implements the Stringer interface
+func (lts LockedTransfers) String() string {
+	if len(lts) == 0 {
+		return "[]"
+	}
+	out := make([]string, len(lts))
+	for i, lt := range lts {
+		out[i] = lt.String()
+	}
+	return strings.Join(out, "\n")
+}
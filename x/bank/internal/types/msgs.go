@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -101,6 +103,105 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// MsgSendLocked - transaction sending coins that are held in escrow until a
+// release time
+type MsgSendLocked struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	ReleaseTime time.Time      `json:"release_time" yaml:"release_time"`
+	Canceller   sdk.AccAddress `json:"canceller" yaml:"canceller"` // optional address allowed to cancel the transfer
+}
+
+var _ sdk.Msg = MsgSendLocked{}
+
+// NewMsgSendLocked - construct a locked send msg.
+func NewMsgSendLocked(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins,
+	releaseTime time.Time, canceller sdk.AccAddress) MsgSendLocked {
+
+	return MsgSendLocked{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		ReleaseTime: releaseTime,
+		Canceller:   canceller,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSendLocked) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSendLocked) Type() string { return "send_locked" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSendLocked) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("send amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("send amount must be positive")
+	}
+	if msg.ReleaseTime.IsZero() {
+		return ErrInvalidReleaseTime(DefaultCodespace, "missing release time")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSendLocked) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSendLocked) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCancelLockedTransfer - transaction cancelling a pending locked transfer,
+// returning its coins to the sender
+type MsgCancelLockedTransfer struct {
+	Canceller        sdk.AccAddress `json:"canceller" yaml:"canceller"`
+	LockedTransferID uint64         `json:"locked_transfer_id" yaml:"locked_transfer_id"`
+}
+
+var _ sdk.Msg = MsgCancelLockedTransfer{}
+
+// NewMsgCancelLockedTransfer - construct a locked transfer cancellation msg.
+func NewMsgCancelLockedTransfer(canceller sdk.AccAddress, id uint64) MsgCancelLockedTransfer {
+	return MsgCancelLockedTransfer{Canceller: canceller, LockedTransferID: id}
+}
+
+// Route Implements Msg.
+func (msg MsgCancelLockedTransfer) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCancelLockedTransfer) Type() string { return "cancel_locked_transfer" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelLockedTransfer) ValidateBasic() sdk.Error {
+	if msg.Canceller.Empty() {
+		return sdk.ErrInvalidAddress("missing canceller address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelLockedTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelLockedTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Canceller}
+}
+
 // Input models transaction input
 type Input struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
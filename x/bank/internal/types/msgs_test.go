@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -260,3 +261,41 @@ func TestMsgSendSigners(t *testing.T) {
 	require.Equal(t, signers, tx.Signers())
 }
 */
+
+func TestMsgSendLockedValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+	releaseTime := time.Now().UTC()
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		tx    MsgSendLocked
+	}{
+		{true, NewMsgSendLocked(addr1, addr2, atom123, releaseTime, nil)},      // valid send
+		{true, NewMsgSendLocked(addr1, addr2, atom123, releaseTime, addr1)},    // valid send with canceller
+		{false, NewMsgSendLocked(addr1, addr2, atom0, releaseTime, nil)},       // non positive coin
+		{false, NewMsgSendLocked(emptyAddr, addr2, atom123, releaseTime, nil)}, // empty from addr
+		{false, NewMsgSendLocked(addr1, emptyAddr, atom123, releaseTime, nil)}, // empty to addr
+		{false, NewMsgSendLocked(addr1, addr2, atom123, time.Time{}, nil)},     // missing release time
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+
+	require.Equal(t, []sdk.AccAddress{addr1}, NewMsgSendLocked(addr1, addr2, atom123, releaseTime, addr2).GetSigners())
+}
+
+func TestMsgCancelLockedTransferValidation(t *testing.T) {
+	require.Nil(t, NewMsgCancelLockedTransfer(sdk.AccAddress([]byte("canceller")), 1).ValidateBasic())
+	require.NotNil(t, NewMsgCancelLockedTransfer(nil, 1).ValidateBasic())
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -14,9 +17,62 @@ const (
 // ParamStoreKeySendEnabled is store's key for SendEnabled
 var ParamStoreKeySendEnabled = []byte("sendenabled")
 
+// ParamStoreKeySendEnabledDenoms is store's key for SendEnabledDenoms
+var ParamStoreKeySendEnabledDenoms = []byte("sendenableddenoms")
+
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeySendEnabled, false,
+		params.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
+		params.NewParamSetPair(ParamStoreKeySendEnabledDenoms, []SendEnabled{}, validateSendEnabledDenoms),
 	)
 }
+
+// SendEnabled overrides SendEnabled for the coins of a single denom
+type SendEnabled struct {
+	Denom   string `json:"denom" yaml:"denom"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+// NewSendEnabled creates a new SendEnabled object
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// String implements fmt.Stringer
+func (se SendEnabled) String() string {
+	return fmt.Sprintf("%s: %t", se.Denom, se.Enabled)
+}
+
+// ValidateSendEnabledDenoms validates a per-denom send enabled table
+func ValidateSendEnabledDenoms(sendEnabledDenoms []SendEnabled) error {
+	seen := make(map[string]bool)
+	for _, se := range sendEnabledDenoms {
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return err
+		}
+		if seen[se.Denom] {
+			return fmt.Errorf("duplicate send enabled entry for denom %s", se.Denom)
+		}
+		seen[se.Denom] = true
+	}
+	return nil
+}
+
+func validateSendEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSendEnabledDenoms(i interface{}) error {
+	v, ok := i.([]SendEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateSendEnabledDenoms(v)
+}
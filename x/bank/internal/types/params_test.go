@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSendEnabledDenoms(t *testing.T) {
+	tests := []struct {
+		name              string
+		sendEnabledDenoms []SendEnabled
+		expectPass        bool
+	}{
+		{"empty", []SendEnabled{}, true},
+		{"valid", []SendEnabled{NewSendEnabled("stake", true), NewSendEnabled("foocoin", false)}, true},
+		{"invalid denom", []SendEnabled{NewSendEnabled("FOO", true)}, false},
+		{"duplicate denom", []SendEnabled{NewSendEnabled("stake", true), NewSendEnabled("stake", false)}, false},
+	}
+
+	for _, tc := range tests {
+		err := ValidateSendEnabledDenoms(tc.sendEnabledDenoms)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
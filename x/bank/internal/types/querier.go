@@ -13,3 +13,25 @@ type QueryBalanceParams struct {
 func NewQueryBalanceParams(addr sdk.AccAddress) QueryBalanceParams {
 	return QueryBalanceParams{Address: addr}
 }
+
+// QueryLockedTransferParams defines the params for querying a locked transfer.
+type QueryLockedTransferParams struct {
+	LockedTransferID uint64
+}
+
+// NewQueryLockedTransferParams creates a new instance of QueryLockedTransferParams.
+func NewQueryLockedTransferParams(id uint64) QueryLockedTransferParams {
+	return QueryLockedTransferParams{LockedTransferID: id}
+}
+
+// QueryLockedTransfersParams defines the params for querying the pending
+// locked transfers, optionally filtered by sender and recipient.
+type QueryLockedTransfersParams struct {
+	Sender    sdk.AccAddress
+	Recipient sdk.AccAddress
+}
+
+// NewQueryLockedTransfersParams creates a new instance of QueryLockedTransfersParams.
+func NewQueryLockedTransfersParams(sender, recipient sdk.AccAddress) QueryLockedTransfersParams {
+	return QueryLockedTransfersParams{Sender: sender, Recipient: recipient}
+}
@@ -0,0 +1,35 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding bank type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.LockedTransfersKeyPrefix):
+		var lockedTransferA, lockedTransferB types.LockedTransfer
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &lockedTransferA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &lockedTransferB)
+		return fmt.Sprintf("%v\n%v", lockedTransferA, lockedTransferB)
+
+	case bytes.Equal(kvA.Key[:1], types.LockedTransferQueuePrefix),
+		bytes.Equal(kvA.Key[:1], types.LockedTransferIDKey):
+		idA := types.GetLockedTransferIDFromBytes(kvA.Value)
+		idB := types.GetLockedTransferIDFromBytes(kvB.Value)
+		return fmt.Sprintf("%d\n%d", idA, idB)
+
+	case bytes.Equal(kvA.Key[:1], types.LockedTransfersBySenderPrefix),
+		bytes.Equal(kvA.Key[:1], types.LockedTransfersByRecipientPrefix):
+		return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+	default:
+		panic(fmt.Sprintf("invalid bank key prefix %X", kvA.Key[:1]))
+	}
+}
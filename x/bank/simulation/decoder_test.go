@@ -0,0 +1,62 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+var (
+	senderAddr    = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipientAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	return
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := makeTestCodec()
+	releaseTime := time.Now().UTC()
+	lockedTransfer := types.NewLockedTransfer(1, senderAddr, recipientAddr,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), releaseTime, nil)
+
+	kvPairs := cmn.KVPairs{
+		cmn.KVPair{Key: types.LockedTransferKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(lockedTransfer)},
+		cmn.KVPair{Key: types.LockedTransferQueueKey(1, releaseTime), Value: types.GetLockedTransferIDBytes(1)},
+		cmn.KVPair{Key: types.LockedTransferIDKey, Value: types.GetLockedTransferIDBytes(2)},
+		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"LockedTransfer", fmt.Sprintf("%v\n%v", lockedTransfer, lockedTransfer)},
+		{"LockedTransferQueue", "1\n1"},
+		{"NextLockedTransferID", "2\n2"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package operations
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -22,6 +23,9 @@ func SimulateMsgSend(mapper types.AccountKeeper, bk bank.Keeper) simulation.Oper
 		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
 
 		fromAcc, comment, msg, ok := createMsgSend(r, ctx, accs, mapper)
+		if ok && bk.IsSendEnabledCoins(ctx, msg.Amount) != nil {
+			comment, ok = "skipping, send disabled for "+msg.Amount.String(), false
+		}
 		opMsg = simulation.NewOperationMsg(msg, ok, comment)
 		if !ok {
 			return opMsg, nil, nil
@@ -116,6 +120,9 @@ func SimulateSingleInputMsgMultiSend(mapper types.AccountKeeper, bk bank.Keeper)
 		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
 
 		fromAcc, comment, msg, ok := createSingleInputMsgMultiSend(r, ctx, accs, mapper)
+		if ok && bk.IsSendEnabledCoins(ctx, msg.Inputs[0].Coins) != nil {
+			comment, ok = "skipping, send disabled for "+msg.Inputs[0].Coins.String(), false
+		}
 		opMsg = simulation.NewOperationMsg(msg, ok, comment)
 		if !ok {
 			return opMsg, nil, nil
@@ -216,3 +223,75 @@ func sendAndVerifyMsgMultiSend(app *baseapp.BaseApp, mapper types.AccountKeeper,
 	}
 	return nil
 }
+
+// SimulateMsgSendLocked tests and runs a single msg send locked where both
+// accounts already exist.
+func SimulateMsgSendLocked(mapper types.AccountKeeper, bk bank.Keeper) simulation.Operation {
+	handler := bank.NewHandler(bk)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		_, comment, sendMsg, ok := createMsgSend(r, ctx, accs, mapper)
+		if !ok {
+			return simulation.NewOperationMsg(sendMsg, ok, comment), nil, nil
+		}
+
+		releaseTime := ctx.BlockHeader().Time.Add(time.Duration(simulation.RandIntBetween(r, 1, 3600)) * time.Second)
+
+		var canceller sdk.AccAddress
+		if r.Intn(2) == 0 {
+			canceller = simulation.RandomAcc(r, accs).Address
+		}
+
+		msg := types.NewMsgSendLocked(sendMsg.FromAddress, sendMsg.ToAddress, sendMsg.Amount, releaseTime, canceller)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		res := handler(ctx, msg)
+		if !res.IsOK() && res.Code != types.CodeSendDisabled {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("handling msg failed %v", res)
+		}
+		if res.IsOK() {
+			write()
+		}
+
+		return simulation.NewOperationMsg(msg, res.IsOK(), ""), nil, nil
+	}
+}
+
+// SimulateMsgCancelLockedTransfer tests and runs a single msg cancel locked
+// transfer on a random pending locked transfer with a canceller.
+func SimulateMsgCancelLockedTransfer(bk bank.Keeper) simulation.Operation {
+	handler := bank.NewHandler(bk)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		var cancellable types.LockedTransfers
+		bk.IterateLockedTransfers(ctx, func(lockedTransfer types.LockedTransfer) bool {
+			if !lockedTransfer.Canceller.Empty() {
+				cancellable = append(cancellable, lockedTransfer)
+			}
+			return false
+		})
+		if len(cancellable) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		lockedTransfer := cancellable[r.Intn(len(cancellable))]
+		msg := types.NewMsgCancelLockedTransfer(lockedTransfer.Canceller, lockedTransfer.ID)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("handling msg failed %v", res)
+		}
+		write()
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
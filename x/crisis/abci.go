@@ -4,11 +4,39 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// halt the chain if invariants were found broken under the halt policy, and
+// run the incremental invariant checks
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	report, found := k.GetHaltReport(ctx)
+	if found {
+		if haltHandler := k.HaltHandler(); haltHandler != nil {
+			haltHandler(ctx, report)
+		}
+
+		// the halt handler is expected to stop the node, make sure the chain
+		// does not progress if it returns
+		panic(report.String())
+	}
+
+	// The written keys are recorded when the block state is committed. The
+	// incremental checks are thus run at the beginning of the block following
+	// the check height, on the state committed at the check height, so that
+	// the keys written in that block are part of the write set.
+	if k.CheckMode() == CheckModeIncremental && ctx.BlockHeight() > 1 && isCheckHeight(k, ctx.BlockHeight()-1) {
+		k.AssertInvariants(ctx)
+	}
+}
+
 // check all registered invariants
 func EndBlocker(ctx sdk.Context, k Keeper) {
-	if k.InvCheckPeriod() == 0 || ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
+	if k.CheckMode() == CheckModeIncremental || !isCheckHeight(k, ctx.BlockHeight()) {
 		// skip running the invariant check
 		return
 	}
 	k.AssertInvariants(ctx)
 }
+
+// isCheckHeight returns true if the invariants are checked at the given height
+func isCheckHeight(k Keeper, height int64) bool {
+	return k.InvCheckPeriod() != 0 && height%int64(k.InvCheckPeriod()) == 0
+}
@@ -10,32 +10,61 @@ import (
 )
 
 const (
-	DefaultCodespace  = types.DefaultCodespace
-	CodeInvalidInput  = types.CodeInvalidInput
-	ModuleName        = types.ModuleName
-	DefaultParamspace = types.DefaultParamspace
+	DefaultCodespace            = types.DefaultCodespace
+	CodeInvalidInput            = types.CodeInvalidInput
+	CodeRouteDisabled           = types.CodeRouteDisabled
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	QuerierRoute                = types.QuerierRoute
+	QueryInvariantMetrics       = types.QueryInvariantMetrics
+	DefaultParamspace           = types.DefaultParamspace
+	PolicyPanic                 = types.PolicyPanic
+	PolicyHalt                  = types.PolicyHalt
+	PolicyDisableRoutes         = types.PolicyDisableRoutes
+	DefaultInvariantBreakPolicy = types.DefaultInvariantBreakPolicy
+	CheckModeFull               = types.CheckModeFull
+	CheckModeIncremental        = types.CheckModeIncremental
+	CheckModeSampled            = types.CheckModeSampled
 )
 
 var (
 	// functions aliases
-	RegisterCodec         = types.RegisterCodec
-	ErrNilSender          = types.ErrNilSender
-	ErrUnknownInvariant   = types.ErrUnknownInvariant
-	NewGenesisState       = types.NewGenesisState
-	DefaultGenesisState   = types.DefaultGenesisState
-	NewMsgVerifyInvariant = types.NewMsgVerifyInvariant
-	ParamKeyTable         = types.ParamKeyTable
-	NewInvarRoute         = types.NewInvarRoute
-	NewKeeper             = keeper.NewKeeper
+	RegisterCodec            = types.RegisterCodec
+	ErrNilSender             = types.ErrNilSender
+	ErrUnknownInvariant      = types.ErrUnknownInvariant
+	ErrRouteDisabled         = types.ErrRouteDisabled
+	NewGenesisState          = types.NewGenesisState
+	DefaultGenesisState      = types.DefaultGenesisState
+	NewMsgVerifyInvariant    = types.NewMsgVerifyInvariant
+	ValidateGenesis          = types.ValidateGenesis
+	ParamKeyTable            = types.ParamKeyTable
+	NewInvarRoute            = types.NewInvarRoute
+	NewIncrementalInvarRoute = types.NewIncrementalInvarRoute
+	ValidateCheckMode        = types.ValidateCheckMode
+	NewBrokenInvariant       = types.NewBrokenInvariant
+	NewInvariantReport       = types.NewInvariantReport
+
+	ValidateInvariantBreakPolicy = types.ValidateInvariantBreakPolicy
+	ValidateDisabledRoutes       = types.ValidateDisabledRoutes
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeyConstantFee = types.ParamStoreKeyConstantFee
+	ModuleCdc                         = types.ModuleCdc
+	ParamStoreKeyConstantFee          = types.ParamStoreKeyConstantFee
+	ParamStoreKeyInvariantBreakPolicy = types.ParamStoreKeyInvariantBreakPolicy
+	ParamStoreKeyDisabledRoutes       = types.ParamStoreKeyDisabledRoutes
+	HaltReportKey                     = types.HaltReportKey
 )
 
 type (
 	GenesisState       = types.GenesisState
 	MsgVerifyInvariant = types.MsgVerifyInvariant
 	InvarRoute         = types.InvarRoute
+	BrokenInvariant    = types.BrokenInvariant
+	InvariantReport    = types.InvariantReport
+	HaltHandler        = types.HaltHandler
+	InvariantMetrics   = types.InvariantMetrics
+	InvariantsMetrics  = types.InvariantsMetrics
 	Keeper             = keeper.Keeper
 )
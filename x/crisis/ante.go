@@ -0,0 +1,24 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
+)
+
+// NewDisabledRoutesAnteHandler returns an AnteHandler that rejects the
+// transactions containing messages routed to a message route disabled after
+// an invariant break, and otherwise calls the next AnteHandler.
+func NewDisabledRoutesAnteHandler(k Keeper, next sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		for _, msg := range tx.GetMsgs() {
+			if k.IsRouteDisabled(ctx, msg.Route()) {
+				return ctx, types.ErrRouteDisabled(types.DefaultCodespace, msg.Route()).Result(), true
+			}
+		}
+
+		if next == nil {
+			return ctx, sdk.Result{}, false
+		}
+		return next(ctx, tx, simulate)
+	}
+}
@@ -0,0 +1,39 @@
+package crisis_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+)
+
+func TestDisabledRoutesAnteHandler(t *testing.T) {
+	ctx, crisisKeeper, _, _ := CreateTestInput(t)
+
+	called := false
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, sdk.Result, bool) {
+		called = true
+		return ctx, sdk.Result{}, false
+	}
+	anteHandler := crisis.NewDisabledRoutesAnteHandler(crisisKeeper, next)
+
+	msg := crisis.NewMsgVerifyInvariant(addrs[0], testModuleName, dummyRouteWhichPasses.Route)
+	tx := auth.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addrs[0]), msg}, auth.StdFee{}, nil, "")
+
+	_, res, abort := anteHandler(ctx, tx, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+	require.True(t, called)
+
+	// disable the route of the test message
+	called = false
+	crisisKeeper.SetDisabledRoutes(ctx, []string{sdk.NewTestMsg().Route()})
+
+	_, res, abort = anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, crisis.CodeRouteDisabled, res.Code)
+	require.False(t, called)
+}
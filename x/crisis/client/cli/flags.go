@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
+)
+
+// node-local invariant check flags
+const (
+	FlagInvCheckMode       = "inv-check-mode"
+	FlagInvCheckSampleSize = "inv-check-sample-size"
+)
+
+// AddModuleInitFlags adds the invariant check flags to the command starting
+// the node
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(FlagInvCheckMode, types.CheckModeFull,
+		"Invariant check mode: full, incremental or sampled")
+	startCmd.Flags().Int(FlagInvCheckSampleSize, 1,
+		"Number of invariants run on each check in the sampled invariant check mode")
+}
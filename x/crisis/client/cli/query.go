@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the crisis module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	crisisQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	crisisQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryInvariantMetrics(cdc),
+		)...,
+	)
+
+	return crisisQueryCmd
+}
+
+// GetCmdQueryInvariantMetrics implements a command to return the timing
+// metrics of the invariants run by the queried node.
+func GetCmdQueryInvariantMetrics(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "invariant-metrics",
+		Short: "Query the timing metrics of the invariants run by the node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariantMetrics)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var metrics types.InvariantsMetrics
+			if err := cdc.UnmarshalJSON(res, &metrics); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(metrics)
+		},
+	}
+}
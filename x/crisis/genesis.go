@@ -9,10 +9,17 @@ import (
 // new crisis genesis
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
 	keeper.SetConstantFee(ctx, data.ConstantFee)
+	keeper.SetInvariantBreakPolicy(ctx, data.InvariantBreakPolicy)
+	keeper.SetDisabledRoutes(ctx, data.DisabledRoutes)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	constantFee := keeper.GetConstantFee(ctx)
-	return types.NewGenesisState(constantFee)
+	policy := keeper.GetInvariantBreakPolicy(ctx)
+	disabledRoutes := keeper.GetDisabledRoutes(ctx)
+	if disabledRoutes == nil {
+		disabledRoutes = []string{}
+	}
+	return types.NewGenesisState(constantFee, policy, disabledRoutes)
 }
@@ -0,0 +1,54 @@
+package crisis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
+)
+
+// NewStateDumpHaltHandler returns a HaltHandler that writes the report of the
+// broken invariants and the exported application state to the given
+// directory, then exits the process.
+func NewStateDumpHaltHandler(dir string, exportState func() (json.RawMessage, error)) types.HaltHandler {
+	return func(ctx sdk.Context, report types.InvariantReport) {
+		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+
+		if err := writeHaltDump(dir, report, exportState); err != nil {
+			logger.Error("failed to dump state on halt", "err", err)
+		} else {
+			logger.Error("halting on broken invariants", "height", report.Height, "dump", dir)
+		}
+
+		os.Exit(1)
+	}
+}
+
+func writeHaltDump(dir string, report types.InvariantReport, exportState func() (json.RawMessage, error)) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	reportBz, err := types.ModuleCdc.MarshalJSONIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	reportFile := filepath.Join(dir, fmt.Sprintf("invariant-report-%d.json", report.Height))
+	if err := ioutil.WriteFile(reportFile, reportBz, 0600); err != nil {
+		return err
+	}
+
+	if exportState == nil {
+		return nil
+	}
+	state, err := exportState()
+	if err != nil {
+		return err
+	}
+	stateFile := filepath.Join(dir, fmt.Sprintf("state-%d.json", report.Height))
+	return ioutil.WriteFile(stateFile, state, 0600)
+}
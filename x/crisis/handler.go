@@ -55,23 +55,13 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 	}
 
 	if stop {
-		// NOTE currently, because the chain halts here, this transaction will never be included
-		// in the blockchain thus the constant fee will have never been deducted. Thus no
-		// refund is required.
-
-		// TODO uncomment the following code block with implementation of the circuit breaker
-		//// refund constant fee
-		//err := k.distrKeeper.DistributeFromFeePool(ctx, constantFee, msg.Sender)
-		//if err != nil {
-		//// if there are insufficient coins to refund, log the error,
-		//// but still halt the chain.
-		//logger := ctx.Logger().With("module", "x/crisis")
-		//logger.Error(fmt.Sprintf(
-		//"WARNING: insufficient funds to allocate to sender from fee pool, err: %s", err))
-		//}
-
-		// TODO replace with circuit breaker
-		panic(res)
+		// NOTE under the panic policy the chain halts here, this transaction will
+		// never be included in the blockchain thus the constant fee will have
+		// never been deducted. Under the other policies the sender pays the
+		// constant fee for reporting the broken invariant.
+		k.HandleBrokenInvariants(ctx, []types.BrokenInvariant{
+			types.NewBrokenInvariant(msg.InvariantModuleName, msg.InvariantRoute, res),
+		})
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
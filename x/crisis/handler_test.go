@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -28,7 +29,8 @@ func CreateTestInput(t *testing.T) (sdk.Context, crisis.Keeper, auth.AccountKeep
 		distr.CreateTestInputAdvanced(t, false, 10, communityTax)
 
 	paramSpace := paramsKeeper.Subspace(crisis.DefaultParamspace)
-	crisisKeeper := crisis.NewKeeper(paramSpace, 1, supplyKeeper, auth.FeeCollectorName)
+	crisisKeeper := crisis.NewKeeper(codec.New(), sdk.NewKVStoreKey(crisis.StoreKey), paramSpace, 1,
+		supplyKeeper, auth.FeeCollectorName)
 	constantFee := sdk.NewInt64Coin("stake", 10000000)
 	crisisKeeper.SetConstantFee(ctx, constantFee)
 
@@ -96,6 +98,23 @@ func TestHandleMsgVerifyInvariantWithInvariantBrokenAndNotEnoughPoolCoins(t *tes
 	}, fmt.Sprintf("%v", res))
 }
 
+func TestHandleMsgVerifyInvariantWithInvariantBrokenDisableRoutes(t *testing.T) {
+	ctx, crisisKeeper, accKeeper, _ := CreateTestInput(t)
+	crisisKeeper.SetInvariantBreakPolicy(ctx, crisis.PolicyDisableRoutes)
+	crisisKeeper.SetModuleRouterKeys(testModuleName, testModuleName)
+	sender := addrs[0]
+	balance := accKeeper.GetAccount(ctx, sender).GetCoins()
+
+	h := crisis.NewHandler(crisisKeeper)
+	msg := crisis.NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichFails.Route)
+	require.True(t, h(ctx, msg).IsOK())
+
+	// the sender pays the constant fee and the module route is disabled
+	fee := sdk.NewCoins(crisisKeeper.GetConstantFee(ctx))
+	require.Equal(t, balance.Sub(fee), accKeeper.GetAccount(ctx, sender).GetCoins())
+	require.True(t, crisisKeeper.IsRouteDisabled(ctx, testModuleName))
+}
+
 func TestHandleMsgVerifyInvariantWithInvariantNotBroken(t *testing.T) {
 	ctx, crisisKeeper, _, _ := CreateTestInput(t)
 	sender := addrs[0]
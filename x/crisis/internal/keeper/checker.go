@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
+)
+
+// maxWrittenKeys is the maximum number of keys recorded between two
+// incremental checks. Past it the recorded keys are dropped and the next
+// check runs every invariant in full.
+const maxWrittenKeys = 1 << 20
+
+// invariantChecker holds the node-local configuration and state of the
+// invariant checks. It is shared by all the copies of the keeper.
+type invariantChecker struct {
+	mtx sync.Mutex
+
+	mode       string
+	sampleSize int
+	period     uint
+
+	// keys written since the last check, indexed by store name. The write set
+	// is complete if every write since the last check was recorded, which is
+	// not the case after a restart, a change of mode or too many writes.
+	written  map[string]map[string]struct{}
+	numKeys  int
+	complete bool
+	metrics  map[string]*types.InvariantMetrics
+}
+
+var _ sdk.WriteListener = (*invariantChecker)(nil)
+
+func newInvariantChecker(period uint) *invariantChecker {
+	return &invariantChecker{
+		mode:    types.CheckModeFull,
+		period:  period,
+		written: make(map[string]map[string]struct{}),
+		metrics: make(map[string]*types.InvariantMetrics),
+	}
+}
+
+// OnWrite implements sdk.WriteListener. The written keys are only recorded in
+// the incremental mode, when the invariants are checked periodically and the
+// write set is complete.
+func (c *invariantChecker) OnWrite(storeKey sdk.StoreKey, key []byte, _ []byte, _ bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.mode != types.CheckModeIncremental || c.period == 0 || !c.complete {
+		return
+	}
+
+	keys, ok := c.written[storeKey.Name()]
+	if !ok {
+		keys = make(map[string]struct{})
+		c.written[storeKey.Name()] = keys
+	}
+	if _, ok := keys[string(key)]; ok {
+		return
+	}
+	if c.numKeys == maxWrittenKeys {
+		c.reset(false)
+		return
+	}
+	keys[string(key)] = struct{}{}
+	c.numKeys++
+}
+
+// reset drops the recorded keys. It must be called with the lock held.
+func (c *invariantChecker) reset(complete bool) {
+	c.written = make(map[string]map[string]struct{})
+	c.numKeys = 0
+	c.complete = complete
+}
+
+// popWriteSet returns the keys written since the last call, or false if they
+// were not all recorded, and starts recording the keys written from now on.
+func (c *invariantChecker) popWriteSet() (sdk.WriteSet, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	ws, complete := sdk.NewWriteSet(c.written), c.complete
+	c.reset(true)
+	return ws, complete
+}
+
+// record records the duration of an invariant run in the route metrics
+func (c *invariantChecker) record(ir types.InvarRoute, incremental bool, duration time.Duration) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	m, ok := c.metrics[ir.FullRoute()]
+	if !ok {
+		m = &types.InvariantMetrics{ModuleName: ir.ModuleName, Route: ir.Route}
+		c.metrics[ir.FullRoute()] = m
+	}
+
+	m.Runs++
+	if incremental {
+		m.IncrRuns++
+	}
+	m.LastDuration = duration
+	m.TotalDuration += duration
+	if duration > m.MaxDuration {
+		m.MaxDuration = duration
+	}
+}
+
+// SetCheckMode sets the mode of the invariant checks run every
+// invCheckPeriod blocks. The sample size is the number of invariants run on
+// each check in the sampled mode. The check mode is a node-local setting
+// which never affects the state: under the halt and disable routes policies,
+// the invariants found broken by the periodic checks are only logged.
+func (k Keeper) SetCheckMode(mode string, sampleSize int) {
+	if err := types.ValidateCheckMode(mode); err != nil {
+		panic(err)
+	}
+	if mode == types.CheckModeSampled && sampleSize <= 0 {
+		panic(fmt.Sprintf("invalid invariant sample size %d, must be positive", sampleSize))
+	}
+
+	k.checker.mtx.Lock()
+	defer k.checker.mtx.Unlock()
+
+	k.checker.mode = mode
+	k.checker.sampleSize = sampleSize
+	k.checker.reset(false)
+}
+
+// CheckMode returns the mode of the invariant checks
+func (k Keeper) CheckMode() string {
+	k.checker.mtx.Lock()
+	defer k.checker.mtx.Unlock()
+
+	return k.checker.mode
+}
+
+// WriteListener returns the listener recording the keys written to the
+// stores for the incremental invariant checks. It must be added to the
+// listeners of the stores read by the incremental invariants. As the keys are
+// only kept in memory, the first check after the node starts runs every
+// invariant in full.
+func (k Keeper) WriteListener() sdk.WriteListener {
+	return k.checker
+}
+
+// InvariantMetrics returns the timing metrics of the invariant routes run so
+// far, in their registration order
+func (k Keeper) InvariantMetrics() []types.InvariantMetrics {
+	k.checker.mtx.Lock()
+	defer k.checker.mtx.Unlock()
+
+	metrics := make([]types.InvariantMetrics, 0, len(k.checker.metrics))
+	for _, ir := range k.routes {
+		if m, ok := k.checker.metrics[ir.FullRoute()]; ok {
+			metrics = append(metrics, *m)
+		}
+	}
+	return metrics
+}
+
+// sampleRoutes returns a random subset of the invariant routes of the sample
+// size, in their registration order. The sample is deterministic for a given
+// block so that all the nodes check the same invariants.
+func (k Keeper) sampleRoutes(ctx sdk.Context, sampleSize int) []types.InvarRoute {
+	routes := k.Routes()
+	if sampleSize >= len(routes) {
+		return routes
+	}
+
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(ctx.BlockHeight()))
+	seed := sha256.Sum256(append(ctx.BlockHeader().LastBlockId.Hash, heightBz...))
+	r := rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(seed[:8]))))
+
+	indexes := r.Perm(len(routes))[:sampleSize]
+	sort.Ints(indexes)
+
+	sample := make([]types.InvarRoute, sampleSize)
+	for i, index := range indexes {
+		sample[i] = routes[index]
+	}
+	return sample
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
+)
+
+func TestSetCheckMode(t *testing.T) {
+	_, k := testKeeper(t, 5)
+	require.Equal(t, types.CheckModeFull, k.CheckMode())
+
+	k.SetCheckMode(types.CheckModeSampled, 2)
+	require.Equal(t, types.CheckModeSampled, k.CheckMode())
+
+	require.Panics(t, func() { k.SetCheckMode("invalid", 0) })
+	require.Panics(t, func() { k.SetCheckMode(types.CheckModeSampled, 0) })
+}
+
+func TestAssertInvariantsIncremental(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+	storeKey := sdk.NewKVStoreKey("test")
+
+	var fullRuns int
+	var writeSets []sdk.WriteSet
+	k.RegisterIncrementalRoute("testModule", "testRoute1",
+		func(_ sdk.Context) (string, bool) {
+			fullRuns++
+			return "", false
+		},
+		func(_ sdk.Context, writeSet sdk.WriteSet) (string, bool) {
+			writeSets = append(writeSets, writeSet)
+			return "", false
+		},
+	)
+	k.RegisterRoute("testModule", "testRoute2", testPassingInvariant)
+
+	// writes are not recorded in the full mode
+	k.WriteListener().OnWrite(storeKey, []byte("key0"), []byte("value"), false)
+	k.AssertInvariants(ctx)
+	require.Equal(t, 1, fullRuns)
+	require.Empty(t, writeSets)
+
+	// the first incremental check runs in full, as the keys written before it
+	// are unknown
+	k.SetCheckMode(types.CheckModeIncremental, 0)
+	k.WriteListener().OnWrite(storeKey, []byte("key0"), []byte("value"), false)
+	k.AssertInvariants(ctx)
+	require.Equal(t, 2, fullRuns)
+	require.Empty(t, writeSets)
+
+	k.WriteListener().OnWrite(storeKey, []byte("key2"), []byte("value"), false)
+	k.WriteListener().OnWrite(storeKey, []byte("key1"), nil, true)
+	k.WriteListener().OnWrite(storeKey, []byte("key2"), []byte("value"), false)
+	k.AssertInvariants(ctx)
+
+	require.Equal(t, 2, fullRuns)
+	require.Equal(t, []sdk.WriteSet{
+		{"test": [][]byte{[]byte("key1"), []byte("key2")}},
+	}, writeSets)
+
+	// the write set is reset after each check
+	k.AssertInvariants(ctx)
+	require.Len(t, writeSets, 2)
+	require.Empty(t, writeSets[1].Keys("test"))
+
+	// genesis checks run every invariant in full
+	k.AssertAllInvariants(ctx)
+	require.Equal(t, 3, fullRuns)
+
+	metrics := k.InvariantMetrics()
+	require.Len(t, metrics, 2)
+	require.Equal(t, "testRoute1", metrics[0].Route)
+	require.Equal(t, uint64(5), metrics[0].Runs)
+	require.Equal(t, uint64(2), metrics[0].IncrRuns)
+	require.Equal(t, "testRoute2", metrics[1].Route)
+	require.Equal(t, uint64(5), metrics[1].Runs)
+	require.Equal(t, uint64(0), metrics[1].IncrRuns)
+}
+
+func TestWriteSetNotRecordedWithoutCheckPeriod(t *testing.T) {
+	_, k := testKeeper(t, 0)
+	storeKey := sdk.NewKVStoreKey("test")
+
+	k.SetCheckMode(types.CheckModeIncremental, 0)
+	k.checker.popWriteSet()
+	k.WriteListener().OnWrite(storeKey, []byte("key"), []byte("value"), false)
+
+	writeSet, complete := k.checker.popWriteSet()
+	require.True(t, complete)
+	require.Empty(t, writeSet.Keys("test"))
+}
+
+func TestAssertInvariantsSampled(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+
+	runs := make(map[string]int)
+	for _, route := range []string{"testRoute1", "testRoute2", "testRoute3", "testRoute4"} {
+		route := route
+		k.RegisterRoute("testModule", route, func(_ sdk.Context) (string, bool) {
+			runs[route]++
+			return "", false
+		})
+	}
+
+	k.SetCheckMode(types.CheckModeSampled, 2)
+	sample := k.sampleRoutes(ctx, 2)
+	require.Len(t, sample, 2)
+	for i, ir := range k.sampleRoutes(ctx, 2) {
+		require.Equal(t, sample[i].Route, ir.Route, "sample must be deterministic")
+	}
+
+	k.AssertInvariants(ctx)
+	require.Len(t, runs, 2)
+	for _, ir := range sample {
+		require.Equal(t, 1, runs[ir.Route])
+	}
+
+	// a sample size larger than the number of invariants runs them all
+	require.Len(t, k.sampleRoutes(ctx, 10), 4)
+}
@@ -1,19 +1,32 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
+// exemptRouterKeys are the message routes never disabled under the disable
+// routes policy: the crisis route, so that invariants can still be verified,
+// and the gov and params routes, so that governance can always re-enable the
+// disabled routes.
+var exemptRouterKeys = []string{types.ModuleName, "gov", params.RouterKey}
+
 // Keeper - crisis keeper
 type Keeper struct {
+	storeKey       sdk.StoreKey
+	cdc            *codec.Codec
 	routes         []types.InvarRoute
+	routerKeys     map[string][]string
+	haltHandler    types.HaltHandler
+	checker        *invariantChecker
 	paramSpace     params.Subspace
 	invCheckPeriod uint
 
@@ -24,12 +37,16 @@ type Keeper struct {
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(
-	paramSpace params.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
-	feeCollectorName string,
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, invCheckPeriod uint,
+	supplyKeeper types.SupplyKeeper, feeCollectorName string,
 ) Keeper {
 
 	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
 		routes:           make([]types.InvarRoute, 0),
+		routerKeys:       make(map[string][]string),
+		checker:          newInvariantChecker(invCheckPeriod),
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
@@ -48,11 +65,34 @@ func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
 	k.routes = append(k.routes, invarRoute)
 }
 
+// RegisterIncrementalRoute register the routes for each of the invariants
+// which can also be tested incrementally
+func (k *Keeper) RegisterIncrementalRoute(moduleName, route string, invar sdk.Invariant,
+	incrInvar sdk.IncrementalInvariant) {
+
+	invarRoute := types.NewIncrementalInvarRoute(moduleName, route, invar, incrInvar)
+	k.routes = append(k.routes, invarRoute)
+}
+
 // Routes - return the keeper's invariant routes
 func (k Keeper) Routes() []types.InvarRoute {
 	return k.routes
 }
 
+// SetModuleRouterKeys sets the message routes disabled when an invariant of
+// the module is broken under the disable routes policy. A module without
+// router keys, such as supply, can be mapped to the routes of the messages
+// which could break its invariants.
+func (k *Keeper) SetModuleRouterKeys(moduleName string, routerKeys ...string) {
+	k.routerKeys[moduleName] = routerKeys
+}
+
+// ModuleRouterKeys returns the message routes disabled when an invariant of
+// the module is broken
+func (k Keeper) ModuleRouterKeys(moduleName string) []string {
+	return k.routerKeys[moduleName]
+}
+
 // Invariants returns all the registered Crisis keeper invariants.
 func (k Keeper) Invariants() []sdk.Invariant {
 	invars := make([]sdk.Invariant, len(k.routes))
@@ -62,28 +102,186 @@ func (k Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
-// AssertInvariants asserts all registered invariants. If any invariant fails,
-// the method panics.
+// SetHaltHandler sets the handler called when the chain halts on broken
+// invariants under the halt policy
+func (k *Keeper) SetHaltHandler(hh types.HaltHandler) {
+	if k.haltHandler != nil {
+		panic("cannot set crisis halt handler twice")
+	}
+	k.haltHandler = hh
+}
+
+// HaltHandler returns the handler called when the chain halts on broken
+// invariants
+func (k Keeper) HaltHandler() types.HaltHandler {
+	return k.haltHandler
+}
+
+// AssertInvariants asserts the registered invariants according to the check
+// mode. If any invariant fails, the method panics under the panic policy,
+// otherwise the broken invariants are only logged. As the invariants are
+// asserted depending on the node-local check period, the other policies are
+// only applied by MsgVerifyInvariant, which all the nodes execute.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
+	k.assertInvariants(ctx, k.CheckMode())
+}
+
+// AssertAllInvariants asserts all registered invariants in full, regardless
+// of the check mode.
+func (k Keeper) AssertAllInvariants(ctx sdk.Context) {
+	k.assertInvariants(ctx, types.CheckModeFull)
+}
+
+func (k Keeper) assertInvariants(ctx sdk.Context, mode string) {
 	logger := k.Logger(ctx)
 
 	start := time.Now()
+	policy := k.GetInvariantBreakPolicy(ctx)
+
 	invarRoutes := k.Routes()
+	var writeSet sdk.WriteSet
+	switch mode {
+	case types.CheckModeIncremental:
+		var complete bool
+		if writeSet, complete = k.checker.popWriteSet(); !complete {
+			// the keys written since the last check are unknown
+			writeSet = nil
+			mode = types.CheckModeFull
+		}
+	case types.CheckModeSampled:
+		invarRoutes = k.sampleRoutes(ctx, k.checker.sampleSize)
+	}
 
+	var broken int
 	for _, ir := range invarRoutes {
-		if res, stop := ir.Invar(ctx); stop {
+		if res, stop := k.runInvariant(ctx, ir, writeSet); stop {
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
-			panic(fmt.Errorf("invariant broken: %s\n"+
+			msg := fmt.Sprintf("invariant broken: %s\n"+
 				"\tCRITICAL please submit the following transaction:\n"+
-				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route)
+			if policy == types.PolicyPanic {
+				panic(errors.New(msg))
+			}
+
+			// the other policies are applied by MsgVerifyInvariant
+			broken++
+			logger.Error(msg, "policy", policy)
 		}
 	}
 
 	end := time.Now()
 	diff := end.Sub(start)
 
-	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
+	logger.Info("asserted invariants", "mode", mode, "invariants", len(invarRoutes),
+		"broken", broken, "duration", diff, "height", ctx.BlockHeight())
+}
+
+// runInvariant runs the invariant, incrementally if a write set is given and
+// the invariant supports it, and records its duration
+func (k Keeper) runInvariant(ctx sdk.Context, ir types.InvarRoute, writeSet sdk.WriteSet) (res string, stop bool) {
+	incremental := writeSet != nil && ir.IncrInvar != nil
+
+	start := time.Now()
+	if incremental {
+		res, stop = ir.IncrInvar(ctx, writeSet)
+	} else {
+		res, stop = ir.Invar(ctx)
+	}
+	duration := time.Since(start)
+
+	k.checker.record(ir, incremental, duration)
+	k.Logger(ctx).Debug("asserted invariant", "route", ir.FullRoute(),
+		"incremental", incremental, "duration", duration)
+
+	return res, stop
+}
+
+// HandleBrokenInvariants responds to the broken invariants according to the
+// invariant break policy: it panics under the panic policy, schedules a halt
+// at the beginning of the next block under the halt policy, or disables the
+// message routes of the modules whose invariants are broken under the
+// disable routes policy. As it writes to the state, it must only be called
+// from the execution of a transaction.
+func (k Keeper) HandleBrokenInvariants(ctx sdk.Context, broken []types.BrokenInvariant) {
+	report := types.NewInvariantReport(ctx.BlockHeight(), broken)
+	policy := k.GetInvariantBreakPolicy(ctx)
+
+	if policy == types.PolicyPanic {
+		panic(report.String())
+	}
+
+	for _, bi := range broken {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeInvariantBroken,
+				sdk.NewAttribute(sdk.AttributeKeyModule, bi.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyRoute, bi.Route),
+				sdk.NewAttribute(types.AttributeKeyPolicy, policy),
+			),
+		)
+	}
+
+	k.Logger(ctx).Error(report.String(), "policy", policy)
+
+	switch policy {
+	case types.PolicyHalt:
+		k.SetHaltReport(ctx, report)
+
+	case types.PolicyDisableRoutes:
+		for _, bi := range broken {
+			routerKeys := k.ModuleRouterKeys(bi.ModuleName)
+			if len(routerKeys) == 0 {
+				k.Logger(ctx).Error(fmt.Sprintf("no message route to disable for the broken invariant %s/%s",
+					bi.ModuleName, bi.Route))
+			}
+			for _, routerKey := range routerKeys {
+				if isExemptRouterKey(routerKey) {
+					continue
+				}
+				k.disableRoute(ctx, routerKey)
+			}
+		}
+	}
+}
+
+// isExemptRouterKey returns true if the route is never disabled
+func isExemptRouterKey(routerKey string) bool {
+	for _, exempt := range exemptRouterKeys {
+		if routerKey == exempt {
+			return true
+		}
+	}
+	return false
+}
+
+// GetHaltReport gets the report of the broken invariants the chain is to halt
+// on, if any
+func (k Keeper) GetHaltReport(ctx sdk.Context) (report types.InvariantReport, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HaltReportKey)
+	if bz == nil {
+		return report, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &report)
+	return report, true
+}
+
+// SetHaltReport sets the report of the broken invariants the chain is to halt
+// on at the beginning of the next block
+func (k Keeper) SetHaltReport(ctx sdk.Context, report types.InvariantReport) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HaltReportKey, k.cdc.MustMarshalBinaryLengthPrefixed(report))
+}
+
+// ClearHaltReport deletes the halt report, so that the chain no longer halts
+// on the broken invariants it reports. As the report is stored, a node
+// restarted with the same binary halts again at the next block: it is meant to
+// be called by the software upgrade restarting the chain once the invariants
+// are fixed, before the crisis BeginBlocker runs.
+func (k Keeper) ClearHaltReport(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.HaltReportKey)
 }
 
 // InvCheckPeriod returns the invariant checks period.
@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -20,24 +23,36 @@ func testFailingInvariant(_ sdk.Context) (string, bool) {
 	return "", true
 }
 
-func testKeeper(checkPeriod uint) Keeper {
+func testKeeper(t *testing.T, checkPeriod uint) (sdk.Context, Keeper) {
+	keyCrisis := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyCrisis, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+
 	cdc := codec.New()
-	paramsKeeper := params.NewKeeper(
-		cdc, sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey), params.DefaultCodespace,
-	)
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
 
-	return NewKeeper(paramsKeeper.Subspace(types.DefaultParamspace), checkPeriod, nil, "test")
+	k := NewKeeper(cdc, keyCrisis, paramsKeeper.Subspace(types.DefaultParamspace), checkPeriod, nil, "test")
+	return ctx, k
 }
 
 func TestLogger(t *testing.T) {
-	k := testKeeper(5)
+	_, k := testKeeper(t, 5)
 
 	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
 	require.Equal(t, ctx.Logger(), k.Logger(ctx))
 }
 
 func TestInvariants(t *testing.T) {
-	k := testKeeper(5)
+	_, k := testKeeper(t, 5)
 	require.Equal(t, k.InvCheckPeriod(), uint(5))
 
 	k.RegisterRoute("testModule", "testRoute", testPassingInvariant)
@@ -45,8 +60,7 @@ func TestInvariants(t *testing.T) {
 }
 
 func TestAssertInvariants(t *testing.T) {
-	k := testKeeper(5)
-	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	ctx, k := testKeeper(t, 5)
 
 	k.RegisterRoute("testModule", "testRoute1", testPassingInvariant)
 	require.NotPanics(t, func() { k.AssertInvariants(ctx) })
@@ -54,3 +68,90 @@ func TestAssertInvariants(t *testing.T) {
 	k.RegisterRoute("testModule", "testRoute2", testFailingInvariant)
 	require.Panics(t, func() { k.AssertInvariants(ctx) })
 }
+
+func TestAssertInvariantsHaltPolicy(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+	k.SetInvariantBreakPolicy(ctx, types.PolicyHalt)
+
+	// the broken invariants are only logged by the node-local checks
+	k.RegisterRoute("testModule", "testRoute1", testPassingInvariant)
+	k.RegisterRoute("testModule", "testRoute2", testFailingInvariant)
+	require.NotPanics(t, func() { k.AssertInvariants(ctx) })
+	_, found := k.GetHaltReport(ctx)
+	require.False(t, found)
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestHandleBrokenInvariantsHaltPolicy(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+	k.SetInvariantBreakPolicy(ctx, types.PolicyHalt)
+
+	broken := []types.BrokenInvariant{
+		types.NewBrokenInvariant("testModule", "testRoute2", ""),
+		types.NewBrokenInvariant("otherModule", "testRoute3", ""),
+	}
+	k.HandleBrokenInvariants(ctx, broken)
+
+	report, found := k.GetHaltReport(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(10), report.Height)
+	require.Equal(t, broken, report.BrokenInvariants)
+	require.Empty(t, k.GetDisabledRoutes(ctx))
+
+	k.ClearHaltReport(ctx)
+	_, found = k.GetHaltReport(ctx)
+	require.False(t, found)
+}
+
+func TestHandleBrokenInvariantsDisableRoutesPolicy(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+	k.SetInvariantBreakPolicy(ctx, types.PolicyDisableRoutes)
+	k.SetModuleRouterKeys("testModule", "testRoute", "otherRoute")
+	k.SetModuleRouterKeys(types.ModuleName, types.ModuleName)
+
+	k.HandleBrokenInvariants(ctx, []types.BrokenInvariant{
+		types.NewBrokenInvariant("testModule", "testRoute1", ""),
+		types.NewBrokenInvariant("testModule", "testRoute2", ""),
+		types.NewBrokenInvariant("noRouteModule", "testRoute3", ""),
+		types.NewBrokenInvariant(types.ModuleName, "testRoute4", ""),
+	})
+
+	require.Equal(t, []string{"testRoute", "otherRoute"}, k.GetDisabledRoutes(ctx))
+	require.True(t, k.IsRouteDisabled(ctx, "testRoute"))
+	require.False(t, k.IsRouteDisabled(ctx, "testModule"))
+	require.False(t, k.IsRouteDisabled(ctx, "noRouteModule"))
+	require.False(t, k.IsRouteDisabled(ctx, types.ModuleName))
+
+	_, found := k.GetHaltReport(ctx)
+	require.False(t, found)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 4)
+	require.Equal(t, types.EventTypeInvariantBroken, events[0].Type)
+}
+
+func TestHandleBrokenInvariantsExemptRoutes(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+	k.SetInvariantBreakPolicy(ctx, types.PolicyDisableRoutes)
+	k.SetModuleRouterKeys("gov", "gov", "params", "testRoute")
+
+	// a broken gov invariant does not disable the routes governance needs to
+	// re-enable the disabled routes
+	k.HandleBrokenInvariants(ctx, []types.BrokenInvariant{
+		types.NewBrokenInvariant("gov", "deposits", ""),
+	})
+
+	require.Equal(t, []string{"testRoute"}, k.GetDisabledRoutes(ctx))
+	require.False(t, k.IsRouteDisabled(ctx, "gov"))
+	require.False(t, k.IsRouteDisabled(ctx, "params"))
+}
+
+func TestSetHaltHandler(t *testing.T) {
+	_, k := testKeeper(t, 5)
+	require.Nil(t, k.HaltHandler())
+
+	hh := func(_ sdk.Context, _ types.InvariantReport) {}
+	k.SetHaltHandler(hh)
+	require.NotNil(t, k.HaltHandler())
+	require.Panics(t, func() { k.SetHaltHandler(hh) })
+}
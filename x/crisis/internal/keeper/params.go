@@ -15,3 +15,48 @@ func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
 	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
 }
+
+// GetInvariantBreakPolicy gets the invariant break policy from the paramSpace,
+// defaulting to the panic policy if it is not set
+func (k Keeper) GetInvariantBreakPolicy(ctx sdk.Context) (policy string) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyInvariantBreakPolicy, &policy)
+	if policy == "" {
+		policy = types.DefaultInvariantBreakPolicy
+	}
+	return
+}
+
+// SetInvariantBreakPolicy sets the invariant break policy in the paramSpace
+func (k Keeper) SetInvariantBreakPolicy(ctx sdk.Context, policy string) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyInvariantBreakPolicy, policy)
+}
+
+// GetDisabledRoutes gets the disabled message routes from the paramSpace
+func (k Keeper) GetDisabledRoutes(ctx sdk.Context) (routes []string) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyDisabledRoutes, &routes)
+	return
+}
+
+// SetDisabledRoutes sets the disabled message routes in the paramSpace
+func (k Keeper) SetDisabledRoutes(ctx sdk.Context, routes []string) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyDisabledRoutes, routes)
+}
+
+// IsRouteDisabled returns true if the message route is disabled
+func (k Keeper) IsRouteDisabled(ctx sdk.Context, route string) bool {
+	for _, disabled := range k.GetDisabledRoutes(ctx) {
+		if disabled == route {
+			return true
+		}
+	}
+	return false
+}
+
+// disableRoute adds the message route to the disabled routes, if not already
+// disabled
+func (k Keeper) disableRoute(ctx sdk.Context, route string) {
+	if k.IsRouteDisabled(ctx, route) {
+		return
+	}
+	k.SetDisabledRoutes(ctx, append(k.GetDisabledRoutes(ctx), route))
+}
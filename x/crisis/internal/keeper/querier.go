@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
+)
+
+// NewQuerier returns a crisis Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryInvariantMetrics:
+			return queryInvariantMetrics(k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown crisis query endpoint: %s", path[0]))
+		}
+	}
+}
+
+// queryInvariantMetrics returns the metrics of the invariants run by the
+// queried node, which differ from node to node
+func queryInvariantMetrics(k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.InvariantMetrics())
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
+)
+
+func TestQueryInvariantMetrics(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+	k.RegisterRoute("testModule", "testRoute", testPassingInvariant)
+	querier := NewQuerier(k)
+
+	res, err := querier(ctx, []string{types.QueryInvariantMetrics}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var metrics []types.InvariantMetrics
+	require.NoError(t, k.cdc.UnmarshalJSON(res, &metrics))
+	require.Empty(t, metrics)
+
+	k.AssertInvariants(ctx)
+	res, err = querier(ctx, []string{types.QueryInvariantMetrics}, abci.RequestQuery{})
+	require.NoError(t, err)
+	require.NoError(t, k.cdc.UnmarshalJSON(res, &metrics))
+	require.Len(t, metrics, 1)
+	require.Equal(t, "testRoute", metrics[0].Route)
+	require.Equal(t, uint64(1), metrics[0].Runs)
+
+	_, err = querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Invariant check modes, defining which invariants are run on each check
+const (
+	// CheckModeFull runs every registered invariant in full
+	CheckModeFull = "full"
+	// CheckModeIncremental runs the invariants supporting it incrementally, on
+	// the keys written since the last check, and the other invariants in full
+	CheckModeIncremental = "incremental"
+	// CheckModeSampled runs a random subset of the registered invariants in
+	// full
+	CheckModeSampled = "sampled"
+)
+
+// ValidateCheckMode checks that the invariant check mode is one of the
+// supported modes
+func ValidateCheckMode(mode string) error {
+	switch mode {
+	case CheckModeFull, CheckModeIncremental, CheckModeSampled:
+		return nil
+	default:
+		return fmt.Errorf("invalid invariant check mode %q, must be one of %s, %s or %s",
+			mode, CheckModeFull, CheckModeIncremental, CheckModeSampled)
+	}
+}
+
+// InvariantMetrics defines the timing metrics of an invariant route
+type InvariantMetrics struct {
+	ModuleName    string        `json:"module_name" yaml:"module_name"`
+	Route         string        `json:"route" yaml:"route"`
+	Runs          uint64        `json:"runs" yaml:"runs"`
+	IncrRuns      uint64        `json:"incremental_runs" yaml:"incremental_runs"`
+	LastDuration  time.Duration `json:"last_duration" yaml:"last_duration"`
+	MaxDuration   time.Duration `json:"max_duration" yaml:"max_duration"`
+	TotalDuration time.Duration `json:"total_duration" yaml:"total_duration"`
+}
+
+// AverageDuration returns the average duration of the invariant runs
+func (im InvariantMetrics) AverageDuration() time.Duration {
+	if im.Runs == 0 {
+		return 0
+	}
+	return im.TotalDuration / time.Duration(im.Runs)
+}
+
+// String implements the Stringer interface
+func (im InvariantMetrics) String() string {
+	return fmt.Sprintf(`Invariant Metrics %s/%s:
+  Runs:             %d
+  Incremental Runs: %d
+  Last Duration:    %s
+  Max Duration:     %s
+  Average Duration: %s`,
+		im.ModuleName, im.Route, im.Runs, im.IncrRuns,
+		im.LastDuration, im.MaxDuration, im.AverageDuration(),
+	)
+}
+
+// InvariantsMetrics defines the timing metrics of several invariant routes
+type InvariantsMetrics []InvariantMetrics
+
+// String implements the Stringer interface
+func (ims InvariantsMetrics) String() string {
+	out := make([]string, len(ims))
+	for i, im := range ims {
+		out[i] = im.String()
+	}
+	return strings.Join(out, "\n")
+}
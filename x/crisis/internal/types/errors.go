@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +12,9 @@ const (
 
 	// CodeInvalidInput is the codetype for invalid input for the crisis module
 	CodeInvalidInput sdk.CodeType = 103
+
+	// CodeRouteDisabled is the codetype for messages routed to a disabled route
+	CodeRouteDisabled sdk.CodeType = 104
 )
 
 // ErrNilSender -  no sender provided for the input
@@ -21,3 +26,9 @@ func ErrNilSender(codespace sdk.CodespaceType) sdk.Error {
 func ErrUnknownInvariant(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "unknown invariant")
 }
+
+// ErrRouteDisabled - message route disabled after an invariant break
+func ErrRouteDisabled(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeRouteDisabled,
+		fmt.Sprintf("message route %s is disabled after an invariant break", route))
+}
@@ -2,8 +2,10 @@ package types
 
 // Crisis module event types
 var (
-	EventTypeInvariant = "invariant"
+	EventTypeInvariant       = "invariant"
+	EventTypeInvariantBroken = "invariant_broken"
 
 	AttributeValueCrisis = ModuleName
 	AttributeKeyRoute    = "route"
+	AttributeKeyPolicy   = "policy"
 )
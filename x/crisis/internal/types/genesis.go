@@ -8,20 +8,26 @@ import (
 
 // GenesisState - crisis genesis state
 type GenesisState struct {
-	ConstantFee sdk.Coin `json:"constant_fee" yaml:"constant_fee"`
+	ConstantFee          sdk.Coin `json:"constant_fee" yaml:"constant_fee"`
+	InvariantBreakPolicy string   `json:"invariant_break_policy" yaml:"invariant_break_policy"`
+	DisabledRoutes       []string `json:"disabled_routes" yaml:"disabled_routes"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(constantFee sdk.Coin) GenesisState {
+func NewGenesisState(constantFee sdk.Coin, invariantBreakPolicy string, disabledRoutes []string) GenesisState {
 	return GenesisState{
-		ConstantFee: constantFee,
+		ConstantFee:          constantFee,
+		InvariantBreakPolicy: invariantBreakPolicy,
+		DisabledRoutes:       disabledRoutes,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		ConstantFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		ConstantFee:          sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		InvariantBreakPolicy: DefaultInvariantBreakPolicy,
+		DisabledRoutes:       []string{},
 	}
 }
 
@@ -30,5 +36,8 @@ func ValidateGenesis(data GenesisState) error {
 	if !data.ConstantFee.IsPositive() {
 		return fmt.Errorf("constant fee must be positive: %s", data.ConstantFee)
 	}
-	return nil
+	if err := ValidateInvariantBreakPolicy(data.InvariantBreakPolicy); err != nil {
+		return err
+	}
+	return ValidateDisabledRoutes(data.DisabledRoutes)
 }
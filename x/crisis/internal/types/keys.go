@@ -3,4 +3,22 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// StoreKey is the store key string for crisis
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for crisis
+	QuerierRoute = ModuleName
+
+	// QueryInvariantMetrics is the query endpoint of the node-local invariant
+	// metrics
+	QueryInvariantMetrics = "invariant_metrics"
+)
+
+// Keys for crisis store
+// Items are stored with the following key: values
+//
+// - 0x00: InvariantReport of the broken invariants the chain halts on
+var (
+	HaltReportKey = []byte{0x00}
 )
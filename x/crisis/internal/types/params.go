@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
@@ -10,14 +12,91 @@ const (
 	DefaultParamspace = ModuleName
 )
 
+// Invariant break policies, defining how the crisis module responds to a
+// broken invariant
+const (
+	// PolicyPanic panics, crashing the node
+	PolicyPanic = "panic"
+	// PolicyHalt halts the chain at the beginning of the next block, handing a
+	// report of the broken invariants to the halt handler
+	PolicyHalt = "halt"
+	// PolicyDisableRoutes emits an event and disables the message routes of the
+	// modules whose invariants are broken until governance enables them again
+	PolicyDisableRoutes = "disable_routes"
+)
+
+// DefaultInvariantBreakPolicy is the invariant break policy used by default
+const DefaultInvariantBreakPolicy = PolicyPanic
+
 var (
 	// key for constant fee parameter
 	ParamStoreKeyConstantFee = []byte("ConstantFee")
+	// key for the invariant break policy parameter
+	ParamStoreKeyInvariantBreakPolicy = []byte("InvariantBreakPolicy")
+	// key for the disabled message routes parameter
+	ParamStoreKeyDisabledRoutes = []byte("DisabledRoutes")
 )
 
 // type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeyConstantFee, sdk.Coin{},
+		params.NewParamSetPair(ParamStoreKeyConstantFee, sdk.Coin{}, validateConstantFee),
+		params.NewParamSetPair(ParamStoreKeyInvariantBreakPolicy, "", validateInvariantBreakPolicy),
+		params.NewParamSetPair(ParamStoreKeyDisabledRoutes, []string{}, validateDisabledRoutes),
 	)
 }
+
+// ValidateInvariantBreakPolicy checks that the invariant break policy is one
+// of the supported policies
+func ValidateInvariantBreakPolicy(policy string) error {
+	switch policy {
+	case PolicyPanic, PolicyHalt, PolicyDisableRoutes:
+		return nil
+	default:
+		return fmt.Errorf("invalid invariant break policy %q, must be one of %s, %s or %s",
+			policy, PolicyPanic, PolicyHalt, PolicyDisableRoutes)
+	}
+}
+
+// ValidateDisabledRoutes checks that the disabled message routes are non-empty
+// and unique
+func ValidateDisabledRoutes(routes []string) error {
+	seen := make(map[string]bool)
+	for _, route := range routes {
+		if route == "" {
+			return fmt.Errorf("disabled route cannot be empty")
+		}
+		if seen[route] {
+			return fmt.Errorf("duplicate disabled route %s", route)
+		}
+		seen[route] = true
+	}
+	return nil
+}
+
+func validateConstantFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() || !v.IsPositive() {
+		return fmt.Errorf("constant fee must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateInvariantBreakPolicy(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateInvariantBreakPolicy(v)
+}
+
+func validateDisabledRoutes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateDisabledRoutes(v)
+}
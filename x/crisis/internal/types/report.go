@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BrokenInvariant defines an invariant found broken along with its message
+type BrokenInvariant struct {
+	ModuleName string `json:"module_name" yaml:"module_name"`
+	Route      string `json:"route" yaml:"route"`
+	Message    string `json:"message" yaml:"message"`
+}
+
+// NewBrokenInvariant creates a new BrokenInvariant instance
+func NewBrokenInvariant(moduleName, route, message string) BrokenInvariant {
+	return BrokenInvariant{
+		ModuleName: moduleName,
+		Route:      route,
+		Message:    message,
+	}
+}
+
+// FullRoute gets the full invariant route
+func (bi BrokenInvariant) FullRoute() string {
+	return bi.ModuleName + "/" + bi.Route
+}
+
+// InvariantReport defines the report of the invariants found broken at a
+// given height
+type InvariantReport struct {
+	Height           int64             `json:"height" yaml:"height"`
+	BrokenInvariants []BrokenInvariant `json:"broken_invariants" yaml:"broken_invariants"`
+}
+
+// NewInvariantReport creates a new InvariantReport instance
+func NewInvariantReport(height int64, brokenInvariants []BrokenInvariant) InvariantReport {
+	return InvariantReport{
+		Height:           height,
+		BrokenInvariants: brokenInvariants,
+	}
+}
+
+// String implements the Stringer interface
+func (r InvariantReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invariants broken at height %d:\n", r.Height)
+	for _, bi := range r.BrokenInvariants {
+		fmt.Fprintf(&b, "%s\n%s\n", bi.FullRoute(), bi.Message)
+	}
+	return b.String()
+}
+
+// HaltHandler is called at the beginning of the block following an invariant
+// break under the halt policy, with the report of the broken invariants. It is
+// expected to stop the node, e.g. after exporting the application state.
+type HaltHandler func(ctx sdk.Context, report InvariantReport)
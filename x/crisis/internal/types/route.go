@@ -9,6 +9,7 @@ type InvarRoute struct {
 	ModuleName string
 	Route      string
 	Invar      sdk.Invariant
+	IncrInvar  sdk.IncrementalInvariant // optional incremental version of Invar
 }
 
 // NewInvarRoute - create an InvarRoute object
@@ -20,6 +21,19 @@ func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
 	}
 }
 
+// NewIncrementalInvarRoute - create an InvarRoute object for an invariant
+// which can also be tested incrementally
+func NewIncrementalInvarRoute(moduleName, route string, invar sdk.Invariant,
+	incrInvar sdk.IncrementalInvariant) InvarRoute {
+
+	return InvarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+		IncrInvar:  incrInvar,
+	}
+}
+
 // get the full invariance route
 func (i InvarRoute) FullRoute() string {
 	return i.ModuleName + "/" + i.Route
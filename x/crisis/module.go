@@ -59,8 +59,16 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetTxCmd(cdc)
 }
 
-// GetQueryCmd returns no root query command for the crisis module.
-func (AppModuleBasic) GetQueryCmd(_ *codec.Codec) *cobra.Command { return nil }
+// AddModuleInitFlags adds the node-local invariant check flags of the crisis
+// module to the command starting the node.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	cli.AddModuleInitFlags(startCmd)
+}
+
+// GetQueryCmd returns the root query command for the crisis module.
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetQueryCmd(cdc)
+}
 
 //____________________________________________________________________________
 
@@ -110,11 +118,15 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(*am.keeper)
 }
 
-// QuerierRoute returns no querier route.
-func (AppModule) QuerierRoute() string { return "" }
+// QuerierRoute returns the crisis module's querier route name.
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
 
-// NewQuerierHandler returns no sdk.Querier.
-func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+// NewQuerierHandler returns the crisis module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(*am.keeper)
+}
 
 // InitGenesis performs genesis initialization for the crisis module. It returns
 // no validator updates.
@@ -123,7 +135,7 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 	types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
 	InitGenesis(ctx, *am.keeper, genesisState)
 
-	am.keeper.AssertInvariants(ctx)
+	am.keeper.AssertAllInvariants(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -134,8 +146,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return types.ModuleCdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock performs a no-op.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock returns the begin blocker for the crisis module.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, *am.keeper)
+}
 
 // EndBlock returns the end blocker for the crisis module. It returns no validator
 // updates.
@@ -7,9 +7,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 )
 
-// set the proposer for determining distribution during endblock
-// and distribute rewards for the previous block
-func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+// set the proposer for determining distribution during endblock,
+// distribute rewards for the previous block, compound the rewards
+// of delegations opted into auto-compounding and make the due payments
+// of the community pool spend streams
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper, rewardAllocationFn keeper.RewardAllocationFn) {
 	// determine the total power signing the block
 	var previousTotalPower, sumPreviousPrecommitPower int64
 	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
@@ -23,10 +25,13 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// ref https://github.com/cosmos/cosmos-sdk/issues/3095
 	if ctx.BlockHeight() > 1 {
 		previousProposer := k.GetPreviousProposerConsAddr(ctx)
-		k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes())
+		k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes(), rewardAllocationFn)
 	}
 
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	k.ProcessAutoCompounds(ctx)
+	k.ProcessSpendStreams(ctx)
 }
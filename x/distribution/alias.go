@@ -13,128 +13,192 @@ import (
 )
 
 const (
-	DefaultParamspace                = keeper.DefaultParamspace
-	DefaultCodespace                 = types.DefaultCodespace
-	CodeInvalidInput                 = types.CodeInvalidInput
-	CodeNoDistributionInfo           = types.CodeNoDistributionInfo
-	CodeNoValidatorCommission        = types.CodeNoValidatorCommission
-	CodeSetWithdrawAddrDisabled      = types.CodeSetWithdrawAddrDisabled
-	ModuleName                       = types.ModuleName
-	StoreKey                         = types.StoreKey
-	RouterKey                        = types.RouterKey
-	QuerierRoute                     = types.QuerierRoute
-	ProposalTypeCommunityPoolSpend   = types.ProposalTypeCommunityPoolSpend
-	QueryParams                      = types.QueryParams
-	QueryValidatorOutstandingRewards = types.QueryValidatorOutstandingRewards
-	QueryValidatorCommission         = types.QueryValidatorCommission
-	QueryValidatorSlashes            = types.QueryValidatorSlashes
-	QueryDelegationRewards           = types.QueryDelegationRewards
-	QueryDelegatorTotalRewards       = types.QueryDelegatorTotalRewards
-	QueryDelegatorValidators         = types.QueryDelegatorValidators
-	QueryWithdrawAddr                = types.QueryWithdrawAddr
-	QueryCommunityPool               = types.QueryCommunityPool
-	ParamCommunityTax                = types.ParamCommunityTax
-	ParamBaseProposerReward          = types.ParamBaseProposerReward
-	ParamBonusProposerReward         = types.ParamBonusProposerReward
-	ParamWithdrawAddrEnabled         = types.ParamWithdrawAddrEnabled
+	DefaultParamspace                          = keeper.DefaultParamspace
+	DefaultCodespace                           = types.DefaultCodespace
+	CodeInvalidInput                           = types.CodeInvalidInput
+	CodeNoDistributionInfo                     = types.CodeNoDistributionInfo
+	CodeNoValidatorCommission                  = types.CodeNoValidatorCommission
+	CodeSetWithdrawAddrDisabled                = types.CodeSetWithdrawAddrDisabled
+	CodeUnknownSpendStream                     = types.CodeUnknownSpendStream
+	ModuleName                                 = types.ModuleName
+	StoreKey                                   = types.StoreKey
+	RouterKey                                  = types.RouterKey
+	QuerierRoute                               = types.QuerierRoute
+	ProposalTypeCommunityPoolSpend             = types.ProposalTypeCommunityPoolSpend
+	ProposalTypeCommunityPoolRecurringSpend    = types.ProposalTypeCommunityPoolRecurringSpend
+	ProposalTypeCancelCommunityPoolSpendStream = types.ProposalTypeCancelCommunityPoolSpendStream
+	QueryParams                                = types.QueryParams
+	QueryValidatorOutstandingRewards           = types.QueryValidatorOutstandingRewards
+	QueryValidatorCommission                   = types.QueryValidatorCommission
+	QueryValidatorSlashes                      = types.QueryValidatorSlashes
+	QueryDelegationRewards                     = types.QueryDelegationRewards
+	QueryDelegatorTotalRewards                 = types.QueryDelegatorTotalRewards
+	QueryDelegatorValidators                   = types.QueryDelegatorValidators
+	QueryWithdrawAddr                          = types.QueryWithdrawAddr
+	QueryCommunityPool                         = types.QueryCommunityPool
+	QueryDelegatorAutoCompounds                = types.QueryDelegatorAutoCompounds
+	QuerySpendStreams                          = types.QuerySpendStreams
+	QuerySpendStream                           = types.QuerySpendStream
+	QueryRewardDestinations                    = types.QueryRewardDestinations
+	GasWithdrawDelegationRewards               = types.GasWithdrawDelegationRewards
+	MaxRewardDestinations                      = types.MaxRewardDestinations
+	ParamCommunityTax                          = types.ParamCommunityTax
+	ParamBaseProposerReward                    = types.ParamBaseProposerReward
+	ParamBonusProposerReward                   = types.ParamBonusProposerReward
+	ParamWithdrawAddrEnabled                   = types.ParamWithdrawAddrEnabled
+	ParamAutoCompoundInterval                  = types.ParamAutoCompoundInterval
+	ParamMaxAutoCompoundsPerBlock              = types.ParamMaxAutoCompoundsPerBlock
 )
 
 var (
 	// functions aliases
-	RegisterInvariants                         = keeper.RegisterInvariants
-	AllInvariants                              = keeper.AllInvariants
-	NonNegativeOutstandingInvariant            = keeper.NonNegativeOutstandingInvariant
-	CanWithdrawInvariant                       = keeper.CanWithdrawInvariant
-	ReferenceCountInvariant                    = keeper.ReferenceCountInvariant
-	ModuleAccountInvariant                     = keeper.ModuleAccountInvariant
-	NewKeeper                                  = keeper.NewKeeper
-	GetValidatorOutstandingRewardsAddress      = keeper.GetValidatorOutstandingRewardsAddress
-	GetDelegatorWithdrawInfoAddress            = keeper.GetDelegatorWithdrawInfoAddress
-	GetDelegatorStartingInfoAddresses          = keeper.GetDelegatorStartingInfoAddresses
-	GetValidatorHistoricalRewardsAddressPeriod = keeper.GetValidatorHistoricalRewardsAddressPeriod
-	GetValidatorCurrentRewardsAddress          = keeper.GetValidatorCurrentRewardsAddress
-	GetValidatorAccumulatedCommissionAddress   = keeper.GetValidatorAccumulatedCommissionAddress
-	GetValidatorSlashEventAddressHeight        = keeper.GetValidatorSlashEventAddressHeight
-	GetValidatorOutstandingRewardsKey          = keeper.GetValidatorOutstandingRewardsKey
-	GetDelegatorWithdrawAddrKey                = keeper.GetDelegatorWithdrawAddrKey
-	GetDelegatorStartingInfoKey                = keeper.GetDelegatorStartingInfoKey
-	GetValidatorHistoricalRewardsPrefix        = keeper.GetValidatorHistoricalRewardsPrefix
-	GetValidatorHistoricalRewardsKey           = keeper.GetValidatorHistoricalRewardsKey
-	GetValidatorCurrentRewardsKey              = keeper.GetValidatorCurrentRewardsKey
-	GetValidatorAccumulatedCommissionKey       = keeper.GetValidatorAccumulatedCommissionKey
-	GetValidatorSlashEventPrefix               = keeper.GetValidatorSlashEventPrefix
-	GetValidatorSlashEventKeyPrefix            = keeper.GetValidatorSlashEventKeyPrefix
-	GetValidatorSlashEventKey                  = keeper.GetValidatorSlashEventKey
-	ParamKeyTable                              = keeper.ParamKeyTable
-	HandleCommunityPoolSpendProposal           = keeper.HandleCommunityPoolSpendProposal
-	NewQuerier                                 = keeper.NewQuerier
-	MakeTestCodec                              = keeper.MakeTestCodec
-	CreateTestInputDefault                     = keeper.CreateTestInputDefault
-	CreateTestInputAdvanced                    = keeper.CreateTestInputAdvanced
-	RegisterCodec                              = types.RegisterCodec
-	NewDelegatorStartingInfo                   = types.NewDelegatorStartingInfo
-	ErrNilDelegatorAddr                        = types.ErrNilDelegatorAddr
-	ErrNilWithdrawAddr                         = types.ErrNilWithdrawAddr
-	ErrNilValidatorAddr                        = types.ErrNilValidatorAddr
-	ErrNoDelegationDistInfo                    = types.ErrNoDelegationDistInfo
-	ErrNoValidatorDistInfo                     = types.ErrNoValidatorDistInfo
-	ErrNoValidatorCommission                   = types.ErrNoValidatorCommission
-	ErrSetWithdrawAddrDisabled                 = types.ErrSetWithdrawAddrDisabled
-	ErrBadDistribution                         = types.ErrBadDistribution
-	ErrInvalidProposalAmount                   = types.ErrInvalidProposalAmount
-	ErrEmptyProposalRecipient                  = types.ErrEmptyProposalRecipient
-	InitialFeePool                             = types.InitialFeePool
-	NewGenesisState                            = types.NewGenesisState
-	DefaultGenesisState                        = types.DefaultGenesisState
-	ValidateGenesis                            = types.ValidateGenesis
-	NewMsgSetWithdrawAddress                   = types.NewMsgSetWithdrawAddress
-	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
-	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
-	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
-	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
-	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
-	NewQueryValidatorSlashesParams             = types.NewQueryValidatorSlashesParams
-	NewQueryDelegationRewardsParams            = types.NewQueryDelegationRewardsParams
-	NewQueryDelegatorParams                    = types.NewQueryDelegatorParams
-	NewQueryDelegatorWithdrawAddrParams        = types.NewQueryDelegatorWithdrawAddrParams
-	NewQueryDelegatorTotalRewardsResponse      = types.NewQueryDelegatorTotalRewardsResponse
-	NewDelegationDelegatorReward               = types.NewDelegationDelegatorReward
-	NewValidatorHistoricalRewards              = types.NewValidatorHistoricalRewards
-	NewValidatorCurrentRewards                 = types.NewValidatorCurrentRewards
-	InitialValidatorAccumulatedCommission      = types.InitialValidatorAccumulatedCommission
-	NewValidatorSlashEvent                     = types.NewValidatorSlashEvent
+	RegisterInvariants                           = keeper.RegisterInvariants
+	AllInvariants                                = keeper.AllInvariants
+	NonNegativeOutstandingInvariant              = keeper.NonNegativeOutstandingInvariant
+	CanWithdrawInvariant                         = keeper.CanWithdrawInvariant
+	ReferenceCountInvariant                      = keeper.ReferenceCountInvariant
+	ModuleAccountInvariant                       = keeper.ModuleAccountInvariant
+	NewKeeper                                    = keeper.NewKeeper
+	DefaultRewardAllocationFn                    = keeper.DefaultRewardAllocationFn
+	NewEqualFloorRewardAllocationFn              = keeper.NewEqualFloorRewardAllocationFn
+	GetValidatorOutstandingRewardsAddress        = keeper.GetValidatorOutstandingRewardsAddress
+	GetDelegatorWithdrawInfoAddress              = keeper.GetDelegatorWithdrawInfoAddress
+	GetDelegatorRewardDestinationsAddress        = keeper.GetDelegatorRewardDestinationsAddress
+	GetDelegatorStartingInfoAddresses            = keeper.GetDelegatorStartingInfoAddresses
+	GetValidatorHistoricalRewardsAddressPeriod   = keeper.GetValidatorHistoricalRewardsAddressPeriod
+	GetValidatorCurrentRewardsAddress            = keeper.GetValidatorCurrentRewardsAddress
+	GetValidatorAccumulatedCommissionAddress     = keeper.GetValidatorAccumulatedCommissionAddress
+	GetValidatorSlashEventAddressHeight          = keeper.GetValidatorSlashEventAddressHeight
+	GetDelegatorAutoCompoundAddresses            = keeper.GetDelegatorAutoCompoundAddresses
+	GetSpendStreamQueueStreamID                  = keeper.GetSpendStreamQueueStreamID
+	GetValidatorOutstandingRewardsKey            = keeper.GetValidatorOutstandingRewardsKey
+	GetDelegatorWithdrawAddrKey                  = keeper.GetDelegatorWithdrawAddrKey
+	GetDelegatorRewardDestinationsKey            = keeper.GetDelegatorRewardDestinationsKey
+	GetDelegatorStartingInfoKey                  = keeper.GetDelegatorStartingInfoKey
+	GetValidatorHistoricalRewardsPrefix          = keeper.GetValidatorHistoricalRewardsPrefix
+	GetValidatorHistoricalRewardsKey             = keeper.GetValidatorHistoricalRewardsKey
+	GetValidatorCurrentRewardsKey                = keeper.GetValidatorCurrentRewardsKey
+	GetValidatorAccumulatedCommissionKey         = keeper.GetValidatorAccumulatedCommissionKey
+	GetValidatorSlashEventPrefix                 = keeper.GetValidatorSlashEventPrefix
+	GetValidatorSlashEventKeyPrefix              = keeper.GetValidatorSlashEventKeyPrefix
+	GetValidatorSlashEventKey                    = keeper.GetValidatorSlashEventKey
+	GetDelegatorAutoCompoundPrefix               = keeper.GetDelegatorAutoCompoundPrefix
+	GetDelegatorAutoCompoundKey                  = keeper.GetDelegatorAutoCompoundKey
+	GetSpendStreamKey                            = keeper.GetSpendStreamKey
+	GetSpendStreamQueueTimeKey                   = keeper.GetSpendStreamQueueTimeKey
+	GetSpendStreamQueueKey                       = keeper.GetSpendStreamQueueKey
+	ParamKeyTable                                = keeper.ParamKeyTable
+	HandleCommunityPoolSpendProposal             = keeper.HandleCommunityPoolSpendProposal
+	HandleCommunityPoolRecurringSpendProposal    = keeper.HandleCommunityPoolRecurringSpendProposal
+	HandleCancelCommunityPoolSpendStreamProposal = keeper.HandleCancelCommunityPoolSpendStreamProposal
+	NewQuerier                                   = keeper.NewQuerier
+	MakeTestCodec                                = keeper.MakeTestCodec
+	CreateTestInputDefault                       = keeper.CreateTestInputDefault
+	CreateTestInputAdvanced                      = keeper.CreateTestInputAdvanced
+	RegisterCodec                                = types.RegisterCodec
+	NewDelegatorStartingInfo                     = types.NewDelegatorStartingInfo
+	ErrNilDelegatorAddr                          = types.ErrNilDelegatorAddr
+	ErrNilWithdrawAddr                           = types.ErrNilWithdrawAddr
+	ErrNilClaimerAddr                            = types.ErrNilClaimerAddr
+	ErrNilValidatorAddr                          = types.ErrNilValidatorAddr
+	ErrNoDelegationDistInfo                      = types.ErrNoDelegationDistInfo
+	ErrNoValidatorDistInfo                       = types.ErrNoValidatorDistInfo
+	ErrNoValidatorCommission                     = types.ErrNoValidatorCommission
+	ErrSetWithdrawAddrDisabled                   = types.ErrSetWithdrawAddrDisabled
+	ErrBadDistribution                           = types.ErrBadDistribution
+	ErrInvalidProposalAmount                     = types.ErrInvalidProposalAmount
+	ErrEmptyProposalRecipient                    = types.ErrEmptyProposalRecipient
+	ErrInvalidProposalPeriod                     = types.ErrInvalidProposalPeriod
+	ErrInvalidProposalPeriods                    = types.ErrInvalidProposalPeriods
+	ErrInvalidRewardDestinations                 = types.ErrInvalidRewardDestinations
+	ErrUnknownSpendStream                        = types.ErrUnknownSpendStream
+	InitialFeePool                               = types.InitialFeePool
+	NewGenesisState                              = types.NewGenesisState
+	DefaultGenesisState                          = types.DefaultGenesisState
+	ValidateGenesis                              = types.ValidateGenesis
+	NewMsgSetWithdrawAddress                     = types.NewMsgSetWithdrawAddress
+	NewMsgWithdrawDelegatorReward                = types.NewMsgWithdrawDelegatorReward
+	NewMsgWithdrawAllDelegatorRewards            = types.NewMsgWithdrawAllDelegatorRewards
+	NewMsgWithdrawValidatorCommission            = types.NewMsgWithdrawValidatorCommission
+	NewMsgSetAutoCompound                        = types.NewMsgSetAutoCompound
+	NewMsgSetRewardDestinations                  = types.NewMsgSetRewardDestinations
+	NewMsgClaimDelegatorReward                   = types.NewMsgClaimDelegatorReward
+	NewCommunityPoolSpendProposal                = types.NewCommunityPoolSpendProposal
+	NewCommunityPoolRecurringSpendProposal       = types.NewCommunityPoolRecurringSpendProposal
+	NewCancelCommunityPoolSpendStreamProposal    = types.NewCancelCommunityPoolSpendStreamProposal
+	NewQueryValidatorOutstandingRewardsParams    = types.NewQueryValidatorOutstandingRewardsParams
+	NewQueryValidatorCommissionParams            = types.NewQueryValidatorCommissionParams
+	NewQueryValidatorSlashesParams               = types.NewQueryValidatorSlashesParams
+	NewQueryDelegationRewardsParams              = types.NewQueryDelegationRewardsParams
+	NewQueryDelegatorParams                      = types.NewQueryDelegatorParams
+	NewQueryDelegatorWithdrawAddrParams          = types.NewQueryDelegatorWithdrawAddrParams
+	NewQuerySpendStreamParams                    = types.NewQuerySpendStreamParams
+	NewQueryDelegatorTotalRewardsResponse        = types.NewQueryDelegatorTotalRewardsResponse
+	NewDelegationDelegatorReward                 = types.NewDelegationDelegatorReward
+	NewWithdrawAllDelegatorRewardsResponse       = types.NewWithdrawAllDelegatorRewardsResponse
+	NewDelegationWithdrawnReward                 = types.NewDelegationWithdrawnReward
+	NewValidatorHistoricalRewards                = types.NewValidatorHistoricalRewards
+	NewValidatorCurrentRewards                   = types.NewValidatorCurrentRewards
+	InitialValidatorAccumulatedCommission        = types.InitialValidatorAccumulatedCommission
+	NewValidatorSlashEvent                       = types.NewValidatorSlashEvent
+	NewCommunityPoolSpendStream                  = types.NewCommunityPoolSpendStream
+	NewRewardDestination                         = types.NewRewardDestination
 
 	// variable aliases
-	FeePoolKey                           = keeper.FeePoolKey
-	ProposerKey                          = keeper.ProposerKey
-	ValidatorOutstandingRewardsPrefix    = keeper.ValidatorOutstandingRewardsPrefix
-	DelegatorWithdrawAddrPrefix          = keeper.DelegatorWithdrawAddrPrefix
-	DelegatorStartingInfoPrefix          = keeper.DelegatorStartingInfoPrefix
-	ValidatorHistoricalRewardsPrefix     = keeper.ValidatorHistoricalRewardsPrefix
-	ValidatorCurrentRewardsPrefix        = keeper.ValidatorCurrentRewardsPrefix
-	ValidatorAccumulatedCommissionPrefix = keeper.ValidatorAccumulatedCommissionPrefix
-	ValidatorSlashEventPrefix            = keeper.ValidatorSlashEventPrefix
-	ParamStoreKeyCommunityTax            = keeper.ParamStoreKeyCommunityTax
-	ParamStoreKeyBaseProposerReward      = keeper.ParamStoreKeyBaseProposerReward
-	ParamStoreKeyBonusProposerReward     = keeper.ParamStoreKeyBonusProposerReward
-	ParamStoreKeyWithdrawAddrEnabled     = keeper.ParamStoreKeyWithdrawAddrEnabled
-	TestAddrs                            = keeper.TestAddrs
-	ModuleCdc                            = types.ModuleCdc
-	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
-	EventTypeRewards                     = types.EventTypeRewards
-	EventTypeCommission                  = types.EventTypeCommission
-	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
-	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
-	EventTypeProposerReward              = types.EventTypeProposerReward
-	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
-	AttributeKeyValidator                = types.AttributeKeyValidator
-	AttributeValueCategory               = types.AttributeValueCategory
-	ProposalHandler                      = client.ProposalHandler
+	FeePoolKey                            = keeper.FeePoolKey
+	ProposerKey                           = keeper.ProposerKey
+	ValidatorOutstandingRewardsPrefix     = keeper.ValidatorOutstandingRewardsPrefix
+	DelegatorWithdrawAddrPrefix           = keeper.DelegatorWithdrawAddrPrefix
+	DelegatorStartingInfoPrefix           = keeper.DelegatorStartingInfoPrefix
+	ValidatorHistoricalRewardsPrefix      = keeper.ValidatorHistoricalRewardsPrefix
+	ValidatorCurrentRewardsPrefix         = keeper.ValidatorCurrentRewardsPrefix
+	ValidatorAccumulatedCommissionPrefix  = keeper.ValidatorAccumulatedCommissionPrefix
+	ValidatorSlashEventPrefix             = keeper.ValidatorSlashEventPrefix
+	DelegatorAutoCompoundPrefix           = keeper.DelegatorAutoCompoundPrefix
+	AutoCompoundCursorKey                 = keeper.AutoCompoundCursorKey
+	SpendStreamPrefix                     = keeper.SpendStreamPrefix
+	SpendStreamQueuePrefix                = keeper.SpendStreamQueuePrefix
+	NextSpendStreamIDKey                  = keeper.NextSpendStreamIDKey
+	DelegatorRewardDestinationsPrefix     = keeper.DelegatorRewardDestinationsPrefix
+	ParamStoreKeyCommunityTax             = keeper.ParamStoreKeyCommunityTax
+	ParamStoreKeyBaseProposerReward       = keeper.ParamStoreKeyBaseProposerReward
+	ParamStoreKeyBonusProposerReward      = keeper.ParamStoreKeyBonusProposerReward
+	ParamStoreKeyWithdrawAddrEnabled      = keeper.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyAutoCompoundInterval     = keeper.ParamStoreKeyAutoCompoundInterval
+	ParamStoreKeyMaxAutoCompoundsPerBlock = keeper.ParamStoreKeyMaxAutoCompoundsPerBlock
+	TestAddrs                             = keeper.TestAddrs
+	ModuleCdc                             = types.ModuleCdc
+	EventTypeSetWithdrawAddress           = types.EventTypeSetWithdrawAddress
+	EventTypeRewards                      = types.EventTypeRewards
+	EventTypeCommission                   = types.EventTypeCommission
+	EventTypeWithdrawRewards              = types.EventTypeWithdrawRewards
+	EventTypeWithdrawCommission           = types.EventTypeWithdrawCommission
+	EventTypeProposerReward               = types.EventTypeProposerReward
+	EventTypeSetAutoCompound              = types.EventTypeSetAutoCompound
+	EventTypeCompoundRewards              = types.EventTypeCompoundRewards
+	EventTypeSpendStreamPayment           = types.EventTypeSpendStreamPayment
+	EventTypeCancelSpendStream            = types.EventTypeCancelSpendStream
+	EventTypeSetRewardDestinations        = types.EventTypeSetRewardDestinations
+	EventTypeClaimRewards                 = types.EventTypeClaimRewards
+	AttributeKeyWithdrawAddress           = types.AttributeKeyWithdrawAddress
+	AttributeKeyValidator                 = types.AttributeKeyValidator
+	AttributeKeyDelegator                 = types.AttributeKeyDelegator
+	AttributeKeyEnabled                   = types.AttributeKeyEnabled
+	AttributeKeySpendStreamID             = types.AttributeKeySpendStreamID
+	AttributeKeyRecipient                 = types.AttributeKeyRecipient
+	AttributeKeyRemaining                 = types.AttributeKeyRemaining
+	AttributeKeyDestinations              = types.AttributeKeyDestinations
+	AttributeKeyClaimer                   = types.AttributeKeyClaimer
+	AttributeValueCategory                = types.AttributeValueCategory
+	ProposalHandler                       = client.ProposalHandler
+	RecurringSpendProposalHandler         = client.RecurringSpendProposalHandler
+	CancelSpendStreamProposalHandler      = client.CancelSpendStreamProposalHandler
 )
 
 type (
 	Hooks                                  = keeper.Hooks
 	Keeper                                 = keeper.Keeper
+	RewardAllocationFn                     = keeper.RewardAllocationFn
 	DelegatorStartingInfo                  = types.DelegatorStartingInfo
 	CodeType                               = types.CodeType
 	FeePool                                = types.FeePool
@@ -145,23 +209,39 @@ type (
 	ValidatorCurrentRewardsRecord          = types.ValidatorCurrentRewardsRecord
 	DelegatorStartingInfoRecord            = types.DelegatorStartingInfoRecord
 	ValidatorSlashEventRecord              = types.ValidatorSlashEventRecord
+	DelegatorAutoCompoundRecord            = types.DelegatorAutoCompoundRecord
+	DelegatorRewardDestinationsRecord      = types.DelegatorRewardDestinationsRecord
 	GenesisState                           = types.GenesisState
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
+	MsgWithdrawAllDelegatorRewards         = types.MsgWithdrawAllDelegatorRewards
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
+	MsgSetAutoCompound                     = types.MsgSetAutoCompound
+	MsgSetRewardDestinations               = types.MsgSetRewardDestinations
+	MsgClaimDelegatorReward                = types.MsgClaimDelegatorReward
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
+	CommunityPoolRecurringSpendProposal    = types.CommunityPoolRecurringSpendProposal
+	CancelCommunityPoolSpendStreamProposal = types.CancelCommunityPoolSpendStreamProposal
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
 	QueryValidatorSlashesParams            = types.QueryValidatorSlashesParams
 	QueryDelegationRewardsParams           = types.QueryDelegationRewardsParams
 	QueryDelegatorParams                   = types.QueryDelegatorParams
 	QueryDelegatorWithdrawAddrParams       = types.QueryDelegatorWithdrawAddrParams
+	QuerySpendStreamParams                 = types.QuerySpendStreamParams
 	QueryDelegatorTotalRewardsResponse     = types.QueryDelegatorTotalRewardsResponse
+	QueryDelegatorAutoCompoundsResponse    = types.QueryDelegatorAutoCompoundsResponse
 	DelegationDelegatorReward              = types.DelegationDelegatorReward
+	WithdrawAllDelegatorRewardsResponse    = types.WithdrawAllDelegatorRewardsResponse
+	DelegationWithdrawnReward              = types.DelegationWithdrawnReward
 	ValidatorHistoricalRewards             = types.ValidatorHistoricalRewards
 	ValidatorCurrentRewards                = types.ValidatorCurrentRewards
 	ValidatorAccumulatedCommission         = types.ValidatorAccumulatedCommission
 	ValidatorSlashEvent                    = types.ValidatorSlashEvent
 	ValidatorSlashEvents                   = types.ValidatorSlashEvents
 	ValidatorOutstandingRewards            = types.ValidatorOutstandingRewards
+	CommunityPoolSpendStream               = types.CommunityPoolSpendStream
+	CommunityPoolSpendStreams              = types.CommunityPoolSpendStreams
+	RewardDestination                      = types.RewardDestination
+	RewardDestinations                     = types.RewardDestinations
 )
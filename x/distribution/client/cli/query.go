@@ -33,6 +33,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryDelegatorAutoCompounds(queryRoute, cdc),
+		GetCmdQueryDelegatorRewardDestinations(queryRoute, cdc),
+		GetCmdQuerySpendStreams(queryRoute, cdc),
+		GetCmdQuerySpendStream(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -262,3 +266,144 @@ $ %s query distr community-pool
 		},
 	}
 }
+
+// GetCmdQueryDelegatorAutoCompounds returns the command for fetching the
+// auto-compounding delegations of a delegator
+func GetCmdQueryDelegatorAutoCompounds(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-compounds [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators of a delegator's auto-compounding delegations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators of all delegations of a delegator that are opted into auto-compounding.
+
+Example:
+$ %s query distr auto-compounds cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryDelegatorAutoCompounds(cliCtx, queryRoute, delAddr)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDelegatorAutoCompoundsResponse
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryDelegatorRewardDestinations returns the command for fetching the
+// destinations the withdrawn rewards of a delegator are split between
+func GetCmdQueryDelegatorRewardDestinations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reward-destinations [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the destinations of a delegator's withdrawn rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the destinations the rewards and commission withdrawn by a delegator are split
+between. An empty list means everything is sent to the delegator's withdraw address.
+
+Example:
+$ %s query distr reward-destinations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryDelegatorRewardDestinations(cliCtx, queryRoute, delAddr)
+			if err != nil {
+				return err
+			}
+
+			var result types.RewardDestinations
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQuerySpendStreams returns the command for fetching the active
+// community pool spend streams
+func GetCmdQuerySpendStreams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "spend-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query the active community pool spend streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all community pool spend streams created by recurring spend proposals
+which still have payments to make.
+
+Example:
+$ %s query distr spend-streams
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QuerySpendStreams(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+
+			var result types.CommunityPoolSpendStreams
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQuerySpendStream returns the command for fetching a community pool
+// spend stream
+func GetCmdQuerySpendStream(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "spend-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a community pool spend stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the recipient, amount, period and remaining payments of a community pool spend stream.
+
+Example:
+$ %s query distr spend-stream 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+
+			res, err := common.QuerySpendStream(cliCtx, queryRoute, streamID)
+			if err != nil {
+				return err
+			}
+
+			var result types.CommunityPoolSpendStream
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
@@ -3,6 +3,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -26,6 +27,7 @@ var (
 	flagIsValidator       = "is-validator"
 	flagComission         = "commission"
 	flagMaxMessagesPerTx  = "max-msgs"
+	flagPerValidator      = "per-validator"
 )
 
 const (
@@ -46,6 +48,9 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
 		GetCmdWithdrawAllRewards(cdc, storeKey),
+		GetCmdSetAutoCompound(cdc),
+		GetCmdSetRewardDestinations(cdc),
+		GetCmdClaimRewards(cdc),
 	)...)
 
 	return distTxCmd
@@ -128,12 +133,20 @@ func GetCmdWithdrawAllRewards(cdc *codec.Codec, queryRoute string) *cobra.Comman
 		Use:   "withdraw-all-rewards",
 		Short: "withdraw all delegations rewards for a delegator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw all rewards for a single delegator.
+			fmt.Sprintf(`Withdraw all rewards for a single delegator in a single message,
+and optionally withdraw validator commission if the delegator is a validator operator.
+
+With --per-validator, one withdraw message is built for each validator the
+delegator is bonded to instead, at most --max-msgs of them per transaction. This
+works against nodes that do not support withdrawing all rewards in a single
+message.
 
 Example:
 $ %s tx distr withdraw-all-rewards --from mykey
+$ %s tx distr withdraw-all-rewards --from mykey --commission
+$ %s tx distr withdraw-all-rewards --from mykey --per-validator --max-msgs 10
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -142,6 +155,10 @@ $ %s tx distr withdraw-all-rewards --from mykey
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			delAddr := cliCtx.GetFromAddress()
+			if !viper.GetBool(flagPerValidator) {
+				msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr, viper.GetBool(flagComission))
+				return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			}
 
 			// The transaction cannot be generated offline since it requires a query
 			// to get all the validators.
@@ -158,8 +175,9 @@ $ %s tx distr withdraw-all-rewards --from mykey
 			return splitAndApply(utils.GenerateOrBroadcastMsgs, cliCtx, txBldr, msgs, chunkSize)
 		},
 	}
-
-	cmd.Flags().Int(flagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	cmd.Flags().Bool(flagComission, false, "also withdraw validator's commission")
+	cmd.Flags().Bool(flagPerValidator, false, "build one withdraw message per validator instead of a single message")
+	cmd.Flags().Int(flagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx with --per-validator (0 for unlimited)")
 	return cmd
 }
 
@@ -195,6 +213,123 @@ $ %s tx set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from m
 	}
 }
 
+// command to opt a delegation into or out of auto-compounding
+func GetCmdSetAutoCompound(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-compound [validator-addr] [enabled]",
+		Short: "enable or disable auto-compounding of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable auto-compounding for a delegation. The rewards of an
+auto-compounding delegation are periodically withdrawn and delegated back to the same
+validator.
+
+Example:
+$ %s tx distr set-auto-compound cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, valAddr, enabled)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// command to split a delegator's withdrawn rewards between destinations
+func GetCmdSetRewardDestinations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-reward-destinations [address:share,...]",
+		Short: "split withdrawn rewards and commission between destination addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Split the rewards and commission withdrawn by a delegator between destination
+addresses, given as a comma separated list of address:share pairs whose shares sum to one.
+The destinations take precedence over the withdraw address. Omitting the destinations
+reverts to sending everything to the withdraw address.
+
+Example:
+$ %s tx distr set-reward-destinations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p:0.8,cosmos1xpr6nnhprg3ljvdr9tfh4hq0rxy5cqmmjqdnvj:0.2 --from mykey
+$ %s tx distr set-reward-destinations --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			destinations := types.RewardDestinations{}
+			if len(args) == 1 {
+				var err error
+				destinations, err = parseRewardDestinations(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetRewardDestinations(cliCtx.GetFromAddress(), destinations)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// command to withdraw the rewards of a delegation on behalf of its delegator
+func GetCmdClaimRewards(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-rewards [delegator-addr] [validator-addr]",
+		Short: "withdraw the rewards of a delegation on behalf of its delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of a delegation on behalf of its delegator. Any account can
+claim the rewards of a delegation; they are paid to the delegator's reward destinations or
+withdraw address exactly as if the delegator had withdrawn them.
+
+Example:
+$ %s tx distr claim-rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimDelegatorReward(cliCtx.GetFromAddress(), delAddr, valAddr)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -254,3 +389,124 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitRecurringSpendProposal implements the command to submit a community-pool-recurring-spend proposal
+func GetCmdSubmitRecurringSpendProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-recurring-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool recurring spend proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool recurring spend proposal along with an initial deposit.
+Once the proposal passes, the amount is paid to the recipient from the community
+pool once every period, in nanoseconds, for the given number of periods. The first
+payment is made one period after the proposal passes. The proposal details must be
+supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-recurring-spend <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Recurring Spend",
+  "description": "Pay me some Atoms every week for a month!",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "period": "604800000000000",
+  "periods": "4",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCommunityPoolRecurringSpendProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCommunityPoolRecurringSpendProposal(
+				proposal.Title, proposal.Description, proposal.Recipient, proposal.Amount, proposal.Period, proposal.Periods,
+			)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelSpendStreamProposal implements the command to submit a cancel-spend-stream proposal
+func GetCmdSubmitCancelSpendStreamProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-spend-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel the remaining payments of a community pool spend stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel the remaining payments of a community pool spend stream
+along with an initial deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-spend-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Spend Stream",
+  "description": "The milestones of the grant were not met",
+  "stream_id": "1",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCancelCommunityPoolSpendStreamProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCancelCommunityPoolSpendStreamProposal(proposal.Title, proposal.Description, proposal.StreamID)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -1,10 +1,14 @@
 package cli
 
 import (
+	"fmt"
 	"io/ioutil"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 type (
@@ -16,6 +20,25 @@ type (
 		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolRecurringSpendProposalJSON defines a CommunityPoolRecurringSpendProposal with a deposit
+	CommunityPoolRecurringSpendProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+		Period      time.Duration  `json:"period" yaml:"period"`
+		Periods     uint64         `json:"periods" yaml:"periods"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// CancelCommunityPoolSpendStreamProposalJSON defines a CancelCommunityPoolSpendStreamProposal with a deposit
+	CancelCommunityPoolSpendStreamProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		StreamID    uint64    `json:"stream_id" yaml:"stream_id"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseCommunityPoolSpendProposalJSON reads and parses a CommunityPoolSpendProposalJSON from a file.
@@ -33,3 +56,60 @@ func ParseCommunityPoolSpendProposalJSON(cdc *codec.Codec, proposalFile string)
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolRecurringSpendProposalJSON reads and parses a CommunityPoolRecurringSpendProposalJSON from a file.
+func ParseCommunityPoolRecurringSpendProposalJSON(cdc *codec.Codec, proposalFile string) (CommunityPoolRecurringSpendProposalJSON, error) {
+	proposal := CommunityPoolRecurringSpendProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelCommunityPoolSpendStreamProposalJSON reads and parses a CancelCommunityPoolSpendStreamProposalJSON from
+// a file.
+func ParseCancelCommunityPoolSpendStreamProposalJSON(cdc *codec.Codec, proposalFile string) (CancelCommunityPoolSpendStreamProposalJSON, error) {
+	proposal := CancelCommunityPoolSpendStreamProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// parseRewardDestinations parses a comma separated list of address:share pairs
+func parseRewardDestinations(s string) (types.RewardDestinations, error) {
+	var destinations types.RewardDestinations
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid reward destination %q, expected address:share", pair)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		share, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		destinations = append(destinations, types.NewRewardDestination(addr, share))
+	}
+	return destinations, nil
+}
@@ -35,8 +35,21 @@ func QueryParams(cliCtx context.CLIContext, queryRoute string) (PrettyParams, er
 		return PrettyParams{}, err
 	}
 
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamAutoCompoundInterval)
+	retAutoCompoundInterval, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamMaxAutoCompoundsPerBlock)
+	retMaxAutoCompoundsPerBlock, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
 	return NewPrettyParams(
 		retCommunityTax, retBaseProposerReward, retBonusProposerReward, retWithdrawAddrEnabled,
+		retAutoCompoundInterval, retMaxAutoCompoundsPerBlock,
 	), nil
 }
 
@@ -83,6 +96,41 @@ func QueryDelegatorValidators(cliCtx context.CLIContext, queryRoute string, dele
 	return res, err
 }
 
+// QueryDelegatorAutoCompounds returns the validators of the delegator's
+// delegations that are opted into auto-compounding.
+func QueryDelegatorAutoCompounds(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorAutoCompounds),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+	return res, err
+}
+
+// QueryDelegatorRewardDestinations returns the destinations the rewards
+// withdrawn by the delegator are split between.
+func QueryDelegatorRewardDestinations(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRewardDestinations),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+	return res, err
+}
+
+// QuerySpendStreams returns the active community pool spend streams.
+func QuerySpendStreams(cliCtx context.CLIContext, queryRoute string) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySpendStreams), nil)
+	return res, err
+}
+
+// QuerySpendStream returns a community pool spend stream.
+func QuerySpendStream(cliCtx context.CLIContext, queryRoute string, streamID uint64) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySpendStream),
+		cliCtx.Codec.MustMarshalJSON(types.NewQuerySpendStreamParams(streamID)),
+	)
+	return res, err
+}
+
 // QueryValidatorCommission returns a validator's commission.
 func QueryValidatorCommission(cliCtx context.CLIContext, queryRoute string, validatorAddr sdk.ValAddress) ([]byte, error) {
 	res, _, err := cliCtx.QueryWithData(
@@ -6,7 +6,13 @@ import (
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 )
 
-// param change proposal handler
+// community pool spend, recurring spend and spend stream cancellation proposal handlers
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ProposalHandler               = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	RecurringSpendProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitRecurringSpendProposal, rest.RecurringSpendProposalRESTHandler,
+	)
+	CancelSpendStreamProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitCancelSpendStreamProposal, rest.CancelSpendStreamProposalRESTHandler,
+	)
 )
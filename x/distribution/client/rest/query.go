@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -33,6 +34,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		delegatorWithdrawalAddrHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the validators of a delegator's auto-compounding delegations
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		delegatorAutoCompoundsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the destinations of a delegator's withdrawn rewards
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/reward_destinations",
+		delegatorRewardDestinationsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Validator distribution information
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
@@ -63,6 +76,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		communityPoolHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the active community pool spend streams
+	r.HandleFunc(
+		"/distribution/community_pool/spend_streams",
+		spendStreamsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get a community pool spend stream
+	r.HandleFunc(
+		"/distribution/community_pool/spend_streams/{streamID}",
+		spendStreamHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query the total rewards balance from all delegations
@@ -126,6 +151,56 @@ func delegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext, queryRoute stri
 	}
 }
 
+// HTTP request handler to query the validators of a delegator's
+// auto-compounding delegations
+func delegatorAutoCompoundsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorAutoCompounds), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the destinations of a delegator's withdrawn
+// rewards
+func delegatorRewardDestinationsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QueryDelegatorRewardDestinations(cliCtx, queryRoute, delegatorAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // ValidatorDistInfo defines the properties of
 // validator distribution information response.
 type ValidatorDistInfo struct {
@@ -250,6 +325,51 @@ func communityPoolHandler(cliCtx context.CLIContext, queryRoute string) http.Han
 	}
 }
 
+// HTTP request handler to query the active community pool spend streams
+func spendStreamsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySpendStreams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query a community pool spend stream
+func spendStreamHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		streamID, err := strconv.ParseUint(mux.Vars(r)["streamID"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQuerySpendStreamParams(streamID))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySpendStream), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // HTTP request handler to query the outstanding rewards
 func outstandingRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
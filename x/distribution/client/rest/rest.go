@@ -51,3 +51,71 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// RecurringSpendProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool recurring spend REST
+// handler with a given sub-route.
+func RecurringSpendProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_recurring_spend",
+		Handler:  postRecurringSpendProposalHandlerFn(cliCtx),
+	}
+}
+
+func postRecurringSpendProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolRecurringSpendProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCommunityPoolRecurringSpendProposal(
+			req.Title, req.Description, req.Recipient, req.Amount, req.Period, req.Periods,
+		)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CancelSpendStreamProposalRESTHandler returns a ProposalRESTHandler that exposes the REST handler of proposals
+// cancelling a community pool spend stream with a given sub-route.
+func CancelSpendStreamProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_spend_stream",
+		Handler:  postCancelSpendStreamProposalHandlerFn(cliCtx),
+	}
+}
+
+func postCancelSpendStreamProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelCommunityPoolSpendStreamProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelCommunityPoolSpendStreamProposal(req.Title, req.Description, req.StreamID)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -18,7 +18,7 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute strin
 	// Withdraw all delegator rewards
 	r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/rewards",
-		withdrawDelegatorRewardsHandlerFn(cliCtx, queryRoute),
+		withdrawDelegatorRewardsHandlerFn(cliCtx),
 	).Methods("POST")
 
 	// Withdraw delegation rewards
@@ -33,6 +33,24 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute strin
 		setDelegatorWithdrawalAddrHandlerFn(cliCtx),
 	).Methods("POST")
 
+	// Claim delegation rewards on behalf of the delegator
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards/{validatorAddr}/claim",
+		claimDelegationRewardsHandlerFn(cliCtx),
+	).Methods("POST")
+
+	// Split the withdrawn rewards between destinations
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/reward_destinations",
+		setDelegatorRewardDestinationsHandlerFn(cliCtx),
+	).Methods("POST")
+
+	// Enable or disable auto-compounding of delegation rewards
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound/{validatorAddr}",
+		setDelegationAutoCompoundHandlerFn(cliCtx),
+	).Methods("POST")
+
 	// Withdraw validator rewards and commission
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
@@ -46,16 +64,31 @@ type (
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	}
 
+	withdrawAllRewardsReq struct {
+		BaseReq            rest.BaseReq `json:"base_req" yaml:"base_req"`
+		WithdrawCommission bool         `json:"withdraw_commission" yaml:"withdraw_commission"`
+	}
+
 	setWithdrawalAddrReq struct {
 		BaseReq         rest.BaseReq   `json:"base_req" yaml:"base_req"`
 		WithdrawAddress sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
 	}
+
+	setRewardDestinationsReq struct {
+		BaseReq      rest.BaseReq             `json:"base_req" yaml:"base_req"`
+		Destinations types.RewardDestinations `json:"destinations" yaml:"destinations"`
+	}
+
+	setAutoCompoundReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Enabled bool         `json:"enabled" yaml:"enabled"`
+	}
 )
 
 // Withdraw delegator rewards
-func withdrawDelegatorRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+func withdrawDelegatorRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req withdrawRewardsReq
+		var req withdrawAllRewardsReq
 		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
 			return
 		}
@@ -71,13 +104,13 @@ func withdrawDelegatorRewardsHandlerFn(cliCtx context.CLIContext, queryRoute str
 			return
 		}
 
-		msgs, err := common.WithdrawAllDelegatorRewards(cliCtx, queryRoute, delAddr)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr, req.WithdrawCommission)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, msgs)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
 
@@ -116,6 +149,77 @@ func withdrawDelegationRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerF
 	}
 }
 
+// Claim delegation rewards on behalf of the delegator
+func claimDelegationRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawRewardsReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		claimerAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		valAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgClaimDelegatorReward(claimerAddr, delAddr, valAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// Split the withdrawn rewards between destinations
+func setDelegatorRewardDestinationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setRewardDestinationsReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetRewardDestinations(delAddr, req.Destinations)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Replace the rewards withdrawal address
 func setDelegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -146,6 +250,41 @@ func setDelegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext) http.Handler
 	}
 }
 
+// Enable or disable auto-compounding of delegation rewards
+func setDelegationAutoCompoundHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setAutoCompoundReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		valAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetAutoCompound(delAddr, valAddr, req.Enabled)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Withdraw validator rewards and commission
 func withdrawValidatorRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
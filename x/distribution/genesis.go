@@ -16,6 +16,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	keeper.SetBaseProposerReward(ctx, data.BaseProposerReward)
 	keeper.SetBonusProposerReward(ctx, data.BonusProposerReward)
 	keeper.SetWithdrawAddrEnabled(ctx, data.WithdrawAddrEnabled)
+	keeper.SetAutoCompoundInterval(ctx, data.AutoCompoundInterval)
+	keeper.SetMaxAutoCompoundsPerBlock(ctx, data.MaxAutoCompoundsPerBlock)
 
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		keeper.SetDelegatorWithdrawAddr(ctx, dwi.DelegatorAddress, dwi.WithdrawAddress)
@@ -40,6 +42,17 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, ac := range data.DelegatorAutoCompounds {
+		keeper.SetDelegatorAutoCompound(ctx, ac.DelegatorAddress, ac.ValidatorAddress)
+	}
+	for _, stream := range data.SpendStreams {
+		keeper.SetSpendStream(ctx, stream)
+		keeper.InsertSpendStreamQueue(ctx, stream)
+	}
+	keeper.SetNextSpendStreamID(ctx, data.NextSpendStreamID)
+	for _, rd := range data.DelegatorRewardDestinations {
+		keeper.SetDelegatorRewardDestinations(ctx, rd.DelegatorAddress, rd.Destinations)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -65,6 +78,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	baseProposerRewards := keeper.GetBaseProposerReward(ctx)
 	bonusProposerRewards := keeper.GetBonusProposerReward(ctx)
 	withdrawAddrEnabled := keeper.GetWithdrawAddrEnabled(ctx)
+	autoCompoundInterval := keeper.GetAutoCompoundInterval(ctx)
+	maxAutoCompoundsPerBlock := keeper.GetMaxAutoCompoundsPerBlock(ctx)
 	dwi := make([]types.DelegatorWithdrawInfo, 0)
 	keeper.IterateDelegatorWithdrawAddrs(ctx, func(del sdk.AccAddress, addr sdk.AccAddress) (stop bool) {
 		dwi = append(dwi, types.DelegatorWithdrawInfo{
@@ -138,6 +153,29 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 			return false
 		},
 	)
+	autoCompounds := make([]types.DelegatorAutoCompoundRecord, 0)
+	keeper.IterateDelegatorAutoCompounds(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			autoCompounds = append(autoCompounds, types.DelegatorAutoCompoundRecord{
+				DelegatorAddress: del,
+				ValidatorAddress: val,
+			})
+			return false
+		},
+	)
+	streams := keeper.GetAllSpendStreams(ctx)
+	nextStreamID := keeper.GetNextSpendStreamID(ctx)
+	rewardDestinations := make([]types.DelegatorRewardDestinationsRecord, 0)
+	keeper.IterateDelegatorRewardDestinations(ctx,
+		func(del sdk.AccAddress, destinations types.RewardDestinations) (stop bool) {
+			rewardDestinations = append(rewardDestinations, types.DelegatorRewardDestinationsRecord{
+				DelegatorAddress: del,
+				Destinations:     destinations,
+			})
+			return false
+		},
+	)
 	return types.NewGenesisState(feePool, communityTax, baseProposerRewards, bonusProposerRewards, withdrawAddrEnabled,
-		dwi, pp, outstanding, acc, his, cur, dels, slashes)
+		autoCompoundInterval, maxAutoCompoundsPerBlock, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompounds,
+		streams, nextStreamID, rewardDestinations)
 }
@@ -20,9 +20,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgWithdrawDelegatorReward:
 			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
 
+		case types.MsgWithdrawAllDelegatorRewards:
+			return handleMsgWithdrawAllDelegatorRewards(ctx, msg, k)
+
 		case types.MsgWithdrawValidatorCommission:
 			return handleMsgWithdrawValidatorCommission(ctx, msg, k)
 
+		case types.MsgSetAutoCompound:
+			return handleMsgSetAutoCompound(ctx, msg, k)
+
+		case types.MsgSetRewardDestinations:
+			return handleMsgSetRewardDestinations(ctx, msg, k)
+
+		case types.MsgClaimDelegatorReward:
+			return handleMsgClaimDelegatorReward(ctx, msg, k)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized distribution message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -66,6 +78,24 @@ func handleMsgWithdrawDelegatorReward(ctx sdk.Context, msg types.MsgWithdrawDele
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgWithdrawAllDelegatorRewards(ctx sdk.Context, msg types.MsgWithdrawAllDelegatorRewards, k keeper.Keeper) sdk.Result {
+	res, err := k.WithdrawAllDelegationRewards(ctx, msg.DelegatorAddress, msg.WithdrawCommission)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	resBz := types.ModuleCdc.MustMarshalBinaryLengthPrefixed(res)
+	return sdk.Result{Data: resBz, Events: ctx.EventManager().Events()}
+}
+
 func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg types.MsgWithdrawValidatorCommission, k keeper.Keeper) sdk.Result {
 	_, err := k.WithdrawValidatorCommission(ctx, msg.ValidatorAddress)
 	if err != nil {
@@ -83,12 +113,75 @@ func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg types.MsgWithdraw
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgSetAutoCompound(ctx sdk.Context, msg types.MsgSetAutoCompound, k keeper.Keeper) sdk.Result {
+	err := k.SetAutoCompound(ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Enabled)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetRewardDestinations(ctx sdk.Context, msg types.MsgSetRewardDestinations, k keeper.Keeper) sdk.Result {
+	err := k.SetRewardDestinations(ctx, msg.DelegatorAddress, msg.Destinations)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgClaimDelegatorReward(ctx sdk.Context, msg types.MsgClaimDelegatorReward, k keeper.Keeper) sdk.Result {
+	_, err := k.WithdrawDelegationRewards(ctx, msg.DelegatorAddress, msg.ValidatorAddress)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimRewards,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimer, msg.ClaimerAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ClaimerAddress.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func NewCommunityPoolSpendProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
 		switch c := content.(type) {
 		case types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
 
+		case types.CommunityPoolRecurringSpendProposal:
+			return keeper.HandleCommunityPoolRecurringSpendProposal(ctx, k, c)
+
+		case types.CancelCommunityPoolSpendStreamProposal:
+			return keeper.HandleCancelCommunityPoolSpendStreamProposal(ctx, k, c)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized distr proposal content type: %T", c)
 			return sdk.ErrUnknownRequest(errMsg)
@@ -0,0 +1,55 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestHandleMsgClaimDelegatorReward(t *testing.T) {
+	ctx, accountKeeper, keeper, stakingKeeper, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(stakingKeeper)
+	hdlr := NewHandler(keeper)
+
+	// fund the module account with the rewards to be allocated
+	rewards := sdk.TokensFromConsensusPower(10)
+	macc := keeper.GetDistributionAccount(ctx)
+	require.NoError(t, macc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards))))
+	supplyKeeper.SetModuleAccount(ctx, macc)
+
+	// create a validator without commission
+	delAddr, claimerAddr := TestAddrs[3], TestAddrs[0]
+	valAddr := sdk.ValAddress(delAddr)
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(),
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	keeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddr),
+		sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, rewards)})
+
+	delBalance := accountKeeper.GetAccount(ctx, delAddr).GetCoins()
+	claimerBalance := accountKeeper.GetAccount(ctx, claimerAddr).GetCoins()
+
+	// the rewards are claimed by a third party but paid to the delegator
+	res := hdlr(ctx, types.NewMsgClaimDelegatorReward(claimerAddr, delAddr, valAddr))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, delBalance.Add(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards))),
+		accountKeeper.GetAccount(ctx, delAddr).GetCoins())
+	require.Equal(t, claimerBalance, accountKeeper.GetAccount(ctx, claimerAddr).GetCoins())
+
+	// claiming a delegation that does not exist fails
+	res = hdlr(ctx, types.NewMsgClaimDelegatorReward(claimerAddr, claimerAddr, valAddr))
+	require.False(t, res.IsOK())
+}
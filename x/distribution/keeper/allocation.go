@@ -10,10 +10,57 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
-// AllocateTokens handles distribution of the collected fees
+// RewardAllocationFn defines the function used by AllocateTokens to split the
+// rewards left after paying the proposer and the community tax between the
+// validators of the previous block. It returns the reward of each vote, in the
+// same order as the votes. Whatever the function does not allocate is funded
+// to the community pool, so the allocated rewards may not exceed the rewards
+// to split. Applications may provide their own function to the AppModule in
+// order to use an alternative allocation, e.g. a reward cap per validator or
+// an uptime bonus based on the x/slashing signing info.
+type RewardAllocationFn func(ctx sdk.Context, k Keeper, rewards sdk.DecCoins, totalPreviousPower int64, previousVotes []abci.VoteInfo) []sdk.DecCoins
+
+// DefaultRewardAllocationFn allocates the rewards proportionally to the voting
+// power of the validators.
+func DefaultRewardAllocationFn(ctx sdk.Context, k Keeper, rewards sdk.DecCoins, totalPreviousPower int64, previousVotes []abci.VoteInfo) []sdk.DecCoins {
+	allocations := make([]sdk.DecCoins, len(previousVotes))
+	for i, vote := range previousVotes {
+		// TODO consider microslashing for missing votes.
+		// ref https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
+		powerFraction := sdk.NewDec(vote.Validator.Power).QuoTruncate(sdk.NewDec(totalPreviousPower))
+		allocations[i] = rewards.MulDecTruncate(powerFraction)
+	}
+	return allocations
+}
+
+// NewEqualFloorRewardAllocationFn returns a RewardAllocationFn splitting the
+// given fraction of the rewards equally between the validators, regardless of
+// their voting power, and the rest proportionally to their voting power.
+func NewEqualFloorRewardAllocationFn(floor sdk.Dec) RewardAllocationFn {
+	if floor.IsNegative() || floor.GT(sdk.OneDec()) {
+		panic(fmt.Sprintf("equal reward floor must be within [0, 1], is %s", floor))
+	}
+
+	return func(ctx sdk.Context, k Keeper, rewards sdk.DecCoins, totalPreviousPower int64, previousVotes []abci.VoteInfo) []sdk.DecCoins {
+		if len(previousVotes) == 0 {
+			return nil
+		}
+
+		equalShare := rewards.MulDecTruncate(floor).QuoDecTruncate(sdk.NewDec(int64(len(previousVotes))))
+		allocations := DefaultRewardAllocationFn(ctx, k, rewards.MulDecTruncate(sdk.OneDec().Sub(floor)), totalPreviousPower, previousVotes)
+		for i := range allocations {
+			allocations[i] = allocations[i].Add(equalShare)
+		}
+		return allocations
+	}
+}
+
+// AllocateTokens handles distribution of the collected fees, splitting the
+// rewards of the validators with the given allocation function
 func (k Keeper) AllocateTokens(
 	ctx sdk.Context, sumPreviousPrecommitPower, totalPreviousPower int64,
 	previousProposer sdk.ConsAddress, previousVotes []abci.VoteInfo,
+	rewardAllocationFn RewardAllocationFn,
 ) {
 
 	logger := k.Logger(ctx)
@@ -81,17 +128,33 @@ func (k Keeper) AllocateTokens(
 	communityTax := k.GetCommunityTax(ctx)
 	voteMultiplier := sdk.OneDec().Sub(proposerMultiplier).Sub(communityTax)
 
-	// allocate tokens proportionally to voting power
+	// allocate tokens to the validators, the allocation function cannot hand
+	// out more than the rewards to split nor take anything from a validator
+	// as that would break the invariants of the module
 	// TODO consider parallelizing later, ref https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
-	for _, vote := range previousVotes {
-		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
+	rewards := feesCollected.MulDecTruncate(voteMultiplier)
+	allocations := rewardAllocationFn(ctx, k, rewards, totalPreviousPower, previousVotes)
+	if len(allocations) != len(previousVotes) {
+		panic(fmt.Sprintf("reward allocation function returned %d allocations for %d votes",
+			len(allocations), len(previousVotes)))
+	}
 
-		// TODO consider microslashing for missing votes.
-		// ref https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
-		powerFraction := sdk.NewDec(vote.Validator.Power).QuoTruncate(sdk.NewDec(totalPreviousPower))
-		reward := feesCollected.MulDecTruncate(voteMultiplier).MulDecTruncate(powerFraction)
-		k.AllocateTokensToValidator(ctx, validator, reward)
-		remaining = remaining.Sub(reward)
+	allocated := sdk.DecCoins{}
+	for i, vote := range previousVotes {
+		if allocations[i].IsAnyNegative() {
+			panic(fmt.Sprintf("reward allocation function allocated negative rewards %s to %s",
+				allocations[i], vote.Validator.Address))
+		}
+		allocated = allocated.Add(allocations[i])
+	}
+	if _, hasNeg := rewards.SafeSub(allocated); hasNeg {
+		panic(fmt.Sprintf("reward allocation function allocated %s, more than the %s to split", allocated, rewards))
+	}
+
+	for i, vote := range previousVotes {
+		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
+		k.AllocateTokensToValidator(ctx, validator, allocations[i])
+		remaining = remaining.Sub(allocations[i])
 	}
 
 	// allocate community funding
@@ -90,7 +90,7 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 			SignedLastBlock: true,
 		},
 	}
-	k.AllocateTokens(ctx, 200, 200, valConsAddr2, votes)
+	k.AllocateTokens(ctx, 200, 200, valConsAddr2, votes, DefaultRewardAllocationFn)
 
 	// 98 outstanding rewards (100 less 2 to community pool)
 	require.Equal(t, sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDecWithPrec(465, 1)}}, k.GetValidatorOutstandingRewards(ctx, valOpAddr1))
@@ -178,9 +178,73 @@ func TestAllocateTokensTruncation(t *testing.T) {
 			SignedLastBlock: true,
 		},
 	}
-	k.AllocateTokens(ctx, 31, 31, valConsAddr2, votes)
+	k.AllocateTokens(ctx, 31, 31, valConsAddr2, votes, DefaultRewardAllocationFn)
 
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr1).IsValid())
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr2).IsValid())
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr3).IsValid())
 }
+
+func TestAllocateTokensRewardAllocationFn(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create two validators with 0% commission
+	commission := staking.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	msg = staking.NewMsgCreateValidator(valOpAddr2, valConsPk2,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk1.Address(), Power: 300},
+			SignedLastBlock: true,
+		},
+		{
+			Validator:       abci.Validator{Address: valConsPk2.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+	}
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	feeCollector := supplyKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+	require.NoError(t, feeCollector.SetCoins(fees))
+	ak.SetAccount(ctx, feeCollector)
+
+	// half of the 93 rewards left after the proposer reward and community
+	// tax are split equally, the other half by voting power
+	k.AllocateTokens(ctx, 400, 400, valConsAddr2, votes, NewEqualFloorRewardAllocationFn(sdk.NewDecWithPrec(5, 1)))
+
+	// 23.25 + 0.75 * 46.5 = 58.125
+	require.Equal(t, sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDecWithPrec(58125, 3)}}, k.GetValidatorOutstandingRewards(ctx, valOpAddr1))
+	// 5 proposer reward + 23.25 + 0.25 * 46.5 = 39.875
+	require.Equal(t, sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDecWithPrec(39875, 3)}}, k.GetValidatorOutstandingRewards(ctx, valOpAddr2))
+	require.Equal(t, sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDec(2)}}, k.GetFeePool(ctx).CommunityPool)
+	_, broken := AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	// rewards not allocated by the function are funded to the community pool
+	require.NoError(t, feeCollector.SetCoins(fees))
+	ak.SetAccount(ctx, feeCollector)
+	nothing := func(_ sdk.Context, _ Keeper, _ sdk.DecCoins, _ int64, votes []abci.VoteInfo) []sdk.DecCoins {
+		return make([]sdk.DecCoins, len(votes))
+	}
+	k.AllocateTokens(ctx, 400, 400, valConsAddr2, votes, nothing)
+	require.Equal(t, sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDec(97)}}, k.GetFeePool(ctx).CommunityPool)
+	_, broken = AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	// allocating more than the rewards to split panics
+	require.NoError(t, feeCollector.SetCoins(fees))
+	ak.SetAccount(ctx, feeCollector)
+	tooMuch := func(_ sdk.Context, _ Keeper, rewards sdk.DecCoins, _ int64, votes []abci.VoteInfo) []sdk.DecCoins {
+		allocations := make([]sdk.DecCoins, len(votes))
+		for i := range allocations {
+			allocations[i] = rewards
+		}
+		return allocations
+	}
+	require.Panics(t, func() { k.AllocateTokens(ctx, 400, 400, valConsAddr2, votes, tooMuch) })
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SetAutoCompound opts a delegation into or out of auto-compounding
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) sdk.Error {
+	if enabled {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegationDistInfo(k.codespace)
+		}
+		k.SetDelegatorAutoCompound(ctx, delAddr, valAddr)
+	} else {
+		k.DeleteDelegatorAutoCompound(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// CompoundDelegationRewards withdraws the rewards of a delegation and
+// delegates the bond denom portion back to the same validator. Rewards in
+// any other denomination are sent to the delegator's reward destinations.
+func (k Keeper) CompoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, sdk.Error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorDistInfo(k.codespace)
+	}
+
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if del == nil {
+		return sdk.Coin{}, types.ErrNoDelegationDistInfo(k.codespace)
+	}
+
+	// the compounded rewards are paid to the delegator itself so that they can
+	// be delegated from its account
+	compounded := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	_, err := k.withdrawDelegationRewardsWith(ctx, validator, del, func(coins sdk.Coins) sdk.Error {
+		compounded.Amount = coins.AmountOf(compounded.Denom)
+		bonded := sdk.NewCoins(compounded)
+		if !bonded.IsZero() {
+			err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, bonded)
+			if err != nil {
+				return err
+			}
+		}
+
+		rest := coins.Sub(bonded)
+		if rest.IsZero() {
+			return nil
+		}
+		return k.sendRewards(ctx, delAddr, rest)
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// reinitialize the delegation
+	k.initializeDelegation(ctx, valAddr, delAddr)
+
+	if compounded.IsPositive() {
+		_, err := k.stakingKeeper.Delegate(ctx, delAddr, compounded.Amount, sdk.Unbonded, validator, true)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return compounded, nil
+}
+
+// ProcessAutoCompounds compounds the rewards of the delegations opted into
+// auto-compounding. A pass over all such delegations starts every
+// AutoCompoundInterval blocks and compounds at most MaxAutoCompoundsPerBlock
+// delegations per block, resuming from a stored cursor on the next block
+// until every delegation has been visited.
+func (k Keeper) ProcessAutoCompounds(ctx sdk.Context) {
+	interval := k.GetAutoCompoundInterval(ctx)
+	maxPerBlock := k.GetMaxAutoCompoundsPerBlock(ctx)
+	if interval <= 0 || maxPerBlock == 0 {
+		return
+	}
+
+	start, inProgress := k.GetAutoCompoundCursor(ctx)
+	if !inProgress {
+		if ctx.BlockHeight()%interval != 0 {
+			return
+		}
+		start = DelegatorAutoCompoundPrefix
+	}
+
+	// collect this block's batch before compounding, as compounding writes to
+	// the store being iterated
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(DelegatorAutoCompoundPrefix))
+	var batch [][]byte
+	for ; iter.Valid() && uint32(len(batch)) < maxPerBlock; iter.Next() {
+		batch = append(batch, iter.Key())
+	}
+	var next []byte
+	if iter.Valid() {
+		next = iter.Key()
+	}
+	iter.Close()
+
+	for _, key := range batch {
+		delAddr, valAddr := GetDelegatorAutoCompoundAddresses(key)
+
+		// compound in a cached context so that a failed delegation does not
+		// leave the rewards withdrawn
+		cacheCtx, writeCache := ctx.CacheContext()
+		compounded, err := k.CompoundDelegationRewards(cacheCtx, delAddr, valAddr)
+		if err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("failed to compound rewards of delegator %s with validator %s: %s",
+				delAddr, valAddr, err.Error()))
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompoundRewards,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			),
+		)
+	}
+
+	if next == nil {
+		k.DeleteAutoCompoundCursor(ctx)
+	} else {
+		k.SetAutoCompoundCursor(ctx, next)
+	}
+}
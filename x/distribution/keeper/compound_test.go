@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestProcessAutoCompounds(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// compound every 10 blocks, one delegation per block
+	k.SetAutoCompoundInterval(ctx, 10)
+	k.SetMaxAutoCompoundsPerBlock(ctx, 1)
+
+	// create validator with 50% commission and two delegators of equal stake
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+	for _, delAddr := range []sdk.AccAddress{delAddr1, delAddr2} {
+		delMsg := staking.NewMsgDelegate(delAddr, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, valTokens))
+		require.True(t, sh(ctx, delMsg).IsOK())
+	}
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// only existing delegations can be opted into auto-compounding
+	require.NotNil(t, k.SetAutoCompound(ctx, delAddr3, valOpAddr1, true))
+	require.Nil(t, k.SetAutoCompound(ctx, delAddr1, valOpAddr1, true))
+	require.Nil(t, k.SetAutoCompound(ctx, delAddr2, valOpAddr1, true))
+	require.True(t, k.HasDelegatorAutoCompound(ctx, delAddr1, valOpAddr1))
+
+	// allocate rewards, 5 power worth of tokens for each delegation
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(30))})
+	reward := sdk.TokensFromConsensusPower(5)
+
+	// nothing happens outside of the interval
+	ctx = ctx.WithBlockHeight(9)
+	k.ProcessAutoCompounds(ctx)
+	_, found := k.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+
+	// the pass starts with a single delegation
+	ctx = ctx.WithBlockHeight(10)
+	k.ProcessAutoCompounds(ctx)
+	_, found = k.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+
+	compounded := 0
+	for _, delAddr := range []sdk.AccAddress{delAddr1, delAddr2} {
+		del, _ := sk.GetDelegation(ctx, delAddr, valOpAddr1)
+		if del.Shares.Equal(valTokens.Add(reward).ToDec()) {
+			compounded++
+		}
+	}
+	require.Equal(t, 1, compounded)
+
+	// the pass completes on the next block
+	ctx = ctx.WithBlockHeight(11)
+	k.ProcessAutoCompounds(ctx)
+	_, found = k.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+
+	for _, delAddr := range []sdk.AccAddress{delAddr1, delAddr2} {
+		del, _ := sk.GetDelegation(ctx, delAddr, valOpAddr1)
+		require.Equal(t, valTokens.Add(reward).ToDec(), del.Shares)
+
+		// the rewards were delegated, not left in the account
+		require.Equal(t,
+			sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Sub(valTokens))},
+			ak.GetAccount(ctx, delAddr).GetCoins(),
+		)
+	}
+
+	// opting out stops auto-compounding
+	require.Nil(t, k.SetAutoCompound(ctx, delAddr1, valOpAddr1, false))
+	require.False(t, k.HasDelegatorAutoCompound(ctx, delAddr1, valOpAddr1))
+
+	// removing the delegation removes the auto-compound record
+	undelMsg := staking.NewMsgUndelegate(delAddr2, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, valTokens.Add(reward)))
+	require.True(t, sh(ctx, undelMsg).IsOK())
+	require.False(t, k.HasDelegatorAutoCompound(ctx, delAddr2, valOpAddr1))
+}
@@ -137,6 +137,16 @@ func (k Keeper) calculateDelegationRewards(ctx sdk.Context, val exported.Validat
 }
 
 func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.ValidatorI, del exported.DelegationI) (sdk.Coins, sdk.Error) {
+	return k.withdrawDelegationRewardsWith(ctx, val, del, func(coins sdk.Coins) sdk.Error {
+		return k.sendRewards(ctx, del.GetDelegatorAddr(), coins)
+	})
+}
+
+// withdraw rewards from a delegation, handing the non-zero truncated rewards
+// to payout instead of sending them to the delegator's reward destinations
+func (k Keeper) withdrawDelegationRewardsWith(ctx sdk.Context, val exported.ValidatorI, del exported.DelegationI,
+	payout func(coins sdk.Coins) sdk.Error) (sdk.Coins, sdk.Error) {
+
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
 		return nil, types.ErrNoDelegationDistInfo(k.codespace)
@@ -162,9 +172,7 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 
 	// add coins to user account
 	if !coins.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
-		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
-		if err != nil {
+		if err := payout(coins); err != nil {
 			return nil, err
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -105,6 +106,53 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// withdraw the rewards of all delegations of a delegator and, optionally, the
+// commission of the validator operated by the delegator
+func (k Keeper) WithdrawAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress,
+	withdrawCommission bool) (types.WithdrawAllDelegatorRewardsResponse, sdk.Error) {
+
+	res := types.NewWithdrawAllDelegatorRewardsResponse([]types.DelegationWithdrawnReward{}, sdk.Coins{}, sdk.Coins{})
+
+	// collect the validators before withdrawing so that no staking store
+	// iterator is held open while the rewards are paid out
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del exported.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+
+	for _, valAddr := range valAddrs {
+		ctx.GasMeter().ConsumeGas(types.GasWithdrawDelegationRewards, "withdraw delegation rewards")
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return types.WithdrawAllDelegatorRewardsResponse{}, err
+		}
+		res.Rewards = append(res.Rewards, types.NewDelegationWithdrawnReward(valAddr, rewards))
+		res.Total = res.Total.Add(rewards)
+	}
+
+	if withdrawCommission {
+		valAddr := sdk.ValAddress(delAddr)
+		if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+			return types.WithdrawAllDelegatorRewardsResponse{}, types.ErrNoValidatorDistInfo(k.codespace)
+		}
+
+		// having no commission to withdraw is not an error here, as the
+		// delegation rewards may still have been withdrawn
+		if !k.GetValidatorAccumulatedCommission(ctx, valAddr).IsZero() {
+			commission, err := k.WithdrawValidatorCommission(ctx, valAddr)
+			if err != nil {
+				return types.WithdrawAllDelegatorRewardsResponse{}, err
+			}
+			res.Commission = commission
+			res.Total = res.Total.Add(commission)
+		}
+	}
+
+	return res, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, sdk.Error) {
 	// fetch validator accumulated commission
@@ -121,9 +169,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding.Sub(sdk.NewDecCoins(commission)))
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+		err := k.sendRewards(ctx, sdk.AccAddress(valAddr), commission)
 		if err != nil {
 			return nil, err
 		}
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -89,3 +91,93 @@ func TestGetTotalRewards(t *testing.T) {
 
 	require.Equal(t, expectedRewards, totalRewards)
 }
+
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, keeper, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := keeper.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	keeper.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create two validators with 50% commission, the first operator also
+	// delegating to the second validator
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1, sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	msg = staking.NewMsgCreateValidator(valOpAddr2, valConsPk2, sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	delAddr := sdk.AccAddress(valOpAddr1)
+	delMsg := staking.NewMsgDelegate(delAddr, valOpAddr2, sdk.NewCoin(sdk.DefaultBondDenom, valTokens))
+	require.True(t, sh(ctx, delMsg).IsOK())
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate rewards to both validators
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	keeper.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	keeper.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr2), tokens)
+
+	// commission can only be withdrawn by a validator operator
+	_, err := keeper.WithdrawAllDelegationRewards(ctx, delAddr3, true)
+	require.NotNil(t, err)
+
+	// withdraw rewards from both delegations along with the commission
+	gasBefore := ctx.GasMeter().GasConsumed()
+	res, err := keeper.WithdrawAllDelegationRewards(ctx, delAddr, true)
+	require.Nil(t, err)
+	require.True(t, ctx.GasMeter().GasConsumed()-gasBefore >= 2*types.GasWithdrawDelegationRewards)
+
+	// half of the first validator's rewards are commission, the delegation
+	// to the second validator holds half of its stake
+	require.Len(t, res.Rewards, 2)
+	selfReward := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2)))
+	delReward := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(4)))
+	for _, reward := range res.Rewards {
+		if reward.ValidatorAddress.Equals(valOpAddr1) {
+			require.Equal(t, selfReward, reward.Reward)
+		} else {
+			require.Equal(t, valOpAddr2, reward.ValidatorAddress)
+			require.Equal(t, delReward, reward.Reward)
+		}
+	}
+	require.Equal(t, selfReward, res.Commission)
+	require.Equal(t, selfReward.Add(delReward).Add(selfReward), res.Total)
+
+	// assert correct balance
+	exp := balanceTokens.Sub(valTokens.MulRaw(2)).Add(res.Total.AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp)},
+		ak.GetAccount(ctx, delAddr).GetCoins(),
+	)
+}
+
+func TestUpdateRewardParams(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+	keeper.SetCommunityTax(ctx, sdk.NewDecWithPrec(5, 1))
+	keeper.SetBaseProposerReward(ctx, sdk.NewDecWithPrec(2, 1))
+	keeper.SetBonusProposerReward(ctx, sdk.NewDecWithPrec(2, 1))
+
+	// the proposer rewards cannot exceed the fees remaining after the community tax
+	err := keeper.paramSpace.Update(ctx, ParamStoreKeyBonusProposerReward, []byte(`"0.400000000000000000"`))
+	require.Error(t, err)
+	err = keeper.paramSpace.Update(ctx, ParamStoreKeyCommunityTax, []byte(`"0.700000000000000000"`))
+	require.Error(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), keeper.GetBonusProposerReward(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), keeper.GetCommunityTax(ctx))
+
+	err = keeper.paramSpace.Update(ctx, ParamStoreKeyBonusProposerReward, []byte(`"0.300000000000000000"`))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), keeper.GetBonusProposerReward(ctx))
+}
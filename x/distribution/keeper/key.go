@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -32,6 +33,18 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes><valAddr_Bytes>: AutoCompound
+//
+// - 0x0A: AutoCompoundCursor
+//
+// - 0x0B<streamID_Bytes>: CommunityPoolSpendStream
+//
+// - 0x0C<time_Bytes><streamID_Bytes>: nil
+//
+// - 0x0D: NextSpendStreamID
+//
+// - 0x0E<accAddr_Bytes>: RewardDestinations
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -43,11 +56,20 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorAutoCompoundPrefix          = []byte{0x09} // key for delegations opted into auto-compounding
+	AutoCompoundCursorKey                = []byte{0x0A} // key for the next auto-compound entry of the pass in progress
+	SpendStreamPrefix                    = []byte{0x0B} // key for community pool spend streams
+	SpendStreamQueuePrefix               = []byte{0x0C} // key for community pool spend streams by next payment time
+	NextSpendStreamIDKey                 = []byte{0x0D} // key for the ID of the next community pool spend stream
+	DelegatorRewardDestinationsPrefix    = []byte{0x0E} // key for the destinations of a delegator's withdrawn rewards
 
 	ParamStoreKeyCommunityTax        = []byte("communitytax")
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoCompoundInterval     = []byte("autocompoundinterval")
+	ParamStoreKeyMaxAutoCompoundsPerBlock = []byte("maxautocompoundsperblock")
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -68,6 +90,15 @@ func GetDelegatorWithdrawInfoAddress(key []byte) (delAddr sdk.AccAddress) {
 	return sdk.AccAddress(addr)
 }
 
+// gets the address from a delegator's reward destinations key
+func GetDelegatorRewardDestinationsAddress(key []byte) (delAddr sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
 // gets the addresses from a delegator starting info key
 func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
 	addr := key[1 : 1+sdk.AddrLen]
@@ -129,6 +160,27 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// gets the addresses from a delegator auto-compound key
+func GetDelegatorAutoCompoundAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
+// gets the stream ID from a community pool spend stream queue key
+func GetSpendStreamQueueStreamID(key []byte) (streamID uint64) {
+	b := key[len(key)-8:]
+	return binary.BigEndian.Uint64(b)
+}
+
 // gets the outstanding rewards key for a validator
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, valAddr.Bytes()...)
@@ -139,6 +191,11 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
 }
 
+// gets the key for a delegator's reward destinations
+func GetDelegatorRewardDestinationsKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorRewardDestinationsPrefix, delAddr.Bytes()...)
+}
+
 // gets the key for a delegator's starting info
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, v.Bytes()...), d.Bytes()...)
@@ -191,3 +248,34 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the prefix key for all of a delegator's auto-compounding delegations
+func GetDelegatorAutoCompoundPrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorAutoCompoundPrefix, d.Bytes()...)
+}
+
+// gets the key for a delegation opted into auto-compounding
+func GetDelegatorAutoCompoundKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoCompoundPrefix(d), v.Bytes()...)
+}
+
+// gets the key for a community pool spend stream
+func GetSpendStreamKey(streamID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, streamID)
+	return append(SpendStreamPrefix, b...)
+}
+
+// gets the prefix key for the community pool spend streams with a payment due
+// at a given time
+func GetSpendStreamQueueTimeKey(paymentTime time.Time) []byte {
+	return append(SpendStreamQueuePrefix, sdk.FormatTimeBytes(paymentTime)...)
+}
+
+// gets the queue key for a community pool spend stream with a payment due at
+// a given time
+func GetSpendStreamQueueKey(paymentTime time.Time, streamID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, streamID)
+	return append(GetSpendStreamQueueTimeKey(paymentTime), b...)
+}
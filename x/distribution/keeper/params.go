@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
@@ -8,11 +10,43 @@ import (
 // type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeyCommunityTax, sdk.Dec{},
-		ParamStoreKeyBaseProposerReward, sdk.Dec{},
-		ParamStoreKeyBonusProposerReward, sdk.Dec{},
-		ParamStoreKeyWithdrawAddrEnabled, false,
-	)
+		params.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, false, validateWithdrawAddrEnabled),
+		params.NewParamSetPair(ParamStoreKeyAutoCompoundInterval, int64(0), validateAutoCompoundInterval),
+		params.NewParamSetPair(ParamStoreKeyMaxAutoCompoundsPerBlock, uint32(0), validateMaxAutoCompoundsPerBlock),
+	).RegisterParamSet(&rewardParams{})
+}
+
+// rewardParams are the parameters splitting the collected fees, which are
+// validated together on update as the proposer rewards are paid out of the
+// fees remaining after the community tax
+type rewardParams struct {
+	CommunityTax        sdk.Dec
+	BaseProposerReward  sdk.Dec
+	BonusProposerReward sdk.Dec
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *rewardParams) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyCommunityTax, &p.CommunityTax, validateCommunityTax),
+		params.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
+		params.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
+	}
+}
+
+// Validate implements params.ParamSetValidator
+func (p *rewardParams) Validate() error {
+	// parameters not set yet are validated on their own when set
+	if p.CommunityTax.IsNil() || p.BaseProposerReward.IsNil() || p.BonusProposerReward.IsNil() {
+		return nil
+	}
+
+	proposerReward := p.BaseProposerReward.Add(p.BonusProposerReward)
+	if proposerReward.GT(sdk.OneDec().Sub(p.CommunityTax)) {
+		return fmt.Errorf("distribution parameters BaseProposerReward and BonusProposerReward add to %s, "+
+			"more than one minus CommunityTax %s", proposerReward, p.CommunityTax)
+	}
+	return nil
 }
 
 // returns the current CommunityTax rate from the global param store
@@ -66,3 +100,77 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
 func (k Keeper) SetWithdrawAddrEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled)
 }
+
+// returns the number of blocks between auto-compounding passes
+// nolint: errcheck
+func (k Keeper) GetAutoCompoundInterval(ctx sdk.Context) int64 {
+	var interval int64
+	k.paramSpace.Get(ctx, ParamStoreKeyAutoCompoundInterval, &interval)
+	return interval
+}
+
+// nolint: errcheck
+func (k Keeper) SetAutoCompoundInterval(ctx sdk.Context, interval int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyAutoCompoundInterval, &interval)
+}
+
+// returns the maximum number of delegations compounded in a single block
+// nolint: errcheck
+func (k Keeper) GetMaxAutoCompoundsPerBlock(ctx sdk.Context) uint32 {
+	var max uint32
+	k.paramSpace.Get(ctx, ParamStoreKeyMaxAutoCompoundsPerBlock, &max)
+	return max
+}
+
+// nolint: errcheck
+func (k Keeper) SetMaxAutoCompoundsPerBlock(ctx sdk.Context, max uint32) {
+	k.paramSpace.Set(ctx, ParamStoreKeyMaxAutoCompoundsPerBlock, &max)
+}
+
+func validateRate(name string, i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameter %s must be between 0 and 1, is %s", name, v)
+	}
+	return nil
+}
+
+func validateCommunityTax(i interface{}) error {
+	return validateRate("CommunityTax", i)
+}
+
+func validateBaseProposerReward(i interface{}) error {
+	return validateRate("BaseProposerReward", i)
+}
+
+func validateBonusProposerReward(i interface{}) error {
+	return validateRate("BonusProposerReward", i)
+}
+
+func validateWithdrawAddrEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAutoCompoundInterval(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundInterval should be non-negative, is %d", v)
+	}
+	return nil
+}
+
+func validateMaxAutoCompoundsPerBlock(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // HandleCommunityPoolSpendProposal is a handler for executing a passed community spend proposal
@@ -22,3 +23,31 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p types.Communi
 	logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
 }
+
+// HandleCommunityPoolRecurringSpendProposal is a handler for executing a passed community pool recurring spend
+// proposal, creating the spend stream making its payments
+func HandleCommunityPoolRecurringSpendProposal(ctx sdk.Context, k Keeper, p types.CommunityPoolRecurringSpendProposal) sdk.Error {
+	if k.blacklistedAddrs[p.Recipient.String()] {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is blacklisted from receiving external funds", p.Recipient))
+	}
+
+	proposalID, _ := govtypes.ProposalIDFromContext(ctx)
+	stream := k.CreateSpendStream(ctx, proposalID, p.Recipient, p.Amount, p.Period, p.Periods)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("created community pool spend stream %d paying %s to recipient %s every %s, %d times",
+		stream.ID, p.Amount, p.Recipient, p.Period, p.Periods))
+	return nil
+}
+
+// HandleCancelCommunityPoolSpendStreamProposal is a handler for executing a passed proposal cancelling a community
+// pool spend stream
+func HandleCancelCommunityPoolSpendStreamProposal(ctx sdk.Context, k Keeper, p types.CancelCommunityPoolSpendStreamProposal) sdk.Error {
+	if err := k.CancelSpendStream(ctx, p.StreamID); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled community pool spend stream %d", p.StreamID))
+	return nil
+}
@@ -42,6 +42,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryDelegatorAutoCompounds:
+			return queryDelegatorAutoCompounds(ctx, path[1:], req, k)
+
+		case types.QuerySpendStreams:
+			return querySpendStreams(ctx, path[1:], req, k)
+
+		case types.QuerySpendStream:
+			return querySpendStream(ctx, path[1:], req, k)
+
+		case types.QueryRewardDestinations:
+			return queryDelegatorRewardDestinations(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
 		}
@@ -74,6 +86,18 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case types.ParamAutoCompoundInterval:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetAutoCompoundInterval(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case types.ParamMaxAutoCompoundsPerBlock:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetMaxAutoCompoundsPerBlock(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -241,6 +265,25 @@ func queryDelegatorWithdrawAddress(ctx sdk.Context, _ []string, req abci.Request
 	return bz, nil
 }
 
+func queryDelegatorRewardDestinations(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	destinations, found := k.GetDelegatorRewardDestinations(ctx, params.DelegatorAddress)
+	if !found {
+		destinations = types.RewardDestinations{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, destinations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	bz, err := k.cdc.MarshalJSON(k.GetFeePoolCommunityCoins(ctx))
 	if err != nil {
@@ -248,3 +291,50 @@ func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Ke
 	}
 	return bz, nil
 }
+
+func queryDelegatorAutoCompounds(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	validators := make(types.QueryDelegatorAutoCompoundsResponse, 0)
+	k.IterateDelegatorAutoCompoundsByDelegator(ctx, params.DelegatorAddress, func(val sdk.ValAddress) (stop bool) {
+		validators = append(validators, val)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, validators)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySpendStreams(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetAllSpendStreams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySpendStream(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySpendStreamParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	stream, found := k.GetSpendStream(ctx, params.StreamID)
+	if !found {
+		return nil, types.ErrUnknownSpendStream(k.codespace, params.StreamID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, stream)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
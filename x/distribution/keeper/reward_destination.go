@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SetRewardDestinations sets the destinations the rewards and commission
+// withdrawn by a delegator are split between. Setting no destinations reverts
+// to sending everything to the delegator's withdraw address.
+func (k Keeper) SetRewardDestinations(ctx sdk.Context, delAddr sdk.AccAddress, destinations types.RewardDestinations) sdk.Error {
+	if !k.GetWithdrawAddrEnabled(ctx) {
+		return types.ErrSetWithdrawAddrDisabled(k.codespace)
+	}
+
+	if len(destinations) == 0 {
+		k.DeleteDelegatorRewardDestinations(ctx, delAddr)
+	} else {
+		if err := destinations.Validate(); err != nil {
+			return types.ErrInvalidRewardDestinations(k.codespace, err.Error())
+		}
+		for _, d := range destinations {
+			if k.blacklistedAddrs[d.Address.String()] {
+				return sdk.ErrUnauthorized(fmt.Sprintf("%s is blacklisted from receiving external funds", d.Address))
+			}
+		}
+		k.SetDelegatorRewardDestinations(ctx, delAddr, destinations)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRewardDestinations,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDestinations, destinations.String()),
+		),
+	)
+	return nil
+}
+
+// sendRewards pays rewards or commission withdrawn by a delegator, splitting
+// them between the delegator's reward destinations if any are set, or sending
+// them to its withdraw address otherwise
+func (k Keeper) sendRewards(ctx sdk.Context, delAddr sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	destinations, found := k.GetDelegatorRewardDestinations(ctx, delAddr)
+	if !found {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+	}
+
+	for i, amount := range destinations.Split(coins) {
+		if amount.IsZero() {
+			continue
+		}
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, destinations[i].Address, amount)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
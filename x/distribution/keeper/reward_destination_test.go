@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestWithdrawRewardsToDestinations(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+	staking.EndBlocker(ctx, sk)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	destA := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	destB := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	balance := func(addr sdk.AccAddress) sdk.Int {
+		acc := ak.GetAccount(ctx, addr)
+		if acc == nil {
+			return sdk.ZeroInt()
+		}
+		return acc.GetCoins().AmountOf(sdk.DefaultBondDenom)
+	}
+
+	delAddr := sdk.AccAddress(valOpAddr1)
+	destinations := types.RewardDestinations{
+		types.NewRewardDestination(destA, sdk.NewDecWithPrec(8, 1)),
+		types.NewRewardDestination(destB, sdk.NewDecWithPrec(2, 1)),
+	}
+
+	k.SetWithdrawAddrEnabled(ctx, true)
+
+	// destinations must be valid and not blacklisted
+	require.NotNil(t, k.SetRewardDestinations(ctx, delAddr, destinations[:1]))
+	blacklisted := types.RewardDestinations{
+		types.NewRewardDestination(destA, sdk.NewDecWithPrec(5, 1)),
+		types.NewRewardDestination(k.GetDistributionAccount(ctx).GetAddress(), sdk.NewDecWithPrec(5, 1)),
+	}
+	require.NotNil(t, k.SetRewardDestinations(ctx, delAddr, blacklisted))
+
+	// destinations cannot be set while withdraw addresses are disabled
+	k.SetWithdrawAddrEnabled(ctx, false)
+	require.NotNil(t, k.SetRewardDestinations(ctx, delAddr, destinations))
+	k.SetWithdrawAddrEnabled(ctx, true)
+
+	require.Nil(t, k.SetRewardDestinations(ctx, delAddr, destinations))
+	stored, found := k.GetDelegatorRewardDestinations(ctx, delAddr)
+	require.True(t, found)
+	require.Equal(t, destinations, stored)
+
+	// allocate rewards, half of which is commission
+	initial := sdk.TokensFromConsensusPower(10)
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// withdrawn rewards are split between the destinations
+	_, err := k.WithdrawDelegationRewards(ctx, delAddr, valOpAddr1)
+	require.Nil(t, err)
+	reward := initial.QuoRaw(2)
+	require.Equal(t, reward.MulRaw(8).QuoRaw(10), balance(destA))
+	require.Equal(t, reward.MulRaw(2).QuoRaw(10), balance(destB))
+	require.Equal(t, balanceTokens.Sub(valTokens), balance(delAddr))
+
+	// so is the withdrawn commission
+	_, err = k.WithdrawValidatorCommission(ctx, valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, initial.MulRaw(8).QuoRaw(10), balance(destA))
+	require.Equal(t, initial.MulRaw(2).QuoRaw(10), balance(destB))
+
+	// clearing the destinations reverts to the withdraw address
+	require.Nil(t, k.SetRewardDestinations(ctx, delAddr, types.RewardDestinations{}))
+	_, found = k.GetDelegatorRewardDestinations(ctx, delAddr)
+	require.False(t, found)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val = sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	_, err = k.WithdrawDelegationRewards(ctx, delAddr, valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, balanceTokens.Sub(valTokens).Add(reward), balance(delAddr))
+	require.Equal(t, initial.MulRaw(8).QuoRaw(10), balance(destA))
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// CreateSpendStream creates a community pool spend stream paying amount to
+// the recipient once every period, for the given number of periods. The first
+// payment is due one period after the stream is created.
+func (k Keeper) CreateSpendStream(ctx sdk.Context, proposalID uint64, recipient sdk.AccAddress, amount sdk.Coins,
+	period time.Duration, periods uint64) types.CommunityPoolSpendStream {
+
+	streamID := k.GetNextSpendStreamID(ctx)
+	stream := types.NewCommunityPoolSpendStream(
+		streamID, proposalID, recipient, amount, period, periods, ctx.BlockHeader().Time.Add(period),
+	)
+
+	k.SetSpendStream(ctx, stream)
+	k.InsertSpendStreamQueue(ctx, stream)
+	k.SetNextSpendStreamID(ctx, streamID+1)
+	return stream
+}
+
+// CancelSpendStream removes a community pool spend stream, cancelling its
+// remaining payments
+func (k Keeper) CancelSpendStream(ctx sdk.Context, streamID uint64) sdk.Error {
+	stream, found := k.GetSpendStream(ctx, streamID)
+	if !found {
+		return types.ErrUnknownSpendStream(k.codespace, streamID)
+	}
+
+	k.RemoveFromSpendStreamQueue(ctx, stream)
+	k.DeleteSpendStream(ctx, streamID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelSpendStream,
+			sdk.NewAttribute(types.AttributeKeySpendStreamID, fmt.Sprintf("%d", streamID)),
+			sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprintf("%d", stream.RemainingPayments)),
+		),
+	)
+	return nil
+}
+
+// GetAllSpendStreams returns all the active community pool spend streams
+func (k Keeper) GetAllSpendStreams(ctx sdk.Context) types.CommunityPoolSpendStreams {
+	streams := types.CommunityPoolSpendStreams{}
+	k.IterateSpendStreams(ctx, func(stream types.CommunityPoolSpendStream) bool {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// ProcessSpendStreams makes the payments of the community pool spend streams
+// due at the current block time. A stream pays at most once per block; if the
+// community pool cannot cover a payment, the payment stays due and is retried
+// on the next block. Streams are removed once their last payment is made.
+func (k Keeper) ProcessSpendStreams(ctx sdk.Context) {
+	// collect the due streams before paying, as payments write to the queue
+	// being iterated
+	var due []uint64
+	k.IterateDueSpendStreams(ctx, ctx.BlockHeader().Time, func(streamID uint64) bool {
+		due = append(due, streamID)
+		return false
+	})
+
+	logger := k.Logger(ctx)
+	for _, streamID := range due {
+		stream, found := k.GetSpendStream(ctx, streamID)
+		if !found {
+			panic(fmt.Sprintf("community pool spend stream %d is queued but does not exist", streamID))
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.DistributeFromFeePool(cacheCtx, stream.Amount, stream.Recipient); err != nil {
+			logger.Info(fmt.Sprintf("community pool spend stream %d payment failed: %s", streamID, err))
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		k.RemoveFromSpendStreamQueue(ctx, stream)
+		stream.RemainingPayments--
+		if stream.RemainingPayments == 0 {
+			k.DeleteSpendStream(ctx, streamID)
+		} else {
+			stream.NextPaymentTime = stream.NextPaymentTime.Add(stream.Period)
+			k.SetSpendStream(ctx, stream)
+			k.InsertSpendStreamQueue(ctx, stream)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSpendStreamPayment,
+				sdk.NewAttribute(types.AttributeKeySpendStreamID, fmt.Sprintf("%d", streamID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprintf("%d", stream.RemainingPayments)),
+			),
+		)
+		logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s for spend stream %d",
+			stream.Amount, stream.Recipient, streamID))
+	}
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestProcessSpendStreams(t *testing.T) {
+	ctx, ak, k, _, _ := CreateTestInputDefault(t, false, 1000)
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	start := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(start)
+
+	// fund the community pool with two payments only
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)))
+	k.SetFeePool(ctx, feePool)
+
+	// create a stream of three payments, one every hour
+	stream := k.CreateSpendStream(ctx, 5, recipient, amount, time.Hour, 3)
+	require.Equal(t, uint64(1), stream.ID)
+	require.Equal(t, uint64(2), k.GetNextSpendStreamID(ctx))
+	require.Equal(t, start.Add(time.Hour), stream.NextPaymentTime)
+
+	balance := func() sdk.Coins {
+		acc := ak.GetAccount(ctx, recipient)
+		if acc == nil {
+			return sdk.NewCoins()
+		}
+		return acc.GetCoins()
+	}
+
+	// nothing is paid before the first period elapsed
+	k.ProcessSpendStreams(ctx.WithBlockTime(start.Add(time.Hour - time.Second)))
+	require.True(t, balance().IsZero())
+
+	// first payment
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	k.ProcessSpendStreams(ctx)
+	require.Equal(t, amount, balance())
+	stream, found := k.GetSpendStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(2), stream.RemainingPayments)
+	require.Equal(t, start.Add(2*time.Hour), stream.NextPaymentTime)
+
+	// a stream pays at most once per block, even when several periods elapsed
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Hour))
+	k.ProcessSpendStreams(ctx)
+	require.Equal(t, amount.Add(amount), balance())
+
+	// the community pool cannot cover the last payment, which stays due
+	k.ProcessSpendStreams(ctx)
+	require.Equal(t, amount.Add(amount), balance())
+	stream, found = k.GetSpendStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), stream.RemainingPayments)
+	require.Equal(t, start.Add(3*time.Hour), stream.NextPaymentTime)
+
+	// refill the community pool, the last payment is made and the stream removed
+	distrAcc = k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(distrAcc.GetCoins().Add(amount))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+	feePool = k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(amount))
+	k.SetFeePool(ctx, feePool)
+
+	k.ProcessSpendStreams(ctx)
+	require.Equal(t, amount.Add(amount).Add(amount), balance())
+	_, found = k.GetSpendStream(ctx, 1)
+	require.False(t, found)
+	require.Empty(t, k.GetAllSpendStreams(ctx))
+
+	// cancelling removes the stream and its remaining payments
+	stream = k.CreateSpendStream(ctx, 6, recipient, amount, time.Hour, 2)
+	require.Equal(t, uint64(2), stream.ID)
+	require.Nil(t, k.CancelSpendStream(ctx, stream.ID))
+	require.NotNil(t, k.CancelSpendStream(ctx, stream.ID))
+	k.ProcessSpendStreams(ctx.WithBlockTime(start.Add(10 * time.Hour)))
+	require.Equal(t, amount.Add(amount).Add(amount), balance())
+}
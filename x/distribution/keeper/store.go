@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -41,6 +43,47 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// get the destinations of the rewards withdrawn by a delegator
+func (k Keeper) GetDelegatorRewardDestinations(ctx sdk.Context, delAddr sdk.AccAddress) (destinations types.RewardDestinations, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetDelegatorRewardDestinationsKey(delAddr))
+	if b == nil {
+		return nil, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &destinations)
+	return destinations, true
+}
+
+// set the destinations of the rewards withdrawn by a delegator
+func (k Keeper) SetDelegatorRewardDestinations(ctx sdk.Context, delAddr sdk.AccAddress, destinations types.RewardDestinations) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(destinations)
+	store.Set(GetDelegatorRewardDestinationsKey(delAddr), b)
+}
+
+// delete the destinations of the rewards withdrawn by a delegator
+func (k Keeper) DeleteDelegatorRewardDestinations(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDelegatorRewardDestinationsKey(delAddr))
+}
+
+// iterate over the reward destinations of all delegators
+func (k Keeper) IterateDelegatorRewardDestinations(ctx sdk.Context,
+	handler func(del sdk.AccAddress, destinations types.RewardDestinations) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, DelegatorRewardDestinationsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var destinations types.RewardDestinations
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &destinations)
+		del := GetDelegatorRewardDestinationsAddress(iter.Key())
+		if handler(del, destinations) {
+			break
+		}
+	}
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
@@ -377,3 +420,153 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// check whether a delegation is opted into auto-compounding
+func (k Keeper) HasDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetDelegatorAutoCompoundKey(delAddr, valAddr))
+}
+
+// opt a delegation into auto-compounding
+func (k Keeper) SetDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetDelegatorAutoCompoundKey(delAddr, valAddr), []byte{0x01})
+}
+
+// opt a delegation out of auto-compounding
+func (k Keeper) DeleteDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDelegatorAutoCompoundKey(delAddr, valAddr))
+}
+
+// iterate over the validators of a delegator's auto-compounding delegations
+func (k Keeper) IterateDelegatorAutoCompoundsByDelegator(ctx sdk.Context, delAddr sdk.AccAddress,
+	handler func(val sdk.ValAddress) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GetDelegatorAutoCompoundPrefix(delAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, val := GetDelegatorAutoCompoundAddresses(iter.Key())
+		if handler(val) {
+			break
+		}
+	}
+}
+
+// iterate over all auto-compounding delegations
+func (k Keeper) IterateDelegatorAutoCompounds(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, DelegatorAutoCompoundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := GetDelegatorAutoCompoundAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// get the store key of the next delegation to compound in the pass in
+// progress, returning false if no pass is in progress
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (cursor []byte, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor = store.Get(AutoCompoundCursorKey)
+	if cursor == nil {
+		return nil, false
+	}
+	return cursor, true
+}
+
+// set the store key of the next delegation to compound
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(AutoCompoundCursorKey, cursor)
+}
+
+// delete the auto-compound cursor, ending the pass in progress
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(AutoCompoundCursorKey)
+}
+
+// get a community pool spend stream
+func (k Keeper) GetSpendStream(ctx sdk.Context, streamID uint64) (stream types.CommunityPoolSpendStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetSpendStreamKey(streamID))
+	if b == nil {
+		return stream, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &stream)
+	return stream, true
+}
+
+// set a community pool spend stream
+func (k Keeper) SetSpendStream(ctx sdk.Context, stream types.CommunityPoolSpendStream) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(stream)
+	store.Set(GetSpendStreamKey(stream.ID), b)
+}
+
+// delete a community pool spend stream
+func (k Keeper) DeleteSpendStream(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetSpendStreamKey(streamID))
+}
+
+// iterate over all community pool spend streams
+func (k Keeper) IterateSpendStreams(ctx sdk.Context, handler func(stream types.CommunityPoolSpendStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, SpendStreamPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.CommunityPoolSpendStream
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// insert a community pool spend stream into the queue at its next payment time
+func (k Keeper) InsertSpendStreamQueue(ctx sdk.Context, stream types.CommunityPoolSpendStream) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSpendStreamQueueKey(stream.NextPaymentTime, stream.ID), []byte{0x01})
+}
+
+// remove a community pool spend stream from the queue
+func (k Keeper) RemoveFromSpendStreamQueue(ctx sdk.Context, stream types.CommunityPoolSpendStream) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetSpendStreamQueueKey(stream.NextPaymentTime, stream.ID))
+}
+
+// iterate over the community pool spend streams with a payment due at or
+// before the given time, in payment time order
+func (k Keeper) IterateDueSpendStreams(ctx sdk.Context, paymentTime time.Time, handler func(streamID uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(SpendStreamQueuePrefix, sdk.PrefixEndBytes(GetSpendStreamQueueTimeKey(paymentTime)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(GetSpendStreamQueueStreamID(iter.Key())) {
+			break
+		}
+	}
+}
+
+// get the ID of the next community pool spend stream
+func (k Keeper) GetNextSpendStreamID(ctx sdk.Context) (streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(NextSpendStreamIDKey)
+	if b == nil {
+		return 1
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &streamID)
+	return streamID
+}
+
+// set the ID of the next community pool spend stream
+func (k Keeper) SetNextSpendStreamID(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(streamID)
+	store.Set(NextSpendStreamIDKey, b)
+}
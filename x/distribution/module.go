@@ -85,17 +85,25 @@ type AppModule struct {
 	AppModuleBasic
 	AppModuleSimulation
 
-	keeper       Keeper
-	supplyKeeper types.SupplyKeeper
+	keeper             Keeper
+	supplyKeeper       types.SupplyKeeper
+	rewardAllocationFn RewardAllocationFn
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper, supplyKeeper types.SupplyKeeper) AppModule {
+// NewAppModule creates a new AppModule object. If rewardAllocationFn is nil,
+// the rewards are allocated proportionally to the voting power of the
+// validators.
+func NewAppModule(keeper Keeper, supplyKeeper types.SupplyKeeper, rewardAllocationFn RewardAllocationFn) AppModule {
+	if rewardAllocationFn == nil {
+		rewardAllocationFn = DefaultRewardAllocationFn
+	}
+
 	return AppModule{
 		AppModuleBasic:      AppModuleBasic{},
 		AppModuleSimulation: AppModuleSimulation{},
 		keeper:              keeper,
 		supplyKeeper:        supplyKeeper,
+		rewardAllocationFn:  rewardAllocationFn,
 	}
 }
 
@@ -147,7 +155,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 
 // BeginBlock returns the begin blocker for the distribution module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
-	BeginBlocker(ctx, req, am.keeper)
+	BeginBlocker(ctx, req, am.keeper, am.rewardAllocationFn)
 }
 
 // EndBlock returns the end blocker for the distribution module. It returns no validator
@@ -2,6 +2,7 @@ package distribution
 
 import (
 	"testing"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
@@ -9,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -65,3 +67,24 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.Error(t, hdlr(ctx, tp))
 	require.True(t, accountKeeper.GetAccount(ctx, recipient).GetCoins().IsZero())
 }
+
+func TestRecurringSpendProposalHandler(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 10)
+	hdlr := NewCommunityPoolSpendProposalHandler(keeper)
+
+	rp := types.NewCommunityPoolRecurringSpendProposal("Test", "description", delAddr1, amount, time.Hour, 3)
+	require.NoError(t, hdlr(govtypes.ContextWithProposalID(ctx, 4), rp))
+
+	streams := keeper.GetAllSpendStreams(ctx)
+	require.Len(t, streams, 1)
+	require.Equal(t, uint64(4), streams[0].ProposalID)
+	require.Equal(t, delAddr1, streams[0].Recipient)
+	require.Equal(t, uint64(3), streams[0].RemainingPayments)
+
+	cp := types.NewCancelCommunityPoolSpendStreamProposal("Test", "description", streams[0].ID)
+	require.NoError(t, hdlr(ctx, cp))
+	require.Empty(t, keeper.GetAllSpendStreams(ctx))
+
+	// the stream no longer exists
+	require.Error(t, hdlr(ctx, cp))
+}
@@ -64,6 +64,33 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &eventB)
 		return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+	case bytes.Equal(kvA.Key[:1], keeper.DelegatorAutoCompoundPrefix):
+		return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+	case bytes.Equal(kvA.Key[:1], keeper.AutoCompoundCursorKey):
+		return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+	case bytes.Equal(kvA.Key[:1], keeper.SpendStreamPrefix):
+		var streamA, streamB types.CommunityPoolSpendStream
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &streamA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &streamB)
+		return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+	case bytes.Equal(kvA.Key[:1], keeper.SpendStreamQueuePrefix):
+		return fmt.Sprintf("%v\n%v", keeper.GetSpendStreamQueueStreamID(kvA.Key), keeper.GetSpendStreamQueueStreamID(kvB.Key))
+
+	case bytes.Equal(kvA.Key[:1], keeper.NextSpendStreamIDKey):
+		var idA, idB uint64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &idA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &idB)
+		return fmt.Sprintf("%v\n%v", idA, idB)
+
+	case bytes.Equal(kvA.Key[:1], keeper.DelegatorRewardDestinationsPrefix):
+		var destinationsA, destinationsB types.RewardDestinations
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &destinationsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &destinationsB)
+		return fmt.Sprintf("%v\n%v", destinationsA, destinationsB)
+
 	default:
 		panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 	}
@@ -3,6 +3,7 @@ package simulation
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -42,6 +43,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	now := time.Now().UTC()
+	stream := types.NewCommunityPoolSpendStream(3, 7, delAddr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		time.Hour, 4, now)
+	destinations := types.RewardDestinations{types.NewRewardDestination(delAddr1, sdk.OneDec())}
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: keeper.FeePoolKey, Value: cdc.MustMarshalBinaryLengthPrefixed(feePool)},
@@ -53,6 +58,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		cmn.KVPair{Key: keeper.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(currentRewards)},
 		cmn.KVPair{Key: keeper.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(commission)},
 		cmn.KVPair{Key: keeper.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryLengthPrefixed(slashEvent)},
+		cmn.KVPair{Key: keeper.GetSpendStreamKey(3), Value: cdc.MustMarshalBinaryLengthPrefixed(stream)},
+		cmn.KVPair{Key: keeper.GetSpendStreamQueueKey(now, 3), Value: []byte{0x01}},
+		cmn.KVPair{Key: keeper.NextSpendStreamIDKey, Value: cdc.MustMarshalBinaryLengthPrefixed(uint64(4))},
+		cmn.KVPair{Key: keeper.GetDelegatorRewardDestinationsKey(delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(destinations)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -69,6 +78,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"SpendStream", fmt.Sprintf("%v\n%v", stream, stream)},
+		{"SpendStreamQueue", "3\n3"},
+		{"NextSpendStreamID", "4\n4"},
+		{"RewardDestinations", fmt.Sprintf("%v\n%v", destinations, destinations)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
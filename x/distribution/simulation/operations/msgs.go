@@ -3,6 +3,7 @@ package operations
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -62,6 +63,56 @@ func SimulateMsgWithdrawDelegatorReward(k distribution.Keeper) simulation.Operat
 	}
 }
 
+// SimulateMsgWithdrawAllDelegatorRewards generates a MsgWithdrawAllDelegatorRewards with random values.
+func SimulateMsgWithdrawAllDelegatorRewards(k distribution.Keeper) simulation.Operation {
+	handler := distribution.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAccount := simulation.RandomAcc(r, accs)
+		msg := distribution.NewMsgWithdrawAllDelegatorRewards(delegatorAccount.Address, r.Intn(2) == 0)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(distribution.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgSetAutoCompound generates a MsgSetAutoCompound with random values.
+func SimulateMsgSetAutoCompound(k distribution.Keeper) simulation.Operation {
+	handler := distribution.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAccount := simulation.RandomAcc(r, accs)
+		validatorAccount := simulation.RandomAcc(r, accs)
+		enabled := r.Intn(4) != 0
+		msg := distribution.NewMsgSetAutoCompound(delegatorAccount.Address, sdk.ValAddress(validatorAccount.Address), enabled)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(distribution.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
 // SimulateMsgWithdrawValidatorCommission generates a MsgWithdrawValidatorCommission with random values.
 func SimulateMsgWithdrawValidatorCommission(k distribution.Keeper) simulation.Operation {
 	handler := distribution.NewHandler(k)
@@ -86,6 +137,80 @@ func SimulateMsgWithdrawValidatorCommission(k distribution.Keeper) simulation.Op
 	}
 }
 
+// SimulateMsgSetRewardDestinations generates a MsgSetRewardDestinations with random values.
+func SimulateMsgSetRewardDestinations(k distribution.Keeper) simulation.Operation {
+	handler := distribution.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAccount := simulation.RandomAcc(r, accs)
+
+		// split between up to three distinct accounts, no destinations
+		// reverts to the withdraw address
+		n := r.Intn(4)
+		if n > len(accs) {
+			n = len(accs)
+		}
+		weights := make([]int64, n)
+		total := int64(0)
+		for i := range weights {
+			weights[i] = int64(simulation.RandIntBetween(r, 1, 100))
+			total += weights[i]
+		}
+		destinations := distribution.RewardDestinations{}
+		remaining := sdk.OneDec()
+		for i, j := range r.Perm(len(accs))[:n] {
+			share := remaining
+			if i < n-1 {
+				share = sdk.NewDec(weights[i]).QuoInt64(total)
+				remaining = remaining.Sub(share)
+			}
+			destinations = append(destinations, distribution.NewRewardDestination(accs[j].Address, share))
+		}
+
+		msg := distribution.NewMsgSetRewardDestinations(delegatorAccount.Address, destinations)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(distribution.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgClaimDelegatorReward generates a MsgClaimDelegatorReward with random values.
+func SimulateMsgClaimDelegatorReward(k distribution.Keeper) simulation.Operation {
+	handler := distribution.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		claimerAccount := simulation.RandomAcc(r, accs)
+		delegatorAccount := simulation.RandomAcc(r, accs)
+		validatorAccount := simulation.RandomAcc(r, accs)
+		msg := distribution.NewMsgClaimDelegatorReward(claimerAccount.Address, delegatorAccount.Address,
+			sdk.ValAddress(validatorAccount.Address))
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(distribution.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
 // SimulateCommunityPoolSpendProposalContent generates random community-pool-spend proposal content
 func SimulateCommunityPoolSpendProposalContent(k distribution.Keeper) govsimops.ContentSimulator {
 	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
@@ -108,3 +233,32 @@ func SimulateCommunityPoolSpendProposalContent(k distribution.Keeper) govsimops.
 		)
 	}
 }
+
+// SimulateCommunityPoolRecurringSpendProposalContent generates random community-pool-recurring-spend proposal content
+func SimulateCommunityPoolRecurringSpendProposalContent(k distribution.Keeper) govsimops.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
+		recipientAcc := simulation.RandomAcc(r, accs)
+		coins := sdk.Coins{}
+		balance := k.GetFeePool(ctx).CommunityPool
+		if len(balance) > 0 {
+			denomIndex := r.Intn(len(balance))
+			amount, goErr := simulation.RandPositiveInt(r, balance[denomIndex].Amount.TruncateInt())
+			if goErr == nil {
+				coins = sdk.NewCoins(sdk.NewCoin(balance[denomIndex].Denom, amount))
+			}
+		}
+		// a stream must pay a positive amount, payments the community pool
+		// cannot cover are retried until the stream is cancelled
+		if coins.Empty() {
+			coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+		}
+		return distribution.NewCommunityPoolRecurringSpendProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			recipientAcc.Address,
+			coins,
+			time.Duration(simulation.RandIntBetween(r, 1, 600))*time.Second,
+			uint64(simulation.RandIntBetween(r, 1, 10)),
+		)
+	}
+}
@@ -7,9 +7,15 @@ import (
 // Register concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdrawDelegatorReward{}, "cosmos-sdk/MsgWithdrawDelegationReward", nil)
+	cdc.RegisterConcrete(MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/MsgWithdrawAllDelegatorRewards", nil)
 	cdc.RegisterConcrete(MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValidatorCommission", nil)
 	cdc.RegisterConcrete(MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(MsgSetAutoCompound{}, "cosmos-sdk/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(MsgSetRewardDestinations{}, "cosmos-sdk/MsgSetRewardDestinations", nil)
+	cdc.RegisterConcrete(MsgClaimDelegatorReward{}, "cosmos-sdk/MsgClaimDelegatorReward", nil)
 	cdc.RegisterConcrete(CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
+	cdc.RegisterConcrete(CommunityPoolRecurringSpendProposal{}, "cosmos-sdk/CommunityPoolRecurringSpendProposal", nil)
+	cdc.RegisterConcrete(CancelCommunityPoolSpendStreamProposal{}, "cosmos-sdk/CancelCommunityPoolSpendStreamProposal", nil)
 }
 
 // generic sealed codec to be used throughout module
@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,6 +15,7 @@ const (
 	CodeNoDistributionInfo      CodeType          = 104
 	CodeNoValidatorCommission   CodeType          = 105
 	CodeSetWithdrawAddrDisabled CodeType          = 106
+	CodeUnknownSpendStream      CodeType          = 107
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -24,6 +27,9 @@ func ErrNilWithdrawAddr(codespace sdk.CodespaceType) sdk.Error {
 func ErrNilValidatorAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "validator address is nil")
 }
+func ErrNilClaimerAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "claimer address is nil")
+}
 func ErrNoDelegationDistInfo(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeNoDistributionInfo, "no delegation distribution info")
 }
@@ -45,3 +51,15 @@ func ErrInvalidProposalAmount(codespace sdk.CodespaceType) sdk.Error {
 func ErrEmptyProposalRecipient(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "invalid community pool spend proposal recipient")
 }
+func ErrInvalidProposalPeriod(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "community pool recurring spend proposal period must be positive")
+}
+func ErrInvalidProposalPeriods(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "community pool recurring spend proposal must have at least one period")
+}
+func ErrInvalidRewardDestinations(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("invalid reward destinations: %s", msg))
+}
+func ErrUnknownSpendStream(codespace sdk.CodespaceType, streamID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownSpendStream, fmt.Sprintf("unknown community pool spend stream %d", streamID))
+}
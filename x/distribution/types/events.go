@@ -2,15 +2,28 @@ package types
 
 // Distribution module event types
 var (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress    = "set_withdraw_address"
+	EventTypeRewards               = "rewards"
+	EventTypeCommission            = "commission"
+	EventTypeWithdrawRewards       = "withdraw_rewards"
+	EventTypeWithdrawCommission    = "withdraw_commission"
+	EventTypeProposerReward        = "proposer_reward"
+	EventTypeSetAutoCompound       = "set_auto_compound"
+	EventTypeCompoundRewards       = "compound_rewards"
+	EventTypeSpendStreamPayment    = "spend_stream_payment"
+	EventTypeCancelSpendStream     = "cancel_spend_stream"
+	EventTypeSetRewardDestinations = "set_reward_destinations"
+	EventTypeClaimRewards          = "claim_rewards"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeySpendStreamID   = "stream_id"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyRemaining       = "remaining_payments"
+	AttributeKeyDestinations    = "destinations"
+	AttributeKeyClaimer         = "claimer"
 
 	AttributeValueCategory = ModuleName
 )
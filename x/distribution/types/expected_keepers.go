@@ -43,6 +43,12 @@ type StakingKeeper interface {
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
 
 	GetAllSDKDelegations(ctx sdk.Context) []staking.Delegation
+
+	// used to re-delegate auto-compounded rewards
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator staking.Validator, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc sdk.BondStatus,
+		validator staking.Validator, subtractAccount bool) (newShares sdk.Dec, err sdk.Error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
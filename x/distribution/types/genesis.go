@@ -53,6 +53,18 @@ type ValidatorSlashEventRecord struct {
 	Event            ValidatorSlashEvent `json:"validator_slash_event" yaml:"validator_slash_event"`
 }
 
+// used for import / export via genesis json
+type DelegatorAutoCompoundRecord struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// used for import / export via genesis json
+type DelegatorRewardDestinationsRecord struct {
+	DelegatorAddress sdk.AccAddress     `json:"delegator_address" yaml:"delegator_address"`
+	Destinations     RewardDestinations `json:"destinations" yaml:"destinations"`
+}
+
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
 	FeePool                         FeePool                                `json:"fee_pool" yaml:"fee_pool"`
@@ -60,6 +72,8 @@ type GenesisState struct {
 	BaseProposerReward              sdk.Dec                                `json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward             sdk.Dec                                `json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled             bool                                   `json:"withdraw_addr_enabled" yaml:"withdraw_addr_enabled"`
+	AutoCompoundInterval            int64                                  `json:"auto_compound_interval" yaml:"auto_compound_interval"`
+	MaxAutoCompoundsPerBlock        uint32                                 `json:"max_auto_compounds_per_block" yaml:"max_auto_compounds_per_block"`
 	DelegatorWithdrawInfos          []DelegatorWithdrawInfo                `json:"delegator_withdraw_infos" yaml:"delegator_withdraw_infos"`
 	PreviousProposer                sdk.ConsAddress                        `json:"previous_proposer" yaml:"previous_proposer"`
 	OutstandingRewards              []ValidatorOutstandingRewardsRecord    `json:"outstanding_rewards" yaml:"outstanding_rewards"`
@@ -68,13 +82,20 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	DelegatorAutoCompounds          []DelegatorAutoCompoundRecord          `json:"delegator_auto_compounds" yaml:"delegator_auto_compounds"`
+	SpendStreams                    []CommunityPoolSpendStream             `json:"spend_streams" yaml:"spend_streams"`
+	NextSpendStreamID               uint64                                 `json:"next_spend_stream_id" yaml:"next_spend_stream_id"`
+	DelegatorRewardDestinations     []DelegatorRewardDestinationsRecord    `json:"delegator_reward_destinations" yaml:"delegator_reward_destinations"`
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
-	withdrawAddrEnabled bool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
+	withdrawAddrEnabled bool, autoCompoundInterval int64, maxAutoCompoundsPerBlock uint32,
+	dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord,
-	slashes []ValidatorSlashEventRecord) GenesisState {
+	slashes []ValidatorSlashEventRecord, autoCompounds []DelegatorAutoCompoundRecord,
+	streams []CommunityPoolSpendStream, nextStreamID uint64,
+	rewardDestinations []DelegatorRewardDestinationsRecord) GenesisState {
 
 	return GenesisState{
 		FeePool:                         feePool,
@@ -82,6 +103,8 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		BaseProposerReward:              baseProposerReward,
 		BonusProposerReward:             bonusProposerReward,
 		WithdrawAddrEnabled:             withdrawAddrEnabled,
+		AutoCompoundInterval:            autoCompoundInterval,
+		MaxAutoCompoundsPerBlock:        maxAutoCompoundsPerBlock,
 		DelegatorWithdrawInfos:          dwis,
 		PreviousProposer:                pp,
 		OutstandingRewards:              r,
@@ -90,6 +113,10 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		DelegatorAutoCompounds:          autoCompounds,
+		SpendStreams:                    streams,
+		NextSpendStreamID:               nextStreamID,
+		DelegatorRewardDestinations:     rewardDestinations,
 	}
 }
 
@@ -101,6 +128,8 @@ func DefaultGenesisState() GenesisState {
 		BaseProposerReward:              sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward:             sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled:             true,
+		AutoCompoundInterval:            100,
+		MaxAutoCompoundsPerBlock:        100,
 		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
 		PreviousProposer:                nil,
 		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
@@ -109,6 +138,10 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		DelegatorAutoCompounds:          []DelegatorAutoCompoundRecord{},
+		SpendStreams:                    []CommunityPoolSpendStream{},
+		NextSpendStreamID:               1,
+		DelegatorRewardDestinations:     []DelegatorRewardDestinationsRecord{},
 	}
 }
 
@@ -132,5 +165,26 @@ func ValidateGenesis(data GenesisState) error {
 			"BonusProposerReward cannot add to be greater than one, "+
 			"adds to %s", data.BaseProposerReward.Add(data.BonusProposerReward).String())
 	}
+	if data.AutoCompoundInterval < 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundInterval should be non-negative, is %d",
+			data.AutoCompoundInterval)
+	}
+	for _, stream := range data.SpendStreams {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		if stream.ID >= data.NextSpendStreamID {
+			return fmt.Errorf("community pool spend stream ID %d should be lower than the next spend stream ID %d",
+				stream.ID, data.NextSpendStreamID)
+		}
+	}
+	for _, rd := range data.DelegatorRewardDestinations {
+		if rd.DelegatorAddress.Empty() {
+			return fmt.Errorf("reward destinations delegator address cannot be empty")
+		}
+		if err := rd.Destinations.Validate(); err != nil {
+			return fmt.Errorf("invalid reward destinations of delegator %s: %s", rd.DelegatorAddress, err)
+		}
+	}
 	return data.FeePool.ValidateGenesis()
 }
@@ -6,7 +6,12 @@ import (
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{},
+	&MsgSetAutoCompound{}, &MsgWithdrawAllDelegatorRewards{}, &MsgSetRewardDestinations{}, &MsgClaimDelegatorReward{}
+
+// gas charged per delegation by MsgWithdrawAllDelegatorRewards, on top of the
+// gas consumed by store access, as the work done is unbounded by the tx size
+const GasWithdrawDelegationRewards sdk.Gas = 10000
 
 // msg struct for changing the withdraw address for a delegator (or validator self-delegation)
 type MsgSetWithdrawAddress struct {
@@ -84,6 +89,42 @@ func (msg MsgWithdrawDelegatorReward) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// msg struct for withdrawing the rewards of all of a delegator's delegations,
+// and optionally the commission of the validator operated by the delegator
+type MsgWithdrawAllDelegatorRewards struct {
+	DelegatorAddress   sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	WithdrawCommission bool           `json:"withdraw_commission" yaml:"withdraw_commission"`
+}
+
+func NewMsgWithdrawAllDelegatorRewards(delAddr sdk.AccAddress, withdrawCommission bool) MsgWithdrawAllDelegatorRewards {
+	return MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress:   delAddr,
+		WithdrawCommission: withdrawCommission,
+	}
+}
+
+func (msg MsgWithdrawAllDelegatorRewards) Route() string { return ModuleName }
+func (msg MsgWithdrawAllDelegatorRewards) Type() string  { return "withdraw_all_delegator_rewards" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.DelegatorAddress)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawAllDelegatorRewards) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
 // msg struct for validator withdraw
 type MsgWithdrawValidatorCommission struct {
 	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
@@ -116,3 +157,130 @@ func (msg MsgWithdrawValidatorCommission) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// msg struct for opting a delegation into or out of auto-compounding
+type MsgSetAutoCompound struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Enabled          bool           `json:"enabled" yaml:"enabled"`
+}
+
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) MsgSetAutoCompound {
+	return MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+func (msg MsgSetAutoCompound) Type() string  { return "set_auto_compound" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.DelegatorAddress)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoCompound) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+// msg struct for splitting the rewards and commission withdrawn by a delegator
+// between destinations, an empty list of destinations reverts to the withdraw
+// address
+type MsgSetRewardDestinations struct {
+	DelegatorAddress sdk.AccAddress     `json:"delegator_address" yaml:"delegator_address"`
+	Destinations     RewardDestinations `json:"destinations" yaml:"destinations"`
+}
+
+func NewMsgSetRewardDestinations(delAddr sdk.AccAddress, destinations RewardDestinations) MsgSetRewardDestinations {
+	return MsgSetRewardDestinations{
+		DelegatorAddress: delAddr,
+		Destinations:     destinations,
+	}
+}
+
+func (msg MsgSetRewardDestinations) Route() string { return ModuleName }
+func (msg MsgSetRewardDestinations) Type() string  { return "set_reward_destinations" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetRewardDestinations) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.DelegatorAddress)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetRewardDestinations) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetRewardDestinations) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if len(msg.Destinations) == 0 {
+		return nil
+	}
+	if err := msg.Destinations.Validate(); err != nil {
+		return ErrInvalidRewardDestinations(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// msg struct for withdrawing the rewards of a delegation on behalf of its
+// delegator, the rewards are paid as if withdrawn by the delegator
+type MsgClaimDelegatorReward struct {
+	ClaimerAddress   sdk.AccAddress `json:"claimer_address" yaml:"claimer_address"`
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+func NewMsgClaimDelegatorReward(claimerAddr, delAddr sdk.AccAddress, valAddr sdk.ValAddress) MsgClaimDelegatorReward {
+	return MsgClaimDelegatorReward{
+		ClaimerAddress:   claimerAddr,
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+	}
+}
+
+func (msg MsgClaimDelegatorReward) Route() string { return ModuleName }
+func (msg MsgClaimDelegatorReward) Type() string  { return "claim_delegator_reward" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgClaimDelegatorReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ClaimerAddress)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgClaimDelegatorReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgClaimDelegatorReward) ValidateBasic() sdk.Error {
+	if msg.ClaimerAddress.Empty() {
+		return ErrNilClaimerAddr(DefaultCodespace)
+	}
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
@@ -54,6 +54,51 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true, true},
+		{delAddr1, valAddr1, false, true},
+		{emptyDelAddr, valAddr1, true, false},
+		{delAddr1, emptyValAddr, true, false},
+		{emptyDelAddr, emptyValAddr, false, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.validatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgWithdrawAllDelegatorRewards
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	tests := []struct {
+		delegatorAddr      sdk.AccAddress
+		withdrawCommission bool
+		expectPass         bool
+	}{
+		{delAddr1, false, true},
+		{delAddr1, true, true},
+		{emptyDelAddr, false, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllDelegatorRewards(tc.delegatorAddr, tc.withdrawCommission)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgWithdrawValidatorCommission
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	tests := []struct {
@@ -72,3 +117,53 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetRewardDestinations
+func TestMsgSetRewardDestinations(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		destinations  RewardDestinations
+		expectPass    bool
+	}{
+		{delAddr1, RewardDestinations{NewRewardDestination(delAddr2, sdk.OneDec())}, true},
+		{delAddr1, RewardDestinations{NewRewardDestination(delAddr1, half), NewRewardDestination(delAddr2, half)}, true},
+		{delAddr1, RewardDestinations{}, true},
+		{emptyDelAddr, RewardDestinations{}, false},
+		{delAddr1, RewardDestinations{NewRewardDestination(delAddr2, half)}, false},
+		{delAddr1, RewardDestinations{NewRewardDestination(emptyDelAddr, sdk.OneDec())}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetRewardDestinations(tc.delegatorAddr, tc.destinations)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgClaimDelegatorReward
+func TestMsgClaimDelegatorReward(t *testing.T) {
+	tests := []struct {
+		claimerAddr   sdk.AccAddress
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		expectPass    bool
+	}{
+		{delAddr2, delAddr1, valAddr1, true},
+		{delAddr1, delAddr1, valAddr1, true},
+		{emptyDelAddr, delAddr1, valAddr1, false},
+		{delAddr2, emptyDelAddr, valAddr1, false},
+		{delAddr2, delAddr1, emptyValAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgClaimDelegatorReward(tc.claimerAddr, tc.delegatorAddr, tc.validatorAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.claimerAddr}, msg.GetSigners())
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
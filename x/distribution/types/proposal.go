@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -11,14 +12,28 @@ import (
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+
+	// ProposalTypeCommunityPoolRecurringSpend defines the type for a CommunityPoolRecurringSpendProposal
+	ProposalTypeCommunityPoolRecurringSpend = "CommunityPoolRecurringSpend"
+
+	// ProposalTypeCancelCommunityPoolSpendStream defines the type for a CancelCommunityPoolSpendStreamProposal
+	ProposalTypeCancelCommunityPoolSpendStream = "CancelCommunityPoolSpendStream"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = CommunityPoolSpendProposal{}
+// Assert the community pool proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = CommunityPoolSpendProposal{}
+	_ govtypes.Content = CommunityPoolRecurringSpendProposal{}
+	_ govtypes.Content = CancelCommunityPoolSpendStreamProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolRecurringSpend)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolRecurringSpendProposal{}, "cosmos-sdk/CommunityPoolRecurringSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelCommunityPoolSpendStream)
+	govtypes.RegisterProposalTypeCodec(CancelCommunityPoolSpendStreamProposal{}, "cosmos-sdk/CancelCommunityPoolSpendStreamProposal")
 }
 
 // CommunityPoolSpendProposal spends from the community pool
@@ -72,3 +87,113 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// CommunityPoolRecurringSpendProposal streams a fixed amount from the community
+// pool to a recipient once every period, for a number of periods
+type CommunityPoolRecurringSpendProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`   // amount paid every period
+	Period      time.Duration  `json:"period" yaml:"period"`   // time between two payments
+	Periods     uint64         `json:"periods" yaml:"periods"` // number of payments
+}
+
+// NewCommunityPoolRecurringSpendProposal creates a new community pool recurring spend proposal.
+func NewCommunityPoolRecurringSpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins,
+	period time.Duration, periods uint64) CommunityPoolRecurringSpendProposal {
+
+	return CommunityPoolRecurringSpendProposal{title, description, recipient, amount, period, periods}
+}
+
+// GetTitle returns the title of a community pool recurring spend proposal.
+func (crsp CommunityPoolRecurringSpendProposal) GetTitle() string { return crsp.Title }
+
+// GetDescription returns the description of a community pool recurring spend proposal.
+func (crsp CommunityPoolRecurringSpendProposal) GetDescription() string { return crsp.Description }
+
+// ProposalRoute returns the routing key of a community pool recurring spend proposal.
+func (crsp CommunityPoolRecurringSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool recurring spend proposal.
+func (crsp CommunityPoolRecurringSpendProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolRecurringSpend
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (crsp CommunityPoolRecurringSpendProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, crsp)
+	if err != nil {
+		return err
+	}
+	if !crsp.Amount.IsValid() || crsp.Amount.Empty() {
+		return ErrInvalidProposalAmount(DefaultCodespace)
+	}
+	if crsp.Recipient.Empty() {
+		return ErrEmptyProposalRecipient(DefaultCodespace)
+	}
+	if crsp.Period <= 0 {
+		return ErrInvalidProposalPeriod(DefaultCodespace)
+	}
+	if crsp.Periods == 0 {
+		return ErrInvalidProposalPeriods(DefaultCodespace)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (crsp CommunityPoolRecurringSpendProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Recurring Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Period:      %s
+  Periods:     %d
+`, crsp.Title, crsp.Description, crsp.Recipient, crsp.Amount, crsp.Period, crsp.Periods))
+	return b.String()
+}
+
+// CancelCommunityPoolSpendStreamProposal cancels the remaining payments of a
+// community pool spend stream
+type CancelCommunityPoolSpendStreamProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	StreamID    uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewCancelCommunityPoolSpendStreamProposal creates a new proposal cancelling a community pool spend stream.
+func NewCancelCommunityPoolSpendStreamProposal(title, description string, streamID uint64) CancelCommunityPoolSpendStreamProposal {
+	return CancelCommunityPoolSpendStreamProposal{title, description, streamID}
+}
+
+// GetTitle returns the title of a spend stream cancellation proposal.
+func (ccsp CancelCommunityPoolSpendStreamProposal) GetTitle() string { return ccsp.Title }
+
+// GetDescription returns the description of a spend stream cancellation proposal.
+func (ccsp CancelCommunityPoolSpendStreamProposal) GetDescription() string { return ccsp.Description }
+
+// ProposalRoute returns the routing key of a spend stream cancellation proposal.
+func (ccsp CancelCommunityPoolSpendStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a spend stream cancellation proposal.
+func (ccsp CancelCommunityPoolSpendStreamProposal) ProposalType() string {
+	return ProposalTypeCancelCommunityPoolSpendStream
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ccsp CancelCommunityPoolSpendStreamProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(DefaultCodespace, ccsp)
+}
+
+// String implements the Stringer interface.
+func (ccsp CancelCommunityPoolSpendStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Community Pool Spend Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, ccsp.Title, ccsp.Description, ccsp.StreamID))
+	return b.String()
+}
@@ -13,11 +13,18 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryDelegatorAutoCompounds      = "delegator_auto_compounds"
+	QuerySpendStreams                = "spend_streams"
+	QuerySpendStream                 = "spend_stream"
+	QueryRewardDestinations          = "reward_destinations"
 
 	ParamCommunityTax        = "community_tax"
 	ParamBaseProposerReward  = "base_proposer_reward"
 	ParamBonusProposerReward = "bonus_proposer_reward"
 	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
+
+	ParamAutoCompoundInterval     = "auto_compound_interval"
+	ParamMaxAutoCompoundsPerBlock = "max_auto_compounds_per_block"
 )
 
 // params for query 'custom/distr/validator_outstanding_rewards'
@@ -74,7 +81,8 @@ func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress, validatorAddr
 	}
 }
 
-// params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
+// params for query 'custom/distr/delegator_total_rewards', 'custom/distr/delegator_validators'
+// and 'custom/distr/delegator_auto_compounds'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 }
@@ -95,3 +103,13 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// params for query 'custom/distr/spend_stream'
+type QuerySpendStreamParams struct {
+	StreamID uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewQuerySpendStreamParams creates a new instance of QuerySpendStreamParams.
+func NewQuerySpendStreamParams(streamID uint64) QuerySpendStreamParams {
+	return QuerySpendStreamParams{StreamID: streamID}
+}
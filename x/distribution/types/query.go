@@ -44,3 +44,55 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress,
 	reward sdk.DecCoins) DelegationDelegatorReward {
 	return DelegationDelegatorReward{ValidatorAddress: valAddr, Reward: reward}
 }
+
+// QueryDelegatorAutoCompoundsResponse defines the response of the
+// QueryDelegatorAutoCompounds query: the validators of the delegator's
+// auto-compounding delegations.
+type QueryDelegatorAutoCompoundsResponse []sdk.ValAddress
+
+func (res QueryDelegatorAutoCompoundsResponse) String() string {
+	out := "Auto-Compounding Delegations:"
+	for _, valAddr := range res {
+		out += fmt.Sprintf("\n  ValidatorAddress: %s", valAddr)
+	}
+	return out
+}
+
+// WithdrawAllDelegatorRewardsResponse defines the per-validator breakdown
+// returned in the data of a MsgWithdrawAllDelegatorRewards result.
+type WithdrawAllDelegatorRewardsResponse struct {
+	Rewards    []DelegationWithdrawnReward `json:"rewards" yaml:"rewards"`
+	Commission sdk.Coins                   `json:"commission" yaml:"commission"`
+	Total      sdk.Coins                   `json:"total" yaml:"total"`
+}
+
+// NewWithdrawAllDelegatorRewardsResponse constructs a WithdrawAllDelegatorRewardsResponse
+func NewWithdrawAllDelegatorRewardsResponse(rewards []DelegationWithdrawnReward,
+	commission, total sdk.Coins) WithdrawAllDelegatorRewardsResponse {
+	return WithdrawAllDelegatorRewardsResponse{Rewards: rewards, Commission: commission, Total: total}
+}
+
+func (res WithdrawAllDelegatorRewardsResponse) String() string {
+	out := "Withdrawn Rewards:\n"
+	out += "  Rewards:"
+	for _, reward := range res.Rewards {
+		out += fmt.Sprintf(`
+	ValidatorAddress: %s
+	Reward: %s`, reward.ValidatorAddress, reward.Reward)
+	}
+	out += fmt.Sprintf("\n  Commission: %s", res.Commission)
+	out += fmt.Sprintf("\n  Total: %s\n", res.Total)
+	return strings.TrimSpace(out)
+}
+
+// DelegationWithdrawnReward defines the rewards withdrawn
+// from a single delegation.
+type DelegationWithdrawnReward struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Reward           sdk.Coins      `json:"reward" yaml:"reward"`
+}
+
+// NewDelegationWithdrawnReward constructs a DelegationWithdrawnReward.
+func NewDelegationWithdrawnReward(valAddr sdk.ValAddress, reward sdk.Coins) DelegationWithdrawnReward {
+	return DelegationWithdrawnReward{ValidatorAddress: valAddr, Reward: reward}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxRewardDestinations is the maximum number of destinations the withdrawn
+// rewards of a delegator can be split between
+const MaxRewardDestinations = 10
+
+// RewardDestination defines an address receiving a share of the rewards and
+// commission withdrawn by a delegator
+type RewardDestination struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"` // address receiving the share
+	Share   sdk.Dec        `json:"share" yaml:"share"`     // share of the withdrawn rewards, in (0, 1]
+}
+
+// NewRewardDestination creates a new RewardDestination instance
+func NewRewardDestination(addr sdk.AccAddress, share sdk.Dec) RewardDestination {
+	return RewardDestination{
+		Address: addr,
+		Share:   share,
+	}
+}
+
+// String implements the Stringer interface.
+func (d RewardDestination) String() string {
+	return fmt.Sprintf("%s:%s", d.Address, d.Share)
+}
+
+// RewardDestinations defines how the withdrawn rewards of a delegator are
+// split between addresses. The shares of all destinations sum to one.
+type RewardDestinations []RewardDestination
+
+// Validate performs basic validation of the destinations.
+func (ds RewardDestinations) Validate() error {
+	if len(ds) == 0 {
+		return errors.New("reward destinations cannot be empty")
+	}
+	if len(ds) > MaxRewardDestinations {
+		return fmt.Errorf("rewards cannot be split between more than %d destinations, got %d",
+			MaxRewardDestinations, len(ds))
+	}
+
+	seen := make(map[string]bool, len(ds))
+	total := sdk.ZeroDec()
+	for _, d := range ds {
+		if d.Address.Empty() {
+			return errors.New("reward destination address cannot be empty")
+		}
+		if seen[d.Address.String()] {
+			return fmt.Errorf("duplicate reward destination %s", d.Address)
+		}
+		seen[d.Address.String()] = true
+
+		if !d.Share.IsPositive() {
+			return fmt.Errorf("reward destination %s share must be positive, is %s", d.Address, d.Share)
+		}
+		total = total.Add(d.Share)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("reward destination shares must sum to one, sum to %s", total)
+	}
+	return nil
+}
+
+// Split splits coins between the destinations according to their shares. The
+// amounts of all but the last destination are truncated, and the last one
+// receives the remainder so that no coin is lost.
+func (ds RewardDestinations) Split(coins sdk.Coins) []sdk.Coins {
+	amounts := make([]sdk.Coins, len(ds))
+	remainder := coins
+	for i, d := range ds[:len(ds)-1] {
+		amounts[i], _ = sdk.NewDecCoins(coins).MulDecTruncate(d.Share).TruncateDecimal()
+		remainder = remainder.Sub(amounts[i])
+	}
+	amounts[len(ds)-1] = remainder
+	return amounts
+}
+
+// String implements the Stringer interface.
+func (ds RewardDestinations) String() string {
+	out := make([]string, len(ds))
+	for i, d := range ds {
+		out[i] = d.String()
+	}
+	return strings.Join(out, ",")
+}
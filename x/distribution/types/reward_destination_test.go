@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRewardDestinationsValidate(t *testing.T) {
+	third := sdk.OneDec().QuoInt64(3)
+	tests := []struct {
+		name         string
+		destinations RewardDestinations
+		expectPass   bool
+	}{
+		{"single", RewardDestinations{NewRewardDestination(delAddr1, sdk.OneDec())}, true},
+		{"split", RewardDestinations{
+			NewRewardDestination(delAddr1, sdk.NewDecWithPrec(8, 1)),
+			NewRewardDestination(delAddr2, sdk.NewDecWithPrec(2, 1)),
+		}, true},
+		{"empty", RewardDestinations{}, false},
+		{"sum below one", RewardDestinations{
+			NewRewardDestination(delAddr1, third),
+			NewRewardDestination(delAddr2, third),
+			NewRewardDestination(delAddr3, third),
+		}, false},
+		{"sum above one", RewardDestinations{
+			NewRewardDestination(delAddr1, sdk.OneDec()),
+			NewRewardDestination(delAddr2, sdk.NewDecWithPrec(1, 1)),
+		}, false},
+		{"zero share", RewardDestinations{
+			NewRewardDestination(delAddr1, sdk.OneDec()),
+			NewRewardDestination(delAddr2, sdk.ZeroDec()),
+		}, false},
+		{"duplicate", RewardDestinations{
+			NewRewardDestination(delAddr1, sdk.NewDecWithPrec(5, 1)),
+			NewRewardDestination(delAddr1, sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{"empty address", RewardDestinations{NewRewardDestination(emptyDelAddr, sdk.OneDec())}, false},
+	}
+
+	for _, tc := range tests {
+		err := tc.destinations.Validate()
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRewardDestinationsSplit(t *testing.T) {
+	destinations := RewardDestinations{
+		NewRewardDestination(delAddr1, sdk.NewDecWithPrec(8, 1)),
+		NewRewardDestination(delAddr2, sdk.NewDecWithPrec(2, 1)),
+	}
+
+	// the last destination receives the truncated remainder
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 101), sdk.NewInt64Coin("photon", 3))
+	amounts := destinations.Split(coins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 80), sdk.NewInt64Coin("photon", 2)), amounts[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 21), sdk.NewInt64Coin("photon", 1)), amounts[1])
+	require.Equal(t, coins, amounts[0].Add(amounts[1]))
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommunityPoolSpendStream defines the remaining payments of a community pool
+// recurring spend approved by governance. A payment of Amount is made to the
+// recipient once every Period until no payment remains.
+type CommunityPoolSpendStream struct {
+	ID                uint64         `json:"id" yaml:"id"`                                 // ID of the stream
+	ProposalID        uint64         `json:"proposal_id" yaml:"proposal_id"`               // ID of the proposal creating the stream
+	Recipient         sdk.AccAddress `json:"recipient" yaml:"recipient"`                   // recipient of the payments
+	Amount            sdk.Coins      `json:"amount" yaml:"amount"`                         // amount paid every period
+	Period            time.Duration  `json:"period" yaml:"period"`                         // time between two payments
+	RemainingPayments uint64         `json:"remaining_payments" yaml:"remaining_payments"` // number of payments left
+	NextPaymentTime   time.Time      `json:"next_payment_time" yaml:"next_payment_time"`   // time at which the next payment is due
+}
+
+// NewCommunityPoolSpendStream creates a new CommunityPoolSpendStream instance
+func NewCommunityPoolSpendStream(id, proposalID uint64, recipient sdk.AccAddress, amount sdk.Coins,
+	period time.Duration, remainingPayments uint64, nextPaymentTime time.Time) CommunityPoolSpendStream {
+
+	return CommunityPoolSpendStream{
+		ID:                id,
+		ProposalID:        proposalID,
+		Recipient:         recipient,
+		Amount:            amount,
+		Period:            period,
+		RemainingPayments: remainingPayments,
+		NextPaymentTime:   nextPaymentTime,
+	}
+}
+
+// Validate performs basic validation of the stream.
+func (s CommunityPoolSpendStream) Validate() error {
+	if s.Recipient.Empty() {
+		return errors.New("community pool spend stream recipient cannot be empty")
+	}
+	if !s.Amount.IsValid() || s.Amount.Empty() {
+		return fmt.Errorf("invalid community pool spend stream amount: %s", s.Amount)
+	}
+	if s.Period <= 0 {
+		return fmt.Errorf("community pool spend stream period must be positive: %s", s.Period)
+	}
+	if s.RemainingPayments == 0 {
+		return fmt.Errorf("community pool spend stream %d has no remaining payments", s.ID)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (s CommunityPoolSpendStream) String() string {
+	return fmt.Sprintf(`Community Pool Spend Stream %d:
+  Proposal ID:        %d
+  Recipient:          %s
+  Amount:             %s
+  Period:             %s
+  Remaining Payments: %d
+  Next Payment Time:  %s`,
+		s.ID, s.ProposalID, s.Recipient, s.Amount, s.Period, s.RemainingPayments, s.NextPaymentTime,
+	)
+}
+
+// CommunityPoolSpendStreams is a collection of CommunityPoolSpendStream
+type CommunityPoolSpendStreams []CommunityPoolSpendStream
+
+// String implements the Stringer interface.
+func (ss CommunityPoolSpendStreams) String() string {
+	if len(ss) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(ss))
+	for i, s := range ss {
+		out[i] = s.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -70,6 +70,8 @@ var (
 	RegisterCodec                 = types.RegisterCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
 	ValidateAbstract              = types.ValidateAbstract
+	ContextWithProposalID         = types.ContextWithProposalID
+	ProposalIDFromContext         = types.ProposalIDFromContext
 	NewDeposit                    = types.NewDeposit
 	ErrUnknownProposal            = types.ErrUnknownProposal
 	ErrInactiveProposal           = types.ErrInactiveProposal
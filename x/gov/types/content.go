@@ -30,6 +30,23 @@ type Content interface {
 // governance process.
 type Handler func(ctx sdk.Context, content Content) sdk.Error
 
+type contextKey int
+
+const proposalIDContextKey contextKey = iota
+
+// ContextWithProposalID returns a context carrying the ID of the proposal whose
+// content is being executed, so that a Handler can refer to it.
+func ContextWithProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(proposalIDContextKey, proposalID)
+}
+
+// ProposalIDFromContext returns the ID of the proposal whose content is being
+// executed, if any.
+func ProposalIDFromContext(ctx sdk.Context) (uint64, bool) {
+	proposalID, ok := ctx.Value(proposalIDContextKey).(uint64)
+	return proposalID, ok
+}
+
 // ValidateAbstract validates a proposal's abstract contents returning an error
 // if invalid.
 func ValidateAbstract(codespace sdk.CodespaceType, c Content) sdk.Error {
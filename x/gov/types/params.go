@@ -31,9 +31,9 @@ var (
 // ParamKeyTable - Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeyDepositParams, DepositParams{},
-		ParamStoreKeyVotingParams, VotingParams{},
-		ParamStoreKeyTallyParams, TallyParams{},
+		params.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
+		params.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
+		params.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
 	)
 }
 
@@ -71,6 +71,20 @@ func (dp DepositParams) Equal(dp2 DepositParams) bool {
 	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
 }
 
+func validateDepositParams(i interface{}) error {
+	v, ok := i.(DepositParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.MinDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit: %s", v.MinDeposit)
+	}
+	if v.MaxDepositPeriod <= 0 {
+		return fmt.Errorf("maximum deposit period must be positive: %s", v.MaxDepositPeriod)
+	}
+	return nil
+}
+
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
 	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
@@ -101,6 +115,23 @@ func (tp TallyParams) String() string {
 		tp.Quorum, tp.Threshold, tp.Veto)
 }
 
+func validateTallyParams(i interface{}) error {
+	v, ok := i.(TallyParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.Quorum.IsNil() || v.Quorum.IsNegative() || v.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("quorum must be between 0 and 1: %s", v.Quorum)
+	}
+	if v.Threshold.IsNil() || !v.Threshold.IsPositive() || v.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("vote threshold must be positive and less or equal to one: %s", v.Threshold)
+	}
+	if v.Veto.IsNil() || !v.Veto.IsPositive() || v.Veto.GT(sdk.OneDec()) {
+		return fmt.Errorf("veto threshold must be positive and less or equal to one: %s", v.Veto)
+	}
+	return nil
+}
+
 // VotingParams defines the params around Voting in governance
 type VotingParams struct {
 	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
@@ -124,6 +155,17 @@ func (vp VotingParams) String() string {
   Voting Period:      %s`, vp.VotingPeriod)
 }
 
+func validateVotingParams(i interface{}) error {
+	v, ok := i.(VotingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.VotingPeriod <= 0 {
+		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
+	}
+	return nil
+}
+
 // Params returns all of the governance params
 type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
@@ -5,6 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +26,14 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestContextWithProposalID(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	_, ok := ProposalIDFromContext(ctx)
+	require.False(t, ok)
+
+	proposalID, ok := ProposalIDFromContext(ContextWithProposalID(ctx, 5))
+	require.True(t, ok)
+	require.Equal(t, uint64(5), proposalID)
+}
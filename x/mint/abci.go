@@ -6,20 +6,18 @@ import (
 )
 
 // BeginBlocker mints new tokens for the previous block.
-func BeginBlocker(ctx sdk.Context, k Keeper) {
+func BeginBlocker(ctx sdk.Context, k Keeper, inflationCalculationFn InflationCalculationFn) {
 	// fetch stored minter & params
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
-	// recalculate inflation rate
-	totalStakingSupply := k.StakingTokenSupply(ctx)
+	// recalculate inflation rate and the provisions of this block
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	minter, mintedCoin := inflationCalculationFn(ctx, k, minter, params, bondedRatio)
 	k.SetMinter(ctx, minter)
+	k.SetLastBlockTime(ctx, ctx.BlockHeader().Time)
 
 	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -27,8 +25,8 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// send the minted coins to the mint destinations
+	err = k.DistributeMintedCoins(ctx, params.Destinations, mintedCoins)
 	if err != nil {
 		panic(err)
 	}
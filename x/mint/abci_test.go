@@ -0,0 +1,126 @@
+package mint_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func createTestApp(t *testing.T) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(1000000, 0).UTC()})
+	app.MintKeeper.SetParams(ctx, mint.DefaultParams())
+	app.MintKeeper.SetMinter(ctx, mint.DefaultInitialMinter())
+
+	// provide a staking token supply to mint provisions from
+	supply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000000)))
+	require.NoError(t, app.SupplyKeeper.MintCoins(ctx, mint.ModuleName, supply))
+
+	return app, ctx
+}
+
+func TestBlockTimeInflationCalculationFn(t *testing.T) {
+	app, ctx := createTestApp(t)
+	minter := app.MintKeeper.GetMinter(ctx)
+	params := app.MintKeeper.GetParams(ctx)
+	bondedRatio := sdk.ZeroDec()
+
+	// without a previous block time the default model is used
+	expMinter, expCoin := mint.DefaultInflationCalculationFn(ctx, app.MintKeeper, minter, params, bondedRatio)
+	gotMinter, gotCoin := mint.BlockTimeInflationCalculationFn(ctx, app.MintKeeper, minter, params, bondedRatio)
+	require.Equal(t, expMinter, gotMinter)
+	require.Equal(t, expCoin, gotCoin)
+
+	// a block taking an hour mints an hour worth of the annual provisions
+	app.MintKeeper.SetLastBlockTime(ctx, ctx.BlockHeader().Time.Add(-time.Hour))
+	gotMinter, gotCoin = mint.BlockTimeInflationCalculationFn(ctx, app.MintKeeper, minter, params, bondedRatio)
+	require.True(t, gotMinter.Inflation.GT(minter.Inflation))
+	expAmount := gotMinter.AnnualProvisions.MulInt64(int64(time.Hour)).QuoInt64(int64(mint.Year)).TruncateInt()
+	require.Equal(t, expAmount, gotCoin.Amount)
+	require.True(t, gotCoin.Amount.GT(expCoin.Amount))
+}
+
+func TestBeginBlockerCustomInflationCalculationFn(t *testing.T) {
+	app, ctx := createTestApp(t)
+	feeCollector := app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+
+	// a fixed schedule halving the block reward every 10 blocks
+	halvingFn := func(ctx sdk.Context, _ mint.Keeper, minter mint.Minter, params mint.Params, _ sdk.Dec) (mint.Minter, sdk.Coin) {
+		reward := sdk.NewInt(1000).QuoRaw(1 << uint(ctx.BlockHeight()/10))
+		return minter, sdk.NewCoin(params.MintDenom, reward)
+	}
+
+	ctx = ctx.WithBlockHeight(25)
+	mint.BeginBlocker(ctx, app.MintKeeper, halvingFn)
+
+	balance := app.AccountKeeper.GetAccount(ctx, feeCollector).GetCoins()
+	require.Equal(t, sdk.NewInt(250), balance.AmountOf(sdk.DefaultBondDenom))
+
+	lastBlockTime, found := app.MintKeeper.GetLastBlockTime(ctx)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeader().Time, lastBlockTime)
+}
+
+func TestBeginBlockerMintDestinations(t *testing.T) {
+	app, ctx := createTestApp(t)
+	feeCollector := app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.Destinations = []mint.MintDestination{
+		mint.NewModuleMintDestination(auth.FeeCollectorName, sdk.NewDecWithPrec(7, 1)),
+		mint.NewAddressMintDestination(addr, sdk.NewDecWithPrec(3, 1)),
+	}
+	app.MintKeeper.SetParams(ctx, params)
+
+	fixedFn := func(_ sdk.Context, _ mint.Keeper, minter mint.Minter, params mint.Params, _ sdk.Dec) (mint.Minter, sdk.Coin) {
+		return minter, sdk.NewCoin(params.MintDenom, sdk.NewInt(1001))
+	}
+	mint.BeginBlocker(ctx, app.MintKeeper, fixedFn)
+
+	// the truncation remainder goes to the last destination
+	feeBalance := app.AccountKeeper.GetAccount(ctx, feeCollector).GetCoins()
+	require.Equal(t, sdk.NewInt(700), feeBalance.AmountOf(sdk.DefaultBondDenom))
+	addrBalance := app.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	require.Equal(t, sdk.NewInt(301), addrBalance.AmountOf(sdk.DefaultBondDenom))
+
+	var destinationEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == mint.EventTypeMintDestination {
+			destinationEvents++
+		}
+	}
+	require.Equal(t, 2, destinationEvents)
+}
+
+func TestMintDestinationsRestricted(t *testing.T) {
+	app, ctx := createTestApp(t)
+	bondedPool := app.SupplyKeeper.GetModuleAddress(staking.BondedPoolName)
+
+	for _, destination := range []mint.MintDestination{
+		mint.NewModuleMintDestination(distr.ModuleName, sdk.OneDec()),
+		mint.NewModuleMintDestination(staking.NotBondedPoolName, sdk.OneDec()),
+		mint.NewAddressMintDestination(bondedPool, sdk.OneDec()),
+	} {
+		params := app.MintKeeper.GetParams(ctx)
+		params.Destinations = []mint.MintDestination{destination}
+		require.Panics(t, func() { app.MintKeeper.SetParams(ctx, params) }, destination.String())
+
+		subspace, ok := app.ParamsKeeper.GetSubspace(mint.DefaultParamspace)
+		require.True(t, ok)
+		bz, err := app.Codec().MarshalJSON(params.Destinations)
+		require.NoError(t, err)
+		require.Error(t, subspace.Update(ctx, mint.KeyDestinations, bz), destination.String())
+	}
+}
@@ -18,34 +18,47 @@ const (
 	QueryParameters       = types.QueryParameters
 	QueryInflation        = types.QueryInflation
 	QueryAnnualProvisions = types.QueryAnnualProvisions
+	Year                  = types.Year
+
+	EventTypeMint            = types.EventTypeMint
+	EventTypeMintDestination = types.EventTypeMintDestination
 )
 
 var (
 	// functions aliases
-	NewKeeper            = keeper.NewKeeper
-	NewQuerier           = keeper.NewQuerier
-	NewMinter            = types.NewMinter
-	InitialMinter        = types.InitialMinter
-	DefaultInitialMinter = types.DefaultInitialMinter
-	ValidateMinter       = types.ValidateMinter
-	ParamKeyTable        = types.ParamKeyTable
-	NewParams            = types.NewParams
-	DefaultParams        = types.DefaultParams
-	ValidateParams       = types.ValidateParams
+	NewKeeper               = keeper.NewKeeper
+	NewQuerier              = keeper.NewQuerier
+	NewMinter               = types.NewMinter
+	InitialMinter           = types.InitialMinter
+	DefaultInitialMinter    = types.DefaultInitialMinter
+	ValidateMinter          = types.ValidateMinter
+	ParamKeyTable           = types.ParamKeyTable
+	RestrictedParamKeyTable = types.RestrictedParamKeyTable
+	NewParams               = types.NewParams
+	DefaultParams           = types.DefaultParams
+	ValidateParams          = types.ValidateParams
+
+	NewModuleMintDestination          = types.NewModuleMintDestination
+	NewAddressMintDestination         = types.NewAddressMintDestination
+	ValidateMintDestinations          = types.ValidateMintDestinations
+	ValidateMintDestinationRecipients = types.ValidateMintDestinationRecipients
 
 	// variable aliases
 	ModuleCdc              = types.ModuleCdc
 	MinterKey              = types.MinterKey
+	LastBlockTimeKey       = types.LastBlockTimeKey
 	KeyMintDenom           = types.KeyMintDenom
 	KeyInflationRateChange = types.KeyInflationRateChange
 	KeyInflationMax        = types.KeyInflationMax
 	KeyInflationMin        = types.KeyInflationMin
 	KeyGoalBonded          = types.KeyGoalBonded
 	KeyBlocksPerYear       = types.KeyBlocksPerYear
+	KeyDestinations        = types.KeyDestinations
 )
 
 type (
-	Keeper = keeper.Keeper
-	Minter = types.Minter
-	Params = types.Params
+	Keeper          = keeper.Keeper
+	Minter          = types.Minter
+	Params          = types.Params
+	MintDestination = types.MintDestination
 )
@@ -1,13 +1,16 @@
 package mint
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState - minter state
 type GenesisState struct {
-	Minter Minter `json:"minter" yaml:"minter"` // minter object
-	Params Params `json:"params" yaml:"params"` // inflation params
+	Minter        Minter     `json:"minter" yaml:"minter"`                                       // minter object
+	Params        Params     `json:"params" yaml:"params"`                                       // inflation params
+	LastBlockTime *time.Time `json:"last_block_time,omitempty" yaml:"last_block_time,omitempty"` // time of the last minting block
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -30,13 +33,20 @@ func DefaultGenesisState() GenesisState {
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetMinter(ctx, data.Minter)
 	keeper.SetParams(ctx, data.Params)
+	if data.LastBlockTime != nil {
+		keeper.SetLastBlockTime(ctx, *data.LastBlockTime)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
-	return NewGenesisState(minter, params)
+	gs := NewGenesisState(minter, params)
+	if lastBlockTime, found := keeper.GetLastBlockTime(ctx); found {
+		gs.LastBlockTime = &lastBlockTime
+	}
+	return gs
 }
 
 // ValidateGenesis validates the provided genesis state to ensure the
@@ -0,0 +1,39 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InflationCalculationFn defines the function used by the BeginBlocker to
+// calculate the minter for the current block along with the coin to be minted
+// in it. Applications may provide their own function to the AppModule in order
+// to use an alternative inflation model, e.g. a fixed schedule, a halving
+// every N blocks or a capped total supply.
+type InflationCalculationFn func(ctx sdk.Context, k Keeper, minter Minter, params Params, bondedRatio sdk.Dec) (Minter, sdk.Coin)
+
+// DefaultInflationCalculationFn is the default inflation model. The inflation
+// rate follows the bonded ratio towards GoalBonded and the annual provisions
+// are minted over BlocksPerYear blocks.
+func DefaultInflationCalculationFn(ctx sdk.Context, k Keeper, minter Minter, params Params, bondedRatio sdk.Dec) (Minter, sdk.Coin) {
+	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, k.StakingTokenSupply(ctx))
+	return minter, minter.BlockProvision(params)
+}
+
+// BlockTimeInflationCalculationFn follows the same bonded ratio controller as
+// the default inflation model, but derives the inflation change and the
+// provisions of a block from the time elapsed since the previous block rather
+// than assuming BlocksPerYear blocks per year. The first block minted with
+// this model, for which no previous block time is known, falls back to the
+// default model.
+func BlockTimeInflationCalculationFn(ctx sdk.Context, k Keeper, minter Minter, params Params, bondedRatio sdk.Dec) (Minter, sdk.Coin) {
+	lastBlockTime, found := k.GetLastBlockTime(ctx)
+	if !found {
+		return DefaultInflationCalculationFn(ctx, k, minter, params, bondedRatio)
+	}
+
+	elapsed := ctx.BlockHeader().Time.Sub(lastBlockTime)
+	minter.Inflation = minter.NextInflationRateByTime(params, bondedRatio, elapsed)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, k.StakingTokenSupply(ctx))
+	return minter, minter.BlockProvisionByTime(params, elapsed)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -19,25 +20,39 @@ type Keeper struct {
 	sk               types.StakingKeeper
 	supplyKeeper     types.SupplyKeeper
 	feeCollectorName string
+
+	// module accounts and addresses the minted provisions can be sent to
+	destinationModules map[string]bool
+	blacklistedAddrs   map[string]bool
 }
 
-// NewKeeper creates a new mint Keeper instance
+// NewKeeper creates a new mint Keeper instance. The minted provisions can only
+// be sent to the fee collector and to the given destination modules, and not
+// to the blacklisted addresses.
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
-	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string) Keeper {
+	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string,
+	destinationModules []string, blacklistedAddrs map[string]bool) Keeper {
 
 	// ensure mint module account is set
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the mint module account has not been set")
 	}
 
+	allowedModules := map[string]bool{feeCollectorName: true}
+	for _, name := range destinationModules {
+		allowedModules[name] = true
+	}
+
 	return Keeper{
-		cdc:              cdc,
-		storeKey:         key,
-		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
-		sk:               sk,
-		supplyKeeper:     supplyKeeper,
-		feeCollectorName: feeCollectorName,
+		cdc:                cdc,
+		storeKey:           key,
+		paramSpace:         paramSpace.WithKeyTable(types.RestrictedParamKeyTable(allowedModules, blacklistedAddrs)),
+		sk:                 sk,
+		supplyKeeper:       supplyKeeper,
+		feeCollectorName:   feeCollectorName,
+		destinationModules: allowedModules,
+		blacklistedAddrs:   blacklistedAddrs,
 	}
 }
 
@@ -67,6 +82,27 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, b)
 }
 
+// GetLastBlockTime returns the time of the last block in which tokens were
+// minted, if any.
+func (k Keeper) GetLastBlockTime(ctx sdk.Context) (blockTime time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LastBlockTimeKey)
+	if b == nil {
+		return blockTime, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &blockTime)
+	return blockTime, true
+}
+
+// SetLastBlockTime sets the time of the last block in which tokens were
+// minted.
+func (k Keeper) SetLastBlockTime(ctx sdk.Context, blockTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(blockTime)
+	store.Set(types.LastBlockTimeKey, b)
+}
+
 //______________________________________________________________________
 
 // GetParams returns the total set of minting parameters.
@@ -109,3 +145,71 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) sdk.Error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) sdk.Error {
 	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeMintedCoins sends the minted coins to the given destinations
+// according to their shares, or to the fee collector if no destination is
+// set or the destinations are invalid. Any remainder left by truncation is
+// sent to the last destination, and the share of a module destination without
+// a module account is sent to the fee collector.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, destinations []types.MintDestination, minted sdk.Coins) sdk.Error {
+	if len(destinations) == 0 {
+		return k.AddCollectedFees(ctx, minted)
+	}
+
+	// guard against destinations which do not add up or which are not allowed
+	err := types.ValidateMintDestinations(destinations)
+	if err == nil {
+		err = types.ValidateMintDestinationRecipients(destinations, k.destinationModules, k.blacklistedAddrs)
+	}
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("invalid mint destinations, sending the provisions to %s: %s",
+			k.feeCollectorName, err))
+		return k.AddCollectedFees(ctx, minted)
+	}
+
+	remaining := minted
+	for i, d := range destinations {
+		amt := remaining
+		if i < len(destinations)-1 {
+			amt, _ = sdk.NewDecCoins(minted).MulDecTruncate(d.Share).TruncateDecimal()
+		}
+		remaining = remaining.Sub(amt)
+		if amt.IsZero() {
+			continue
+		}
+
+		var recipient sdk.AccAddress
+		if d.IsModule() {
+			moduleName := d.ModuleName
+			recipient = k.supplyKeeper.GetModuleAddress(moduleName)
+			if recipient == nil {
+				k.Logger(ctx).Error(fmt.Sprintf("mint destination module account %s does not exist, sending its share to %s",
+					moduleName, k.feeCollectorName))
+				moduleName = k.feeCollectorName
+				recipient = k.supplyKeeper.GetModuleAddress(moduleName)
+			}
+
+			err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, moduleName, amt)
+			if err != nil {
+				return err
+			}
+		} else {
+			recipient = d.Address
+			err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amt)
+			if err != nil {
+				return err
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintDestination,
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyShare, d.Share.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+			),
+		)
+	}
+
+	return nil
+}
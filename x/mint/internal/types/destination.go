@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintDestination defines a recipient of a share of the minted provisions,
+// either a module account or a fixed address.
+type MintDestination struct {
+	ModuleName string         `json:"module_name,omitempty" yaml:"module_name,omitempty"` // name of the recipient module account
+	Address    sdk.AccAddress `json:"address,omitempty" yaml:"address,omitempty"`         // address of the recipient account
+	Share      sdk.Dec        `json:"share" yaml:"share"`                                 // share of the minted provisions
+}
+
+// NewModuleMintDestination returns a new MintDestination to a module account.
+func NewModuleMintDestination(moduleName string, share sdk.Dec) MintDestination {
+	return MintDestination{
+		ModuleName: moduleName,
+		Share:      share,
+	}
+}
+
+// NewAddressMintDestination returns a new MintDestination to an address.
+func NewAddressMintDestination(address sdk.AccAddress, share sdk.Dec) MintDestination {
+	return MintDestination{
+		Address: address,
+		Share:   share,
+	}
+}
+
+// IsModule returns true if the destination is a module account.
+func (d MintDestination) IsModule() bool {
+	return d.ModuleName != ""
+}
+
+func (d MintDestination) String() string {
+	if d.IsModule() {
+		return fmt.Sprintf("%s module: %s", d.ModuleName, d.Share)
+	}
+	return fmt.Sprintf("%s: %s", d.Address, d.Share)
+}
+
+// ValidateMintDestinations validates a set of mint destinations. Either no
+// destination is set, or the shares of all destinations sum to one.
+func ValidateMintDestinations(destinations []MintDestination) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, d := range destinations {
+		if d.IsModule() == !d.Address.Empty() {
+			return fmt.Errorf("mint destination must have exactly one of a module name or an address, got %s", d)
+		}
+		if d.Share.IsNil() || !d.Share.IsPositive() {
+			return fmt.Errorf("mint destination share should be positive, is %s", d.Share)
+		}
+
+		key := d.ModuleName
+		if !d.IsModule() {
+			key = d.Address.String()
+		}
+		if seen[key] {
+			return fmt.Errorf("duplicate mint destination %s", key)
+		}
+		seen[key] = true
+
+		total = total.Add(d.Share)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("mint destination shares must sum to 1, is %s", total)
+	}
+	return nil
+}
+
+// ValidateMintDestinationRecipients checks that the module destinations are
+// among the allowed modules and that the address destinations are not
+// blacklisted, so that the minted provisions cannot be sent to a module
+// account whose balance is tracked by an invariant, e.g. the staking pools.
+func ValidateMintDestinationRecipients(destinations []MintDestination,
+	allowedModules, blacklistedAddrs map[string]bool) error {
+
+	for _, d := range destinations {
+		if d.IsModule() {
+			if !allowedModules[d.ModuleName] {
+				return fmt.Errorf("module %s is not allowed as a mint destination", d.ModuleName)
+			}
+			continue
+		}
+		if blacklistedAddrs[d.Address.String()] {
+			return fmt.Errorf("address %s is not allowed as a mint destination", d.Address)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateMintDestinations(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name         string
+		destinations []MintDestination
+		expPass      bool
+	}{
+		{"no destinations", nil, true},
+		{"single module", []MintDestination{NewModuleMintDestination("fee_collector", sdk.OneDec())}, true},
+		{"module and address", []MintDestination{
+			NewModuleMintDestination("fee_collector", half),
+			NewAddressMintDestination(addr, half),
+		}, true},
+		{"shares below one", []MintDestination{NewModuleMintDestination("fee_collector", half)}, false},
+		{"shares above one", []MintDestination{
+			NewModuleMintDestination("fee_collector", sdk.OneDec()),
+			NewAddressMintDestination(addr, half),
+		}, false},
+		{"zero share", []MintDestination{
+			NewModuleMintDestination("fee_collector", sdk.OneDec()),
+			NewAddressMintDestination(addr, sdk.ZeroDec()),
+		}, false},
+		{"duplicate destination", []MintDestination{
+			NewAddressMintDestination(addr, half),
+			NewAddressMintDestination(addr, half),
+		}, false},
+		{"no recipient", []MintDestination{{Share: sdk.OneDec()}}, false},
+		{"module and address in one destination", []MintDestination{
+			{ModuleName: "fee_collector", Address: addr, Share: sdk.OneDec()},
+		}, false},
+	}
+
+	for _, tc := range tests {
+		err := ValidateMintDestinations(tc.destinations)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidateMintDestinationRecipients(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	blacklisted := sdk.AccAddress([]byte("addr2_______________"))
+	allowedModules := map[string]bool{"fee_collector": true}
+	blacklistedAddrs := map[string]bool{blacklisted.String(): true}
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name         string
+		destinations []MintDestination
+		expPass      bool
+	}{
+		{"no destination", nil, true},
+		{"allowed module and address", []MintDestination{
+			NewModuleMintDestination("fee_collector", half),
+			NewAddressMintDestination(addr, half),
+		}, true},
+		{"module not allowed", []MintDestination{
+			NewModuleMintDestination("fee_collector", half),
+			NewModuleMintDestination("distribution", half),
+		}, false},
+		{"blacklisted address", []MintDestination{
+			NewModuleMintDestination("fee_collector", half),
+			NewAddressMintDestination(blacklisted, half),
+		}, false},
+	}
+
+	for _, tc := range tests {
+		err := ValidateMintDestinationRecipients(tc.destinations, allowedModules, blacklistedAddrs)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -2,9 +2,12 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint            = ModuleName
+	EventTypeMintDestination = "mint_destination"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyShare            = "share"
 )
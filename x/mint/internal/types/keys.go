@@ -1,7 +1,10 @@
 package types
 
-// the one key to use for the keeper store
-var MinterKey = []byte{0x00}
+// keys for the keeper store
+var (
+	MinterKey        = []byte{0x00} // key for the minter
+	LastBlockTimeKey = []byte{0x01} // key for the time of the last minting block
+)
 
 // nolint
 const (
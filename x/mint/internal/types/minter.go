@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -46,6 +47,10 @@ func ValidateMinter(minter Minter) error {
 	return nil
 }
 
+// Year is the length of a year used to scale annual rates by elapsed block
+// time. It matches the 8766 hours assumed by the default BlocksPerYear.
+const Year = 8766 * time.Hour
+
 // NextInflationRate returns the new inflation rate for the next hour.
 func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
 	// The target annual inflation rate is recalculated for each previsions cycle. The
@@ -53,14 +58,29 @@ func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
 	// the distance from the desired ratio (67%). The maximum rate change possible is
 	// defined to be 13% per year, however the annual inflation is capped as between
 	// 7% and 20%.
+	inflationRateChange := inflationRateChangePerYear(params, bondedRatio).
+		Quo(sdk.NewDec(int64(params.BlocksPerYear)))
+	return m.adjustInflation(params, inflationRateChange)
+}
+
+// NextInflationRateByTime returns the new inflation rate after the given
+// amount of time has elapsed, scaling the annual rate change by the fraction
+// of a year elapsed instead of assuming BlocksPerYear blocks per year.
+func (m Minter) NextInflationRateByTime(params Params, bondedRatio sdk.Dec, elapsed time.Duration) sdk.Dec {
+	inflationRateChange := inflationRateChangePerYear(params, bondedRatio).Mul(yearFraction(elapsed))
+	return m.adjustInflation(params, inflationRateChange)
+}
 
-	// (1 - bondedRatio/GoalBonded) * InflationRateChange
-	inflationRateChangePerYear := sdk.OneDec().
+// (1 - bondedRatio/GoalBonded) * InflationRateChange
+func inflationRateChangePerYear(params Params, bondedRatio sdk.Dec) sdk.Dec {
+	return sdk.OneDec().
 		Sub(bondedRatio.Quo(params.GoalBonded)).
 		Mul(params.InflationRateChange)
-	inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(params.BlocksPerYear)))
+}
 
-	// adjust the new annual inflation for this next cycle
+// adjust the annual inflation by the given change, bounded by the inflation
+// limits of the params
+func (m Minter) adjustInflation(params Params, inflationRateChange sdk.Dec) sdk.Dec {
 	inflation := m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
 	if inflation.GT(params.InflationMax) {
 		inflation = params.InflationMax
@@ -84,3 +104,22 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// BlockProvisionByTime returns the provisions for a block that took the given
+// amount of time based on the annual provisions rate.
+func (m Minter) BlockProvisionByTime(params Params, elapsed time.Duration) sdk.Coin {
+	if elapsed <= 0 {
+		return sdk.NewCoin(params.MintDenom, sdk.ZeroInt())
+	}
+	provisionAmt := m.AnnualProvisions.MulInt64(int64(elapsed)).QuoInt64(int64(Year))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
+
+// yearFraction returns the fraction of a year represented by the given
+// duration, floored at zero.
+func yearFraction(elapsed time.Duration) sdk.Dec {
+	if elapsed <= 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(int64(elapsed)).QuoInt64(int64(Year))
+}
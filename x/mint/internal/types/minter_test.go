@@ -3,6 +3,7 @@ package types
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -82,6 +83,48 @@ func TestBlockProvision(t *testing.T) {
 	}
 }
 
+func TestNextInflationByTime(t *testing.T) {
+	minter := InitialMinter(sdk.NewDecWithPrec(10, 2))
+	params := DefaultParams()
+
+	// a year at 0% bonded increases the inflation by InflationRateChange
+	inflation := minter.NextInflationRateByTime(params, sdk.ZeroDec(), Year)
+	require.True(t, inflation.Equal(sdk.NewDecWithPrec(20, 2)), inflation.String())
+
+	// a negative or zero duration does not change the inflation
+	inflation = minter.NextInflationRateByTime(params, sdk.ZeroDec(), -time.Hour)
+	require.True(t, inflation.Equal(minter.Inflation), inflation.String())
+
+	// the change is scaled by the fraction of a year elapsed
+	inflation = minter.NextInflationRateByTime(params, sdk.ZeroDec(), Year/2)
+	require.True(t, inflation.Equal(sdk.NewDecWithPrec(165, 3)), inflation.String())
+}
+
+func TestBlockProvisionByTime(t *testing.T) {
+	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
+	params := DefaultParams()
+	minter.AnnualProvisions = sdk.NewDec(int64(Year / time.Second))
+
+	tests := []struct {
+		elapsed       time.Duration
+		expProvisions int64
+	}{
+		{5 * time.Second, 5},
+		{5*time.Second + time.Millisecond, 5},
+		{time.Hour, 3600},
+		{0, 0},
+		{-time.Second, 0},
+	}
+	for i, tc := range tests {
+		provisions := minter.BlockProvisionByTime(params, tc.elapsed)
+
+		expProvisions := sdk.NewCoin(params.MintDenom, sdk.NewInt(tc.expProvisions))
+		require.True(t, expProvisions.IsEqual(provisions),
+			"test: %v\n\tExp: %v\n\tGot: %v\n",
+			i, tc.expProvisions, provisions)
+	}
+}
+
 // Benchmarking :)
 // previously using sdk.Int operations:
 // BenchmarkBlockProvision-4 5000000 220 ns/op
@@ -15,6 +15,7 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyDestinations        = []byte("Destinations")
 )
 
 // mint parameters
@@ -25,6 +26,10 @@ type Params struct {
 	InflationMin        sdk.Dec `json:"inflation_min" yaml:"inflation_min"`                 // minimum inflation rate
 	GoalBonded          sdk.Dec `json:"goal_bonded" yaml:"goal_bonded"`                     // goal of percent bonded atoms
 	BlocksPerYear       uint64  `json:"blocks_per_year" yaml:"blocks_per_year"`             // expected blocks per year
+
+	// destinations of the minted provisions, all of which are sent to the fee
+	// collector when empty
+	Destinations []MintDestination `json:"destinations" yaml:"destinations"`
 }
 
 // ParamTable for minting module.
@@ -32,8 +37,21 @@ func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// RestrictedParamKeyTable returns the ParamTable for minting module in which
+// the destinations must be among the allowed modules and must not be
+// blacklisted addresses.
+func RestrictedParamKeyTable(allowedModules, blacklistedAddrs map[string]bool) params.KeyTable {
+	return ParamKeyTable().WithValidator(KeyDestinations, func(i interface{}) error {
+		v, ok := i.([]MintDestination)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		return ValidateMintDestinationRecipients(v, allowedModules, blacklistedAddrs)
+	})
+}
+
 func NewParams(mintDenom string, inflationRateChange, inflationMax,
-	inflationMin, goalBonded sdk.Dec, blocksPerYear uint64) Params {
+	inflationMin, goalBonded sdk.Dec, blocksPerYear uint64, destinations []MintDestination) Params {
 
 	return Params{
 		MintDenom:           mintDenom,
@@ -42,6 +60,7 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax,
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		Destinations:        destinations,
 	}
 }
 
@@ -54,6 +73,7 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		Destinations:        []MintDestination{},
 	}
 }
 
@@ -71,9 +91,18 @@ func ValidateParams(params Params) error {
 	if params.MintDenom == "" {
 		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
 	}
+	if err := ValidateMintDestinations(params.Destinations); err != nil {
+		return err
+	}
 	return nil
 }
 
+// Validate implements params.ParamSetValidator, so that a parameter change
+// cannot e.g. lower the maximum inflation below the minimum inflation.
+func (p Params) Validate() error {
+	return ValidateParams(p)
+}
+
 func (p Params) String() string {
 	return fmt.Sprintf(`Minting Params:
   Mint Denom:             %s
@@ -82,20 +111,79 @@ func (p Params) String() string {
   Inflation Min:          %s
   Goal Bonded:            %s
   Blocks Per Year:        %d
+  Destinations:           %s
 `,
 		p.MintDenom, p.InflationRateChange, p.InflationMax,
-		p.InflationMin, p.GoalBonded, p.BlocksPerYear,
+		p.InflationMin, p.GoalBonded, p.BlocksPerYear, p.Destinations,
 	)
 }
 
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{KeyMintDenom, &p.MintDenom},
-		{KeyInflationRateChange, &p.InflationRateChange},
-		{KeyInflationMax, &p.InflationMax},
-		{KeyInflationMin, &p.InflationMin},
-		{KeyGoalBonded, &p.GoalBonded},
-		{KeyBlocksPerYear, &p.BlocksPerYear},
+		params.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		params.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		params.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflationMax),
+		params.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
+		params.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		params.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		params.NewParamSetPair(KeyDestinations, &p.Destinations, validateDestinations),
+	}
+}
+
+func validateMintDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
+	}
+	return sdk.ValidateDenom(v)
+}
+
+func validateRate(name string, i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter %s must be between 0 and 1, is %s", name, v)
+	}
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	return validateRate("InflationRateChange", i)
+}
+
+func validateInflationMax(i interface{}) error {
+	return validateRate("InflationMax", i)
+}
+
+func validateInflationMin(i interface{}) error {
+	return validateRate("InflationMin", i)
+}
+
+func validateGoalBonded(i interface{}) error {
+	return validateRate("GoalBonded", i)
+}
+
+func validateBlocksPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("mint parameter BlocksPerYear must be positive")
+	}
+	return nil
+}
+
+func validateDestinations(i interface{}) error {
+	v, ok := i.([]MintDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	return ValidateMintDestinations(v)
 }
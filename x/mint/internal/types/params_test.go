@@ -0,0 +1,29 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	p := DefaultParams()
+	require.NoError(t, p.Validate())
+	for _, pair := range p.ParamSetPairs() {
+		require.NoError(t, pair.ValidatorFn(reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()), string(pair.Key))
+	}
+
+	// each value is valid on its own, but max inflation is below min inflation
+	p.InflationMax = sdk.NewDecWithPrec(5, 2)
+	require.NoError(t, validateInflationMax(p.InflationMax))
+	require.Error(t, p.Validate())
+
+	require.Error(t, validateInflationMax(sdk.NewDec(2)))
+	require.Error(t, validateGoalBonded(sdk.NewDec(-1)))
+	require.Error(t, validateBlocksPerYear(uint64(0)))
+	require.Error(t, validateMintDenom(""))
+	require.Error(t, validateDestinations([]MintDestination{NewModuleMintDestination("distribution", sdk.NewDecWithPrec(5, 1))}))
+}
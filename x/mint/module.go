@@ -82,15 +82,22 @@ type AppModule struct {
 	AppModuleBasic
 	AppModuleSimulation
 
-	keeper Keeper
+	keeper                 Keeper
+	inflationCalculationFn InflationCalculationFn
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper) AppModule {
+// NewAppModule creates a new AppModule object. If the given inflation
+// calculation function is nil, DefaultInflationCalculationFn is used.
+func NewAppModule(keeper Keeper, inflationCalculationFn InflationCalculationFn) AppModule {
+	if inflationCalculationFn == nil {
+		inflationCalculationFn = DefaultInflationCalculationFn
+	}
+
 	return AppModule{
-		AppModuleBasic:      AppModuleBasic{},
-		AppModuleSimulation: AppModuleSimulation{},
-		keeper:              keeper,
+		AppModuleBasic:         AppModuleBasic{},
+		AppModuleSimulation:    AppModuleSimulation{},
+		keeper:                 keeper,
+		inflationCalculationFn: inflationCalculationFn,
 	}
 }
 
@@ -136,7 +143,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 
 // BeginBlock returns the begin blocker for the mint module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	BeginBlocker(ctx, am.keeper)
+	BeginBlocker(ctx, am.keeper, am.inflationCalculationFn)
 }
 
 // EndBlock returns the end blocker for the mint module. It returns no validator
@@ -3,6 +3,7 @@ package simulation
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
 
@@ -18,6 +19,11 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &minterA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &minterB)
 		return fmt.Sprintf("%v\n%v", minterA, minterB)
+	case bytes.Equal(kvA.Key, types.LastBlockTimeKey):
+		var timeA, timeB time.Time
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &timeA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &timeB)
+		return fmt.Sprintf("%v\n%v", timeA, timeB)
 	default:
 		panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 	}
@@ -3,6 +3,7 @@ package simulation
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -22,9 +23,11 @@ func makeTestCodec() (cdc *codec.Codec) {
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
 	minter := types.NewMinter(sdk.OneDec(), sdk.NewDec(15))
+	blockTime := time.Now().UTC()
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: types.MinterKey, Value: cdc.MustMarshalBinaryLengthPrefixed(minter)},
+		cmn.KVPair{Key: types.LastBlockTimeKey, Value: cdc.MustMarshalBinaryLengthPrefixed(blockTime)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 	tests := []struct {
@@ -32,6 +35,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"LastBlockTime", fmt.Sprintf("%v\n%v", blockTime, blockTime)},
 		{"other", ""},
 	}
 
@@ -0,0 +1,35 @@
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// BeginBlocker applies the pending parameter changes whose activation height or
+// time is reached. The changes of a proposal are applied atomically: if one of
+// them is no longer valid, none is applied and the failure is reported in an
+// event.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	for _, pending := range k.GetDuePendingParamChanges(ctx) {
+		k.DeletePendingParamChanges(ctx, pending.ID)
+
+		result := types.AttributeValueApplied
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := applyParamChanges(cacheCtx, k, pending.Changes, pending.ProposalID); err != nil {
+			result = types.AttributeValueFailed
+			k.Logger(ctx).Error(fmt.Sprintf("failed to apply pending parameter changes %d: %s", pending.ID, err))
+		} else {
+			writeCache()
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeActivateParamChanges,
+				sdk.NewAttribute(types.AttributeKeyPendingChangesID, fmt.Sprintf("%d", pending.ID)),
+				sdk.NewAttribute(types.AttributeKeyResult, result),
+			),
+		)
+	}
+}
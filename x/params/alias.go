@@ -11,47 +11,74 @@ import (
 )
 
 const (
-	StoreKey             = subspace.StoreKey
-	TStoreKey            = subspace.TStoreKey
-	TestParamStore       = subspace.TestParamStore
-	DefaultCodespace     = types.DefaultCodespace
-	CodeUnknownSubspace  = types.CodeUnknownSubspace
-	CodeSettingParameter = types.CodeSettingParameter
-	CodeEmptyData        = types.CodeEmptyData
-	ModuleName           = types.ModuleName
-	RouterKey            = types.RouterKey
-	ProposalTypeChange   = types.ProposalTypeChange
+	StoreKey                      = subspace.StoreKey
+	TStoreKey                     = subspace.TStoreKey
+	TestParamStore                = subspace.TestParamStore
+	DefaultCodespace              = types.DefaultCodespace
+	CodeUnknownSubspace           = types.CodeUnknownSubspace
+	CodeSettingParameter          = types.CodeSettingParameter
+	CodeEmptyData                 = types.CodeEmptyData
+	ModuleName                    = types.ModuleName
+	RouterKey                     = types.RouterKey
+	ProposalTypeChange            = types.ProposalTypeChange
+	CodeInvalidActivation         = types.CodeInvalidActivation
+	QuerierRoute                  = types.QuerierRoute
+	QueryPendingChanges           = types.QueryPendingChanges
+	QueryParamHistory             = types.QueryParamHistory
+	EventTypeActivateParamChanges = types.EventTypeActivateParamChanges
+	AttributeKeyPendingChangesID  = types.AttributeKeyPendingChangesID
+	AttributeKeyResult            = types.AttributeKeyResult
+	AttributeValueApplied         = types.AttributeValueApplied
+	AttributeValueFailed          = types.AttributeValueFailed
 )
 
 var (
 	// functions aliases
-	NewParamSetPair            = subspace.NewParamSetPair
-	NewSubspace                = subspace.NewSubspace
-	NewKeyTable                = subspace.NewKeyTable
-	DefaultTestComponents      = subspace.DefaultTestComponents
-	RegisterCodec              = types.RegisterCodec
-	ErrUnknownSubspace         = types.ErrUnknownSubspace
-	ErrSettingParameter        = types.ErrSettingParameter
-	ErrEmptyChanges            = types.ErrEmptyChanges
-	ErrEmptySubspace           = types.ErrEmptySubspace
-	ErrEmptyKey                = types.ErrEmptyKey
-	ErrEmptyValue              = types.ErrEmptyValue
-	NewParameterChangeProposal = types.NewParameterChangeProposal
-	NewParamChange             = types.NewParamChange
-	NewParamChangeWithSubkey   = types.NewParamChangeWithSubkey
-	ValidateChanges            = types.ValidateChanges
+	NewParamSetPair                     = subspace.NewParamSetPair
+	NewSubspace                         = subspace.NewSubspace
+	NewKeyTable                         = subspace.NewKeyTable
+	DefaultTestComponents               = subspace.DefaultTestComponents
+	RegisterCodec                       = types.RegisterCodec
+	ErrUnknownSubspace                  = types.ErrUnknownSubspace
+	ErrSettingParameter                 = types.ErrSettingParameter
+	ErrEmptyChanges                     = types.ErrEmptyChanges
+	ErrEmptySubspace                    = types.ErrEmptySubspace
+	ErrEmptyKey                         = types.ErrEmptyKey
+	ErrEmptyValue                       = types.ErrEmptyValue
+	NewParameterChangeProposal          = types.NewParameterChangeProposal
+	NewParamChange                      = types.NewParamChange
+	NewParamChangeWithSubkey            = types.NewParamChangeWithSubkey
+	ValidateChanges                     = types.ValidateChanges
+	ErrInvalidActivation                = types.ErrInvalidActivation
+	NewScheduledParameterChangeProposal = types.NewScheduledParameterChangeProposal
+	ValidateActivation                  = types.ValidateActivation
+	NewPendingParamChanges              = types.NewPendingParamChanges
+	GetPendingChangesKey                = types.GetPendingChangesKey
+	NewParamChangeRecord                = types.NewParamChangeRecord
+	GetParamHistoryKey                  = types.GetParamHistoryKey
+	NewQueryParamHistoryParams          = types.NewQueryParamHistoryParams
 
 	// variable aliases
-	ModuleCdc = types.ModuleCdc
+	ModuleCdc               = types.ModuleCdc
+	PendingChangesKeyPrefix = types.PendingChangesKeyPrefix
+	PendingChangesIDKey     = types.PendingChangesIDKey
+	ParamHistoryKeyPrefix   = types.ParamHistoryKeyPrefix
 )
 
 type (
 	ParamSetPair            = subspace.ParamSetPair
 	ParamSetPairs           = subspace.ParamSetPairs
 	ParamSet                = subspace.ParamSet
+	ParamSetValidator       = subspace.ParamSetValidator
+	ValueValidatorFn        = subspace.ValueValidatorFn
 	Subspace                = subspace.Subspace
 	ReadOnlySubspace        = subspace.ReadOnlySubspace
 	KeyTable                = subspace.KeyTable
 	ParameterChangeProposal = types.ParameterChangeProposal
 	ParamChange             = types.ParamChange
+	PendingParamChanges     = types.PendingParamChanges
+	PendingParamChangesList = types.PendingParamChangesList
+	ParamChangeRecord       = types.ParamChangeRecord
+	ParamChangeRecords      = types.ParamChangeRecords
+	QueryParamHistoryParams = types.QueryParamHistoryParams
 )
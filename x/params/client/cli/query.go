@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// GetQueryCmd returns the cli query commands for the params module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	paramsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the params module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	paramsQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryPendingChanges(cdc),
+			GetCmdQueryParamHistory(cdc),
+		)...,
+	)
+
+	return paramsQueryCmd
+}
+
+// GetCmdQueryPendingChanges implements a command to return the parameter
+// changes scheduled by passed proposals and not yet applied.
+func GetCmdQueryPendingChanges(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending",
+		Short: "Query the scheduled parameter changes not yet applied",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingChanges)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var pendings types.PendingParamChangesList
+			if err := cdc.UnmarshalJSON(res, &pendings); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(pendings)
+		},
+	}
+}
+
+// GetCmdQueryParamHistory implements a command to return the changes applied to
+// a parameter after genesis.
+func GetCmdQueryParamHistory(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history [subspace] [key]",
+		Short: "Query the change history of a parameter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the changes applied to a parameter after genesis, oldest first. The
+history covers every change of the parameter, whether applied by a parameter
+change proposal or set directly by a module. Each change records the height
+and time it was applied at, the old and new values of the parameter and the
+ID of the proposal, which is 0 for changes not made by a proposal.
+
+Example:
+$ %s query params history staking MaxValidators
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryParamHistoryParams(args[0], args[1]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParamHistory)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var records types.ParamChangeRecords
+			if err := cdc.UnmarshalJSON(res, &records); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
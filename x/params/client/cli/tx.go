@@ -29,16 +29,17 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 The proposal details must be supplied via a JSON file. For values that contains
 objects, only non-empty fields will be updated.
 
-IMPORTANT: Currently parameter changes are evaluated but not validated, so it is
-very important that any "value" change is valid (ie. correct type and within bounds)
-for its respective parameter, eg. "MaxValidators" should be an integer and not a decimal.
+The new values are validated against the parameters of each module when the
+proposal is submitted and when it passes, so invalid values, eg. a "MaxValidators"
+of 0, cause the proposal to be rejected.
 
-Proper vetting of a parameter change proposal should prevent this from happening
-(no deposits should occur during the governance process), but it should be noted
-regardless.
+The changes are applied when the proposal passes, unless an "activation_height"
+or an "activation_time" is set, in which case they are scheduled and applied at
+the beginning of the first block at or after it. Scheduled changes can be queried
+with '%[1]s query params pending'.
 
 Example:
-$ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
+$ %[1]s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
 
 Where proposal.json contains:
 
@@ -52,6 +53,7 @@ Where proposal.json contains:
       "value": 105
     }
   ],
+  "activation_height": "1500000",
   "deposit": [
     {
       "denom": "stake",
@@ -73,7 +75,10 @@ Where proposal.json contains:
 			}
 
 			from := cliCtx.GetFromAddress()
-			content := types.NewParameterChangeProposal(proposal.Title, proposal.Description, proposal.Changes.ToParamChanges())
+			content := types.NewScheduledParameterChangeProposal(
+				proposal.Title, proposal.Description, proposal.Changes.ToParamChanges(),
+				proposal.ActivationHeight, proposal.ActivationTime,
+			)
 
 			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
 			if err := msg.ValidateBasic(); err != nil {
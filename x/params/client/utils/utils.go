@@ -3,11 +3,12 @@ package utils
 import (
 	"encoding/json"
 	"io/ioutil"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
-	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 type (
@@ -31,6 +32,9 @@ type (
 		Description string           `json:"description" yaml:"description"`
 		Changes     ParamChangesJSON `json:"changes" yaml:"changes"`
 		Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+
+		ActivationHeight int64     `json:"activation_height,omitempty" yaml:"activation_height,omitempty"`
+		ActivationTime   time.Time `json:"activation_time" yaml:"activation_time"`
 	}
 
 	// ParamChangeProposalReq defines a parameter change proposal request body.
@@ -42,6 +46,9 @@ type (
 		Changes     ParamChangesJSON `json:"changes" yaml:"changes"`
 		Proposer    sdk.AccAddress   `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+
+		ActivationHeight int64     `json:"activation_height,omitempty" yaml:"activation_height,omitempty"`
+		ActivationTime   time.Time `json:"activation_time" yaml:"activation_time"`
 	}
 )
 
@@ -50,14 +57,14 @@ func NewParamChangeJSON(subspace, key, subkey string, value json.RawMessage) Par
 }
 
 // ToParamChange converts a ParamChangeJSON object to ParamChange.
-func (pcj ParamChangeJSON) ToParamChange() params.ParamChange {
-	return params.NewParamChangeWithSubkey(pcj.Subspace, pcj.Key, pcj.Subkey, string(pcj.Value))
+func (pcj ParamChangeJSON) ToParamChange() types.ParamChange {
+	return types.NewParamChangeWithSubkey(pcj.Subspace, pcj.Key, pcj.Subkey, string(pcj.Value))
 }
 
 // ToParamChanges converts a slice of ParamChangeJSON objects to a slice of
 // ParamChange.
-func (pcj ParamChangesJSON) ToParamChanges() []params.ParamChange {
-	res := make([]params.ParamChange, len(pcj))
+func (pcj ParamChangesJSON) ToParamChanges() []types.ParamChange {
+	res := make([]types.ParamChange, len(pcj))
 	for i, pc := range pcj {
 		res[i] = pc.ToParamChange()
 	}
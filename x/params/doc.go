@@ -35,11 +35,23 @@ recommended to use the same name with the module's.
 
 	func ParamKeyTable() params.KeyTable {
 		return params.NewKeyTable(
-			KeyParameter1, MyStruct{},
-			KeyParameter2, MyStruct{},
+			params.NewParamSetPair(KeyParameter1, MyStruct{}, validateMyStruct),
+			params.NewParamSetPair(KeyParameter2, MyStruct{}, validateMyStruct),
 		)
 	}
 
+Every parameter is registered with a validator function, which receives the
+parameter value and returns an error if it is invalid. Set panics and Update,
+used by ParameterChangeProposals, returns an error on invalid values.
+
+	func validateMyStruct(i interface{}) error {
+		v, ok := i.(MyStruct)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		...
+	}
+
 	func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ps params.Subspace) Keeper {
 		return Keeper {
 			cdc: cdc,
@@ -64,7 +76,7 @@ inheriting the key properties.
 
 	func ParamKeyTable() params.KeyTable {
 		return params.NewKeyTable(
-			KeyParamMain, MyStruct{},
+			params.NewParamSetPair(KeyParamMain, MyStruct{}, validateMyStruct),
 		)
 	}
 
@@ -87,11 +99,20 @@ be able to be passed to SetParamSet.
 	// KeyValuePairs must return the list of (ParamKey, PointerToTheField)
 	func (p *MyParams) KeyValuePairs() params.KeyValuePairs {
 		return params.KeyFieldPairs {
-			{KeyParameter1, &p.Parameter1},
-			{KeyParameter2, &p.Parameter2},
+			params.NewParamSetPair(KeyParameter1, &p.Parameter1, validateParameter1),
+			params.NewParamSetPair(KeyParameter2, &p.Parameter2, validateParameter2),
 		}
 	}
 
+If the parameters of a set must also be validated together, e.g. because one
+bounds another, the set can implement params.ParamSetValidator. Its Validate
+method is then checked against the resulting set whenever a single parameter of
+the set is updated.
+
+	func (p MyParams) Validate() error {
+		...
+	}
+
 	func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		k.ps.SetParamSet(ctx, &data.params)
 	}
@@ -0,0 +1,74 @@
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState defines the params module genesis state
+type GenesisState struct {
+	StartingPendingChangesID uint64                `json:"starting_pending_changes_id" yaml:"starting_pending_changes_id"`
+	PendingChanges           []PendingParamChanges `json:"pending_changes" yaml:"pending_changes"`
+	ParamHistory             []ParamChangeRecord   `json:"param_history" yaml:"param_history"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(startingPendingChangesID uint64, pendingChanges []PendingParamChanges,
+	paramHistory []ParamChangeRecord) GenesisState {
+
+	return GenesisState{
+		StartingPendingChangesID: startingPendingChangesID,
+		PendingChanges:           pendingChanges,
+		ParamHistory:             paramHistory,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(1, []PendingParamChanges{}, []ParamChangeRecord{})
+}
+
+// ValidateGenesis performs basic validation of params genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[uint64]bool)
+	for _, pending := range data.PendingChanges {
+		if err := pending.Validate(); err != nil {
+			return err
+		}
+		if pending.ID >= data.StartingPendingChangesID {
+			return fmt.Errorf("pending parameter changes ID %d must be lower than the starting ID %d",
+				pending.ID, data.StartingPendingChangesID)
+		}
+		if seen[pending.ID] {
+			return fmt.Errorf("duplicate pending parameter changes ID %d", pending.ID)
+		}
+		seen[pending.ID] = true
+	}
+	for _, record := range data.ParamHistory {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InitGenesis sets the pending parameter changes and the parameter change
+// history from the genesis state. The parameters themselves are initialized by
+// the genesis of the modules owning them.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetPendingChangesID(ctx, data.StartingPendingChangesID)
+	for _, pending := range data.PendingChanges {
+		k.SetPendingParamChanges(ctx, pending)
+	}
+	for _, record := range data.ParamHistory {
+		k.AppendParamChangeRecord(ctx, record)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetPendingChangesID(ctx), k.GetAllPendingParamChanges(ctx),
+		k.GetAllParamChangeRecords(ctx))
+}
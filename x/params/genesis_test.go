@@ -0,0 +1,51 @@
+package params
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	changes := []ParamChange{NewParamChange("test", "key", "1")}
+	pendings := []PendingParamChanges{
+		NewPendingParamChanges(2, 1, 100, time.Time{}, changes),
+		NewPendingParamChanges(4, 1, 0, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), changes),
+	}
+	records := []ParamChangeRecord{
+		NewParamChangeRecord("test", "key", "", 10, time.Time{}, "", `"1"`, 1),
+		NewParamChangeRecord("test", "key", "", 20, time.Time{}, `"1"`, `"2"`, 3),
+		NewParamChangeRecord("test", "other", "", 20, time.Time{}, "", `"1"`, 3),
+	}
+	genesis := NewGenesisState(5, pendings, records)
+	require.NoError(t, ValidateGenesis(genesis))
+
+	InitGenesis(ctx, keeper, genesis)
+	require.Equal(t, genesis, ExportGenesis(ctx, keeper))
+
+	require.Equal(t, records[:2], []ParamChangeRecord(keeper.GetParamHistory(ctx, "test", "key")))
+
+	id := keeper.SchedulePendingParamChanges(ctx, 6, 200, time.Time{}, changes)
+	require.Equal(t, uint64(5), id)
+	require.Equal(t, uint64(6), keeper.GetPendingChangesID(ctx))
+
+	// pending changes IDs must be unique and lower than the starting ID
+	require.Error(t, ValidateGenesis(NewGenesisState(4, pendings, records)))
+	require.Error(t, ValidateGenesis(NewGenesisState(5, append(pendings, pendings[0]), records)))
+	require.Error(t, ValidateGenesis(NewGenesisState(5, []PendingParamChanges{
+		NewPendingParamChanges(1, 1, 0, time.Time{}, changes),
+	}, records)))
+
+	// records must refer to a parameter and hold its new value
+	require.Error(t, ValidateGenesis(NewGenesisState(5, pendings, []ParamChangeRecord{
+		NewParamChangeRecord("test", "", "", 10, time.Time{}, "", `"1"`, 1),
+	})))
+	require.Error(t, ValidateGenesis(NewGenesisState(5, pendings, []ParamChangeRecord{
+		NewParamChangeRecord("test", "key", "", 10, time.Time{}, `"1"`, "", 1),
+	})))
+}
@@ -0,0 +1,80 @@
+package params
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// GetParamHistory returns the change history of a parameter, oldest first
+func (k Keeper) GetParamHistory(ctx sdk.Context, subspace, key string) types.ParamChangeRecords {
+	records := types.ParamChangeRecords{}
+	k.iterateParamHistory(ctx, types.GetParamHistoryKey(subspace, key), func(record types.ParamChangeRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// AppendParamChangeRecord appends a record to the change history of its
+// parameter
+func (k Keeper) AppendParamChangeRecord(ctx sdk.Context, record types.ParamChangeRecord) {
+	store := ctx.KVStore(k.key)
+
+	// number the records of the parameter applied at the same height
+	var seq uint64
+	iterator := sdk.KVStorePrefixIterator(store,
+		types.GetParamHistoryByHeightKey(record.Subspace, record.Key, record.Height))
+	for ; iterator.Valid(); iterator.Next() {
+		seq++
+	}
+	iterator.Close()
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(types.GetParamChangeRecordKey(record.Subspace, record.Key, record.Height, seq), bz)
+}
+
+// IterateParamHistory iterates over the change history of all the parameters
+// and performs a callback function on each record
+func (k Keeper) IterateParamHistory(ctx sdk.Context, cb func(record types.ParamChangeRecord) (stop bool)) {
+	k.iterateParamHistory(ctx, types.ParamHistoryKeyPrefix, cb)
+}
+
+func (k Keeper) iterateParamHistory(ctx sdk.Context, prefix []byte, cb func(record types.ParamChangeRecord) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ParamChangeRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
+
+// GetAllParamChangeRecords returns the change history of all the parameters
+func (k Keeper) GetAllParamChangeRecords(ctx sdk.Context) []types.ParamChangeRecord {
+	records := []types.ParamChangeRecord{}
+	k.IterateParamHistory(ctx, func(record types.ParamChangeRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// recordParamChange records a change of a parameter value set through a
+// subspace, with the ID of the proposal applying it, if any. The
+// initialization of a parameter, e.g. from the genesis state, is not recorded.
+func (k Keeper) recordParamChange(ctx sdk.Context, subspace string, key, subkey, oldValue, newValue []byte) {
+	if oldValue == nil {
+		return
+	}
+
+	proposalID, _ := govtypes.ProposalIDFromContext(ctx)
+	k.AppendParamChangeRecord(ctx, types.NewParamChangeRecord(
+		subspace, string(key), string(subkey), ctx.BlockHeight(), ctx.BlockHeader().Time,
+		string(oldValue), string(newValue), proposalID,
+	))
+}
@@ -49,7 +49,7 @@ func (k Keeper) Subspace(s string) Subspace {
 		panic("cannot use empty string for subspace")
 	}
 
-	space := subspace.NewSubspace(k.cdc, k.key, k.tkey, s)
+	space := subspace.NewSubspace(k.cdc, k.key, k.tkey, s).WithChangeListener(k.recordParamChange)
 	k.spaces[s] = &space
 
 	return space
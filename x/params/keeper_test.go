@@ -3,6 +3,7 @@ package params
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -25,15 +26,15 @@ func TestKeeper(t *testing.T) {
 	}
 
 	table := NewKeyTable(
-		[]byte("key1"), int64(0),
-		[]byte("key2"), int64(0),
-		[]byte("key3"), int64(0),
-		[]byte("key4"), int64(0),
-		[]byte("key5"), int64(0),
-		[]byte("key6"), int64(0),
-		[]byte("key7"), int64(0),
-		[]byte("extra1"), bool(false),
-		[]byte("extra2"), string(""),
+		NewParamSetPair([]byte("key1"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key2"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key3"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key4"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key5"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key6"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key7"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("extra1"), bool(false), validateNoOp),
+		NewParamSetPair([]byte("extra2"), string(""), validateNoOp),
 	)
 
 	cdc, ctx, skey, _, keeper := testComponents()
@@ -127,18 +128,18 @@ func TestSubspace(t *testing.T) {
 	}
 
 	table := NewKeyTable(
-		[]byte("string"), string(""),
-		[]byte("bool"), bool(false),
-		[]byte("int16"), int16(0),
-		[]byte("int32"), int32(0),
-		[]byte("int64"), int64(0),
-		[]byte("uint16"), uint16(0),
-		[]byte("uint32"), uint32(0),
-		[]byte("uint64"), uint64(0),
-		[]byte("int"), sdk.Int{},
-		[]byte("uint"), sdk.Uint{},
-		[]byte("dec"), sdk.Dec{},
-		[]byte("struct"), s{},
+		NewParamSetPair([]byte("string"), string(""), validateNoOp),
+		NewParamSetPair([]byte("bool"), bool(false), validateNoOp),
+		NewParamSetPair([]byte("int16"), int16(0), validateNoOp),
+		NewParamSetPair([]byte("int32"), int32(0), validateNoOp),
+		NewParamSetPair([]byte("int64"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("uint16"), uint16(0), validateNoOp),
+		NewParamSetPair([]byte("uint32"), uint32(0), validateNoOp),
+		NewParamSetPair([]byte("uint64"), uint64(0), validateNoOp),
+		NewParamSetPair([]byte("int"), sdk.Int{}, validateNoOp),
+		NewParamSetPair([]byte("uint"), sdk.Uint{}, validateNoOp),
+		NewParamSetPair([]byte("dec"), sdk.Dec{}, validateNoOp),
+		NewParamSetPair([]byte("struct"), s{}, validateNoOp),
 	)
 
 	store := prefix.NewStore(ctx.KVStore(key), []byte("test/"))
@@ -190,7 +191,7 @@ func TestJSONUpdate(t *testing.T) {
 
 	key := []byte("key")
 
-	space := keeper.Subspace("test").WithKeyTable(NewKeyTable(key, paramJSON{}))
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable(NewParamSetPair(key, paramJSON{}, validateNoOp)))
 
 	var param paramJSON
 
@@ -210,3 +211,32 @@ func TestJSONUpdate(t *testing.T) {
 	space.Get(ctx, key, &param)
 	require.Equal(t, paramJSON{40964096, "goodbyeworld"}, param)
 }
+
+func TestGetDuePendingParamChanges(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+
+	changes := []ParamChange{NewParamChange("test", "key", "1")}
+	byHeight := keeper.SchedulePendingParamChanges(ctx, 1, 20, time.Time{}, changes)
+	byTime := keeper.SchedulePendingParamChanges(ctx, 2, 0, now.Add(time.Hour), changes)
+	later := keeper.SchedulePendingParamChanges(ctx, 3, 30, time.Time{}, changes)
+	require.Empty(t, keeper.GetDuePendingParamChanges(ctx))
+
+	due := keeper.GetDuePendingParamChanges(ctx.WithBlockHeight(20))
+	require.Len(t, due, 1)
+	require.Equal(t, byHeight, due[0].ID)
+
+	// changes are returned in scheduling order
+	due = keeper.GetDuePendingParamChanges(ctx.WithBlockHeight(25).WithBlockTime(now.Add(time.Hour)))
+	require.Len(t, due, 2)
+	require.Equal(t, byHeight, due[0].ID)
+	require.Equal(t, byTime, due[1].ID)
+
+	// deleted changes are removed from the activation queues
+	keeper.DeletePendingParamChanges(ctx, byHeight)
+	keeper.DeletePendingParamChanges(ctx, byTime)
+	due = keeper.GetDuePendingParamChanges(ctx.WithBlockHeight(30).WithBlockTime(now.Add(time.Hour)))
+	require.Len(t, due, 1)
+	require.Equal(t, later, due[0].ID)
+}
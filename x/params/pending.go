@@ -0,0 +1,141 @@
+package params
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// GetPendingChangesID returns the ID of the next scheduled parameter changes
+func (k Keeper) GetPendingChangesID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PendingChangesIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPendingChangesID sets the ID of the next scheduled parameter changes
+func (k Keeper) SetPendingChangesID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.PendingChangesIDKey, bz)
+}
+
+// GetPendingParamChanges returns the pending parameter changes with the given ID
+func (k Keeper) GetPendingParamChanges(ctx sdk.Context, id uint64) (pending types.PendingParamChanges, found bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.GetPendingChangesKey(id))
+	if bz == nil {
+		return pending, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pending)
+	return pending, true
+}
+
+// SetPendingParamChanges stores pending parameter changes and inserts them in
+// the queue of their activation height or time
+func (k Keeper) SetPendingParamChanges(ctx sdk.Context, pending types.PendingParamChanges) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(pending)
+	store.Set(types.GetPendingChangesKey(pending.ID), bz)
+	store.Set(pendingChangesQueueKey(pending), []byte{})
+}
+
+// DeletePendingParamChanges removes the pending parameter changes with the given
+// ID and their activation queue entry
+func (k Keeper) DeletePendingParamChanges(ctx sdk.Context, id uint64) {
+	pending, found := k.GetPendingParamChanges(ctx, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Delete(types.GetPendingChangesKey(id))
+	store.Delete(pendingChangesQueueKey(pending))
+}
+
+// pendingChangesQueueKey returns the key of the pending parameter changes in
+// the queue they are activated from: the time queue if they have an activation
+// time, the height queue otherwise
+func pendingChangesQueueKey(pending types.PendingParamChanges) []byte {
+	if !pending.ActivationTime.IsZero() {
+		return types.GetPendingChangesTimeQueueKey(pending.ID, pending.ActivationTime)
+	}
+	return types.GetPendingChangesHeightQueueKey(pending.ID, pending.ActivationHeight)
+}
+
+// SchedulePendingParamChanges stores the parameter changes of a proposal to be
+// applied at the given activation height or time, and returns their ID
+func (k Keeper) SchedulePendingParamChanges(ctx sdk.Context, proposalID uint64, activationHeight int64,
+	activationTime time.Time, changes []ParamChange) uint64 {
+
+	id := k.GetPendingChangesID(ctx)
+	k.SetPendingParamChanges(ctx, types.NewPendingParamChanges(id, proposalID, activationHeight, activationTime, changes))
+	k.SetPendingChangesID(ctx, id+1)
+	return id
+}
+
+// IteratePendingParamChanges iterates over the pending parameter changes in
+// scheduling order and performs a callback function
+func (k Keeper) IteratePendingParamChanges(ctx sdk.Context, cb func(pending types.PendingParamChanges) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingChangesKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingParamChanges
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pending)
+		if cb(pending) {
+			break
+		}
+	}
+}
+
+// GetDuePendingParamChanges returns the pending parameter changes whose
+// activation height or time is reached at the current block, in scheduling
+// order. Only the activation queue entries which are due are iterated.
+func (k Keeper) GetDuePendingParamChanges(ctx sdk.Context) []types.PendingParamChanges {
+	store := ctx.KVStore(k.key)
+
+	var ids []uint64
+	for _, iterator := range []sdk.Iterator{
+		store.Iterator(types.PendingChangesHeightQueuePrefix,
+			sdk.PrefixEndBytes(types.GetPendingChangesByHeightKey(ctx.BlockHeight()))),
+		store.Iterator(types.PendingChangesTimeQueuePrefix,
+			sdk.PrefixEndBytes(types.GetPendingChangesByTimeKey(ctx.BlockHeader().Time))),
+	} {
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()
+			ids = append(ids, binary.BigEndian.Uint64(key[len(key)-8:]))
+		}
+		iterator.Close()
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	due := make([]types.PendingParamChanges, len(ids))
+	for i, id := range ids {
+		pending, found := k.GetPendingParamChanges(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("pending parameter changes %d in the activation queue not found", id))
+		}
+		due[i] = pending
+	}
+	return due
+}
+
+// GetAllPendingParamChanges returns all the pending parameter changes
+func (k Keeper) GetAllPendingParamChanges(ctx sdk.Context) []types.PendingParamChanges {
+	pendings := []types.PendingParamChanges{}
+	k.IteratePendingParamChanges(ctx, func(pending types.PendingParamChanges) bool {
+		pendings = append(pendings, pending)
+		return false
+	})
+	return pendings
+}
@@ -22,7 +22,37 @@ func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p ParameterChangeProposal) sdk.Error {
-	for _, c := range p.Changes {
+	// the proposal ID is only set when executing a passed proposal
+	proposalID, _ := govtypes.ProposalIDFromContext(ctx)
+
+	pending := NewPendingParamChanges(0, proposalID, p.ActivationHeight, p.ActivationTime, p.Changes)
+	if !p.IsScheduled() || pending.IsActive(ctx.BlockHeight(), ctx.BlockHeader().Time) {
+		return applyParamChanges(ctx, k, p.Changes, proposalID)
+	}
+
+	// validate the changes against the current parameters, so that invalid
+	// changes are rejected now rather than at activation
+	cacheCtx, _ := ctx.CacheContext()
+	if err := applyParamChanges(cacheCtx, k, p.Changes, proposalID); err != nil {
+		return err
+	}
+
+	id := k.SchedulePendingParamChanges(ctx, proposalID, p.ActivationHeight, p.ActivationTime, p.Changes)
+	k.Logger(ctx).Info(
+		fmt.Sprintf("scheduled parameter changes %d; activation height: %d, activation time: %s",
+			id, p.ActivationHeight, p.ActivationTime),
+	)
+
+	return nil
+}
+
+// applyParamChanges applies the parameter changes of the given proposal in
+// order, stopping at the first invalid change. The subspaces record each
+// applied change with the proposal ID in the history of its parameter.
+func applyParamChanges(ctx sdk.Context, k Keeper, changes []ParamChange, proposalID uint64) sdk.Error {
+	ctx = govtypes.ContextWithProposalID(ctx, proposalID)
+
+	for _, c := range changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
 			return ErrUnknownSubspace(k.codespace, c.Subspace)
@@ -39,6 +69,7 @@ func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p ParameterChangeP
 			k.Logger(ctx).Info(
 				fmt.Sprintf("setting new parameter; key: %s, subkey: %s, value: %s", c.Key, c.Subspace, c.Value),
 			)
+
 			err = ss.UpdateWithSubkey(ctx, []byte(c.Key), []byte(c.Subkey), []byte(c.Value))
 		}
 
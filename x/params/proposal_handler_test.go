@@ -1,7 +1,9 @@
 package params_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -12,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
@@ -43,11 +46,41 @@ type testParams struct {
 
 func (tp *testParams) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
-		{[]byte(keyMaxValidators), &tp.MaxValidators},
-		{[]byte(keySlashingRate), &tp.SlashingRate},
+		params.NewParamSetPair([]byte(keyMaxValidators), &tp.MaxValidators, validateMaxValidators),
+		params.NewParamSetPair([]byte(keySlashingRate), &tp.SlashingRate, validateSlashingRate),
 	}
 }
 
+// Validate implements subspace.ParamSetValidator
+func (tp testParams) Validate() error {
+	if tp.SlashingRate.DoubleSign != 0 && tp.SlashingRate.DoubleSign < tp.SlashingRate.Downtime {
+		return fmt.Errorf("double sign slashing rate must be greater than or equal to downtime rate")
+	}
+	return nil
+}
+
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max validators must be positive")
+	}
+	return nil
+}
+
+func validateSlashingRate(i interface{}) error {
+	v, ok := i.(testParamsSlashingRate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.DoubleSign > 100 || v.Downtime > 100 {
+		return fmt.Errorf("slashing rates must be at most 100")
+	}
+	return nil
+}
+
 func testProposal(changes ...params.ParamChange) params.ParameterChangeProposal {
 	return params.NewParameterChangeProposal(
 		"Test",
@@ -127,3 +160,211 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalHandlerInvalidValue(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	tp := testProposal(params.NewParamChange(testSubspace, keyMaxValidators, `0`))
+	require.Error(t, hdlr(input.ctx, tp))
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+
+	tp = testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 101}`))
+	require.Error(t, hdlr(input.ctx, tp))
+	require.False(t, ss.Has(input.ctx, []byte(keySlashingRate)))
+
+	require.Panics(t, func() { ss.Set(input.ctx, []byte(keyMaxValidators), uint16(0)) })
+}
+
+func TestProposalHandlerInvalidParamSet(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	var param testParamsSlashingRate
+
+	tp := testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"double_sign": 10, "downtime": 5}`))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	// the double sign rate cannot drop below the downtime rate
+	tp = testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"double_sign": 4}`))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	ss.Get(input.ctx, []byte(keySlashingRate), &param)
+	require.Equal(t, testParamsSlashingRate{10, 5}, param)
+}
+
+func TestProposalHandlerScheduled(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	ctx := input.ctx.WithBlockHeight(10)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// invalid values are rejected when scheduling
+	tp := params.NewScheduledParameterChangeProposal("Test", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, keyMaxValidators, "0")}, 20, time.Time{})
+	require.Error(t, hdlr(ctx, tp))
+	require.Empty(t, input.keeper.GetAllPendingParamChanges(ctx))
+
+	tp = params.NewScheduledParameterChangeProposal("Test", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, keyMaxValidators, "5")}, 20, time.Time{})
+	require.NoError(t, hdlr(ctx, tp))
+	require.False(t, ss.Has(ctx, []byte(keyMaxValidators)))
+
+	pendings := input.keeper.GetAllPendingParamChanges(ctx)
+	require.Len(t, pendings, 1)
+	require.Equal(t, int64(20), pendings[0].ActivationHeight)
+
+	// the changes are not applied before the activation height
+	params.BeginBlocker(ctx.WithBlockHeight(19), input.keeper)
+	require.False(t, ss.Has(ctx, []byte(keyMaxValidators)))
+
+	params.BeginBlocker(ctx.WithBlockHeight(20), input.keeper)
+	var param uint16
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(5), param)
+	require.Empty(t, input.keeper.GetAllPendingParamChanges(ctx))
+
+	// changes whose activation is already reached are applied immediately
+	tp = params.NewScheduledParameterChangeProposal("Test", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, keyMaxValidators, "7")}, 5, time.Time{})
+	require.NoError(t, hdlr(ctx, tp))
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(7), param)
+}
+
+func TestBeginBlockerScheduledByTime(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := input.ctx.WithBlockTime(now)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	tp := params.NewScheduledParameterChangeProposal("Test", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, keySlashingRate, `{"double_sign": 10, "downtime": 5}`)},
+		0, now.Add(time.Hour))
+	require.NoError(t, hdlr(ctx, tp))
+
+	params.BeginBlocker(ctx.WithBlockTime(now.Add(time.Minute)), input.keeper)
+	require.False(t, ss.Has(ctx, []byte(keySlashingRate)))
+
+	params.BeginBlocker(ctx.WithBlockTime(now.Add(time.Hour)), input.keeper)
+	var param testParamsSlashingRate
+	ss.Get(ctx, []byte(keySlashingRate), &param)
+	require.Equal(t, testParamsSlashingRate{10, 5}, param)
+}
+
+func TestBeginBlockerInvalidAtActivation(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	ctx := input.ctx.WithBlockHeight(10)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// both changes are valid when scheduled
+	tp := params.NewScheduledParameterChangeProposal("Test", "description",
+		[]params.ParamChange{
+			params.NewParamChange(testSubspace, keyMaxValidators, "5"),
+			params.NewParamChange(testSubspace, keySlashingRate, `{"double_sign": 4}`),
+		}, 20, time.Time{})
+	require.NoError(t, hdlr(ctx, tp))
+
+	// but the downtime rate is raised above the double sign rate before activation
+	tp = testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 8}`))
+	require.NoError(t, hdlr(ctx, tp))
+
+	// none of the pending changes is applied
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	params.BeginBlocker(ctx, input.keeper)
+	require.False(t, ss.Has(ctx, []byte(keyMaxValidators)))
+	require.Empty(t, input.keeper.GetAllPendingParamChanges(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, params.EventTypeActivateParamChanges, events[0].Type)
+	require.Equal(t, params.AttributeValueFailed, string(events[0].Attributes[1].Value))
+}
+
+func TestProposalHandlerHistory(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	ss.Set(input.ctx, []byte(keyMaxValidators), uint16(2))
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := input.ctx.WithBlockHeight(10).WithBlockTime(now)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	tp := testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "1"))
+	require.NoError(t, hdlr(govtypes.ContextWithProposalID(ctx, 3), tp))
+
+	// failed changes are not recorded
+	tp = testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "0"))
+	require.Error(t, hdlr(govtypes.ContextWithProposalID(ctx, 4), tp))
+
+	// scheduled changes are recorded at activation with their proposal ID
+	tp = params.NewScheduledParameterChangeProposal("Test", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, keyMaxValidators, "5")}, 20, time.Time{})
+	require.NoError(t, hdlr(govtypes.ContextWithProposalID(ctx, 5), tp))
+	params.BeginBlocker(ctx.WithBlockHeight(20).WithBlockTime(now.Add(time.Hour)), input.keeper)
+
+	expected := params.ParamChangeRecords{
+		params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", 10, now, "2", "1", 3),
+		params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", 20, now.Add(time.Hour), "1", "5", 5),
+	}
+	require.Equal(t, expected, input.keeper.GetParamHistory(ctx, testSubspace, keyMaxValidators))
+	require.Empty(t, input.keeper.GetParamHistory(ctx, testSubspace, keySlashingRate))
+
+	querier := params.NewQuerier(input.keeper)
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", params.QuerierRoute, params.QueryParamHistory),
+		Data: input.cdc.MustMarshalJSON(params.NewQueryParamHistoryParams(testSubspace, keyMaxValidators)),
+	}
+	bz, err := querier(ctx, []string{params.QueryParamHistory}, req)
+	require.NoError(t, err)
+
+	var records params.ParamChangeRecords
+	require.NoError(t, input.cdc.UnmarshalJSON(bz, &records))
+	require.Equal(t, expected, records)
+}
+
+func TestParamHistorySet(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	// the initialization of a parameter is not recorded
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := input.ctx.WithBlockHeight(10).WithBlockTime(now)
+	ss.Set(ctx, []byte(keyMaxValidators), uint16(1))
+	require.Empty(t, input.keeper.GetParamHistory(ctx, testSubspace, keyMaxValidators))
+
+	// changes set directly by a keeper are recorded without proposal ID, and
+	// unchanged values are not recorded
+	ss.Set(ctx, []byte(keyMaxValidators), uint16(2))
+	ss.Set(ctx, []byte(keyMaxValidators), uint16(2))
+	ss.Set(ctx, []byte(keyMaxValidators), uint16(3))
+
+	expected := params.ParamChangeRecords{
+		params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", 10, now, "1", "2", 0),
+		params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", 10, now, "2", "3", 0),
+	}
+	require.Equal(t, expected, input.keeper.GetParamHistory(ctx, testSubspace, keyMaxValidators))
+	require.Equal(t, []params.ParamChangeRecord(expected), input.keeper.GetAllParamChangeRecords(ctx))
+}
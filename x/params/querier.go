@@ -0,0 +1,56 @@
+package params
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// NewQuerier returns a params Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryPendingChanges:
+			return queryPendingChanges(ctx, k)
+
+		case types.QueryParamHistory:
+			return queryParamHistory(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown params query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryPendingChanges(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	pendings := k.GetAllPendingParamChanges(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, pendings)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryParamHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamHistoryParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	records := k.GetParamHistory(ctx, params.Subspace, params.Key)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
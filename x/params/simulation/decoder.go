@@ -0,0 +1,38 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding params type.
+// The values of the parameters themselves are JSON encoded.
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.PendingChangesKeyPrefix):
+		var pendingA, pendingB types.PendingParamChanges
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &pendingA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &pendingB)
+		return fmt.Sprintf("%v\n%v", pendingA, pendingB)
+
+	case bytes.Equal(kvA.Key, types.PendingChangesIDKey):
+		idA := binary.BigEndian.Uint64(kvA.Value)
+		idB := binary.BigEndian.Uint64(kvB.Value)
+		return fmt.Sprintf("%d\n%d", idA, idB)
+
+	case bytes.Equal(kvA.Key[:1], types.ParamHistoryKeyPrefix):
+		var recordA, recordB types.ParamChangeRecord
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+	default:
+		return fmt.Sprintf("%s: %s\n%s: %s", kvA.Key, kvA.Value, kvB.Key, kvB.Value)
+	}
+}
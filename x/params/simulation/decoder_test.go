@@ -0,0 +1,49 @@
+package simulation
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.New()
+	changes := []types.ParamChange{types.NewParamChange("staking", "MaxValidators", "105")}
+	pending := types.NewPendingParamChanges(1, 1, 100, time.Time{}, changes)
+
+	record := types.NewParamChangeRecord("staking", "MaxValidators", "", 10, time.Time{}, "100", "105", 1)
+
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, 2)
+
+	kvPairs := cmn.KVPairs{
+		cmn.KVPair{Key: types.GetPendingChangesKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(pending)},
+		cmn.KVPair{Key: types.PendingChangesIDKey, Value: idBz},
+		cmn.KVPair{Key: types.GetParamChangeRecordKey("staking", "MaxValidators", 10, 0), Value: cdc.MustMarshalBinaryLengthPrefixed(record)},
+		cmn.KVPair{Key: []byte("staking/MaxValidators"), Value: []byte("105")},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"PendingParamChanges", fmt.Sprintf("%v\n%v", pending, pending)},
+		{"PendingChangesID", "2\n2"},
+		{"ParamChangeRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"Parameter", "staking/MaxValidators: 105\nstaking/MaxValidators: 105"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name)
+		})
+	}
+}
@@ -157,8 +157,9 @@ var paramChangePool = []simParamChange{
 
 // SimulateParamChangeProposalContent returns random parameter change content.
 // It will generate a ParameterChangeProposal object with anywhere between 1 and
-// 3 parameter changes all of which have random, but valid values.
-func SimulateParamChangeProposalContent(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, _ []simulation.Account) govtypes.Content {
+// 3 parameter changes all of which have random, but valid values. One in four
+// proposals schedules its changes at a later activation height.
+func SimulateParamChangeProposalContent(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simulation.Account) govtypes.Content {
 	numChanges := simulation.RandIntBetween(r, 1, len(paramChangePool)/2)
 	paramChanges := make([]params.ParamChange, numChanges, numChanges)
 	paramChangesKeys := make(map[string]struct{})
@@ -177,9 +178,16 @@ func SimulateParamChangeProposalContent(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.
 		paramChanges[i] = params.NewParamChangeWithSubkey(spc.subspace, spc.key, spc.subkey, spc.simValue(r))
 	}
 
-	return params.NewParameterChangeProposal(
+	var activationHeight int64
+	if r.Intn(4) == 0 {
+		activationHeight = ctx.BlockHeight() + int64(simulation.RandIntBetween(r, 1, 100))
+	}
+
+	return params.NewScheduledParameterChangeProposal(
 		simulation.RandStringOfLength(r, 140),
 		simulation.RandStringOfLength(r, 5000),
 		paramChanges,
+		activationHeight,
+		time.Time{},
 	)
 }
@@ -1,14 +1,19 @@
 package subspace
 
+// ValueValidatorFn validates a parameter value. It receives the value itself,
+// never a pointer to it, and returns an error if the value is invalid.
+type ValueValidatorFn func(value interface{}) error
+
 // ParamSetPair is used for associating paramsubspace key and field of param structs
 type ParamSetPair struct {
-	Key   []byte
-	Value interface{}
+	Key         []byte
+	Value       interface{}
+	ValidatorFn ValueValidatorFn
 }
 
 // NewParamSetPair creates a new ParamSetPair instance
-func NewParamSetPair(key []byte, value interface{}) ParamSetPair {
-	return ParamSetPair{key, value}
+func NewParamSetPair(key []byte, value interface{}, vfn ValueValidatorFn) ParamSetPair {
+	return ParamSetPair{key, value, vfn}
 }
 
 // ParamSetPairs Slice of KeyFieldPair
@@ -18,3 +23,11 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// ParamSetValidator is implemented by ParamSets whose parameters must also be
+// validated together, e.g. when one parameter bounds another. It is checked
+// against the resulting set whenever a single parameter of the set is updated.
+type ParamSetValidator interface {
+	ParamSet
+	Validate() error
+}
@@ -1,7 +1,9 @@
 package subspace
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"reflect"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,6 +20,11 @@ const (
 	TStoreKey = "transient_params"
 )
 
+// ChangeListener is notified of each change of a parameter value of a
+// subspace, with the JSON encoded values before and after the change. The old
+// value is nil if the parameter was not set.
+type ChangeListener func(ctx sdk.Context, subspace string, key, subkey, oldValue, newValue []byte)
+
 // Individual parameter store for each keeper
 // Transient store persists for a block, so we use it for
 // recording whether the parameter has been changed or not
@@ -28,7 +35,8 @@ type Subspace struct {
 
 	name []byte
 
-	table KeyTable
+	table    KeyTable
+	listener ChangeListener
 }
 
 // NewSubspace constructs a store with namestore
@@ -68,6 +76,13 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 	return s
 }
 
+// WithChangeListener returns a copy of the subspace notifying the listener of
+// the changes of its parameter values
+func (s Subspace) WithChangeListener(listener ChangeListener) Subspace {
+	s.listener = listener
+	return s
+}
+
 // Returns a KVStore identical with ctx.KVStore(s.key).Prefix()
 func (s Subspace) kvStore(ctx sdk.Context) sdk.KVStore {
 	// append here is safe, appends within a function won't cause
@@ -130,6 +145,12 @@ func (s Subspace) GetRaw(ctx sdk.Context, key []byte) []byte {
 	return store.Get(key)
 }
 
+// GetRawWithSubkey returns raw bytes of a parameter with a given key and a
+// subkey from store.
+func (s Subspace) GetRawWithSubkey(ctx sdk.Context, key, subkey []byte) []byte {
+	return s.GetRaw(ctx, concatKeys(key, subkey))
+}
+
 // Check if the parameter is set in the store
 func (s Subspace) Has(ctx sdk.Context, key []byte) bool {
 	store := s.kvStore(ctx)
@@ -159,17 +180,62 @@ func (s Subspace) checkType(store sdk.KVStore, key []byte, param interface{}) {
 	}
 }
 
-// Set stores the parameter. It returns error if stored parameter has different type from input.
-// It also set to the transient store to record change.
+// Validate checks the parameter value against the validator registered for
+// its key. It panics if the key is not registered.
+func (s Subspace) Validate(ctx sdk.Context, key []byte, param interface{}) error {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		panic("Parameter not registered")
+	}
+
+	if err := attr.vfn(reflect.Indirect(reflect.ValueOf(param)).Interface()); err != nil {
+		return fmt.Errorf("invalid value for parameter %s: %s", key, err)
+	}
+
+	return nil
+}
+
+// validateParamSet checks the parameter set the key was registered with, if it
+// implements ParamSetValidator, as it would be after setting the parameter.
+func (s Subspace) validateParamSet(ctx sdk.Context, key []byte, param interface{}) error {
+	attr := s.table.m[string(key)]
+	if attr.ps == nil {
+		return nil
+	}
+
+	psv, ok := reflect.New(attr.ps).Interface().(ParamSetValidator)
+	if !ok {
+		return nil
+	}
+
+	for _, pair := range psv.ParamSetPairs() {
+		if bytes.Equal(pair.Key, key) {
+			reflect.ValueOf(pair.Value).Elem().Set(reflect.Indirect(reflect.ValueOf(param)))
+			continue
+		}
+		s.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return psv.Validate()
+}
+
+// Set stores the parameter. It panics if the stored parameter has a different
+// type from the input or if the value does not pass the registered validator.
+// It also set to the transient store to record change, and notifies the change
+// listener if the value changed.
 func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 	store := s.kvStore(ctx)
 
 	s.checkType(store, key, param)
+	if err := s.Validate(ctx, key, param); err != nil {
+		panic(err)
+	}
 
 	bz, err := s.cdc.MarshalJSON(param)
 	if err != nil {
 		panic(err)
 	}
+	s.notify(ctx, key, nil, store.Get(key), bz)
 	store.Set(key, bz)
 
 	tstore := s.transientStore(ctx)
@@ -177,9 +243,18 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 
 }
 
+// notify notifies the change listener, if any, of a changed parameter value
+func (s Subspace) notify(ctx sdk.Context, key, subkey, oldValue, newValue []byte) {
+	if s.listener == nil || bytes.Equal(oldValue, newValue) {
+		return
+	}
+	s.listener(ctx, string(s.name), key, subkey, oldValue, newValue)
+}
+
 // Update stores raw parameter bytes. It returns error if the stored parameter
-// has a different type from the input. It also sets to the transient store to
-// record change.
+// has a different type from the input, if the value does not pass the
+// registered validator or if the parameter set it belongs to would be invalid.
+// It also sets to the transient store to record change.
 func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
@@ -194,6 +269,13 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if err := s.Validate(ctx, key, dest); err != nil {
+		return err
+	}
+	if err := s.validateParamSet(ctx, key, dest); err != nil {
+		return err
+	}
+
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(key, []byte{})
@@ -207,6 +289,9 @@ func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, para
 	store := s.kvStore(ctx)
 
 	s.checkType(store, key, param)
+	if err := s.Validate(ctx, key, param); err != nil {
+		panic(err)
+	}
 
 	newkey := concatKeys(key, subkey)
 
@@ -214,6 +299,7 @@ func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, para
 	if err != nil {
 		panic(err)
 	}
+	s.notify(ctx, key, subkey, store.Get(newkey), bz)
 	store.Set(newkey, bz)
 
 	tstore := s.transientStore(ctx)
@@ -238,6 +324,10 @@ func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, p
 		return err
 	}
 
+	if err := s.Validate(ctx, key, dest); err != nil {
+		return err
+	}
+
 	s.SetWithSubkey(ctx, key, subkey, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(concatkey, []byte{})
@@ -5,7 +5,11 @@ import (
 )
 
 type attribute struct {
-	ty reflect.Type
+	ty  reflect.Type
+	vfn ValueValidatorFn
+
+	// type of the ParamSet the parameter was registered with, if any
+	ps reflect.Type
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -14,17 +18,13 @@ type KeyTable struct {
 }
 
 // Constructs new table
-func NewKeyTable(keytypes ...interface{}) (res KeyTable) {
-	if len(keytypes)%2 != 0 {
-		panic("odd number arguments in NewTypeKeyTable")
-	}
-
+func NewKeyTable(pairs ...ParamSetPair) (res KeyTable) {
 	res = KeyTable{
 		m: make(map[string]attribute),
 	}
 
-	for i := 0; i < len(keytypes); i += 2 {
-		res = res.RegisterType(keytypes[i].([]byte), keytypes[i+1])
+	for _, psp := range pairs {
+		res = res.RegisterType(psp)
 	}
 
 	return
@@ -41,20 +41,27 @@ func isAlphaNumeric(key []byte) bool {
 	return true
 }
 
-// Register single key-type pair
-func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
-	if len(key) == 0 {
+// Register single key-type pair along with its value validator
+func (t KeyTable) RegisterType(psp ParamSetPair) KeyTable {
+	return t.registerType(psp, nil)
+}
+
+func (t KeyTable) registerType(psp ParamSetPair, ps reflect.Type) KeyTable {
+	if len(psp.Key) == 0 {
 		panic("cannot register empty key")
 	}
-	if !isAlphaNumeric(key) {
+	if !isAlphaNumeric(psp.Key) {
 		panic("non alphanumeric parameter key")
 	}
-	keystr := string(key)
+	keystr := string(psp.Key)
 	if _, ok := t.m[keystr]; ok {
 		panic("duplicate parameter key")
 	}
+	if psp.ValidatorFn == nil {
+		panic("nil value validator for parameter key " + keystr)
+	}
 
-	rty := reflect.TypeOf(ty)
+	rty := reflect.TypeOf(psp.Value)
 
 	// Indirect rty if it is ptr
 	if rty.Kind() == reflect.Ptr {
@@ -62,7 +69,9 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	}
 
 	t.m[keystr] = attribute{
-		ty: rty,
+		ty:  rty,
+		vfn: psp.ValidatorFn,
+		ps:  ps,
 	}
 
 	return t
@@ -70,12 +79,49 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 
 // Register multiple pairs from ParamSet
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
-	for _, kvp := range ps.ParamSetPairs() {
-		t = t.RegisterType(kvp.Key, kvp.Value)
+	pty := reflect.TypeOf(ps)
+	if pty.Kind() == reflect.Ptr {
+		pty = pty.Elem()
+	}
+
+	for _, psp := range ps.ParamSetPairs() {
+		t = t.registerType(psp, pty)
 	}
 	return t
 }
 
+// WithValidator returns a copy of the table in which the parameter with the
+// given key must also pass vfn, so that an application can restrict a
+// parameter further than the module which registered it.
+func (t KeyTable) WithValidator(key []byte, vfn ValueValidatorFn) KeyTable {
+	keystr := string(key)
+	attr, ok := t.m[keystr]
+	if !ok {
+		panic("parameter key " + keystr + " not registered")
+	}
+	if vfn == nil {
+		panic("nil value validator for parameter key " + keystr)
+	}
+
+	res := KeyTable{
+		m: make(map[string]attribute, len(t.m)),
+	}
+	for k, v := range t.m {
+		res.m[k] = v
+	}
+
+	registered := attr.vfn
+	attr.vfn = func(value interface{}) error {
+		if err := registered(value); err != nil {
+			return err
+		}
+		return vfn(value)
+	}
+	res.m[keystr] = attr
+
+	return res
+}
+
 func (t KeyTable) maxKeyLength() (res int) {
 	for k := range t.m {
 		l := len(k)
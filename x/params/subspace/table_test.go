@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,23 +14,46 @@ type testparams struct {
 
 func (tp *testparams) ParamSetPairs() ParamSetPairs {
 	return ParamSetPairs{
-		{[]byte("i"), &tp.i},
-		{[]byte("b"), &tp.b},
+		{[]byte("i"), &tp.i, validateNoOp},
+		{[]byte("b"), &tp.b, validateNoOp},
 	}
 }
 
+func validateNoOp(_ interface{}) error { return nil }
+
 func TestKeyTable(t *testing.T) {
 	table := NewKeyTable()
 
-	require.Panics(t, func() { table.RegisterType([]byte(""), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("!@#$%"), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello,"), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello"), nil) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte(""), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("!@#$%"), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello,"), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), nil}) })
 
-	require.NotPanics(t, func() { table.RegisterType([]byte("hello"), bool(false)) })
-	require.NotPanics(t, func() { table.RegisterType([]byte("world"), int64(0)) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello"), bool(false)) })
+	require.NotPanics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), validateNoOp}) })
+	require.NotPanics(t, func() { table.RegisterType(ParamSetPair{[]byte("world"), int64(0), validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), validateNoOp}) })
 
 	require.NotPanics(t, func() { table.RegisterParamSet(&testparams{}) })
 	require.Panics(t, func() { table.RegisterParamSet(&testparams{}) })
 }
+
+func TestKeyTableWithValidator(t *testing.T) {
+	table := NewKeyTable().RegisterParamSet(&testparams{})
+
+	require.Panics(t, func() { table.WithValidator([]byte("hello"), validateNoOp) })
+	require.Panics(t, func() { table.WithValidator([]byte("i"), nil) })
+
+	errNegative := errors.New("negative")
+	restricted := table.WithValidator([]byte("i"), func(i interface{}) error {
+		if i.(int64) < 0 {
+			return errNegative
+		}
+		return nil
+	})
+
+	require.NoError(t, table.m["i"].vfn(int64(-1)))
+	require.Equal(t, errNegative, restricted.m["i"].vfn(int64(-1)))
+	require.NoError(t, restricted.m["i"].vfn(int64(1)))
+	require.Equal(t, table.m["i"].ps, restricted.m["i"].ps)
+}
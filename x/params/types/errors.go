@@ -10,9 +10,10 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = "params"
 
-	CodeUnknownSubspace  sdk.CodeType = 1
-	CodeSettingParameter sdk.CodeType = 2
-	CodeEmptyData        sdk.CodeType = 3
+	CodeUnknownSubspace   sdk.CodeType = 1
+	CodeSettingParameter  sdk.CodeType = 2
+	CodeEmptyData         sdk.CodeType = 3
+	CodeInvalidActivation sdk.CodeType = 4
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -44,3 +45,9 @@ func ErrEmptyKey(codespace sdk.CodespaceType) sdk.Error {
 func ErrEmptyValue(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEmptyData, "parameter value is empty")
 }
+
+// ErrInvalidActivation returns an error for an invalid activation height or
+// time of scheduled parameter changes.
+func ErrInvalidActivation(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidActivation, fmt.Sprintf("invalid activation: %s", msg))
+}
@@ -0,0 +1,12 @@
+package types
+
+// params module event types
+const (
+	EventTypeActivateParamChanges = "activate_param_changes"
+
+	AttributeKeyPendingChangesID = "pending_changes_id"
+	AttributeKeyResult           = "result"
+
+	AttributeValueApplied = "applied"
+	AttributeValueFailed  = "failed"
+)
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParamChangeRecord defines a change of a parameter value, applied by a
+// ParameterChangeProposal or set directly by a keeper. Values are the JSON
+// encoded parameters as stored in the subspace.
+type ParamChangeRecord struct {
+	Subspace   string    `json:"subspace" yaml:"subspace"`       // subspace of the parameter
+	Key        string    `json:"key" yaml:"key"`                 // key of the parameter
+	Subkey     string    `json:"subkey,omitempty" yaml:"subkey"` // subkey of the parameter, if any
+	Height     int64     `json:"height" yaml:"height"`           // height at which the change was applied
+	Time       time.Time `json:"time" yaml:"time"`               // block time at which the change was applied
+	OldValue   string    `json:"old_value" yaml:"old_value"`     // value before the change
+	NewValue   string    `json:"new_value" yaml:"new_value"`     // value after the change
+	ProposalID uint64    `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal changing the parameter, if any
+}
+
+// NewParamChangeRecord creates a new ParamChangeRecord instance
+func NewParamChangeRecord(subspace, key, subkey string, height int64, changeTime time.Time,
+	oldValue, newValue string, proposalID uint64) ParamChangeRecord {
+
+	return ParamChangeRecord{
+		Subspace:   subspace,
+		Key:        key,
+		Subkey:     subkey,
+		Height:     height,
+		Time:       changeTime,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+		ProposalID: proposalID,
+	}
+}
+
+// Validate performs basic validation of the record.
+func (pcr ParamChangeRecord) Validate() error {
+	if len(strings.TrimSpace(pcr.Subspace)) == 0 {
+		return errors.New("parameter change record subspace cannot be blank")
+	}
+	if len(strings.TrimSpace(pcr.Key)) == 0 {
+		return errors.New("parameter change record key cannot be blank")
+	}
+	if pcr.Height < 0 {
+		return fmt.Errorf("parameter change record height cannot be negative: %d", pcr.Height)
+	}
+	if len(pcr.NewValue) == 0 {
+		return errors.New("parameter change record new value cannot be empty")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (pcr ParamChangeRecord) String() string {
+	return fmt.Sprintf(`Param Change Record:
+  Subspace:    %s
+  Key:         %s
+  Subkey:      %s
+  Height:      %d
+  Time:        %s
+  Old Value:   %s
+  New Value:   %s
+  Proposal ID: %d`,
+		pcr.Subspace, pcr.Key, pcr.Subkey, pcr.Height, pcr.Time,
+		pcr.OldValue, pcr.NewValue, pcr.ProposalID,
+	)
+}
+
+// ParamChangeRecords defines the change history of a parameter, oldest first
+type ParamChangeRecords []ParamChangeRecord
+
+// String implements the Stringer interface.
+func (pcrs ParamChangeRecords) String() string {
+	if len(pcrs) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(pcrs))
+	for i, pcr := range pcrs {
+		out[i] = pcr.String()
+	}
+	return strings.Join(out, "\n")
+}
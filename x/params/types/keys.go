@@ -1,9 +1,96 @@
 package types
 
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the name of the module
 	ModuleName = "params"
 
 	// RouterKey defines the routing key for a ParameterChangeProposal
 	RouterKey = "params"
+
+	// QuerierRoute defines the querier route for the params module
+	QuerierRoute = "params"
 )
+
+// Keys of the params module state, which is stored in the params store next to
+// the subspaces. Subspace names are non-empty strings, so these single byte
+// keys cannot collide with parameter keys.
+var (
+	PendingChangesKeyPrefix = []byte{0x00} // prefix for the pending parameter changes
+	PendingChangesIDKey     = []byte{0x01} // key for the next pending parameter changes ID
+	ParamHistoryKeyPrefix   = []byte{0x02} // prefix for the parameter change history
+
+	PendingChangesHeightQueuePrefix = []byte{0x03} // prefix for the pending parameter changes by activation height
+	PendingChangesTimeQueuePrefix   = []byte{0x04} // prefix for the pending parameter changes by activation time
+)
+
+// GetPendingChangesKey returns the key of the pending parameter changes with the
+// given ID.
+func GetPendingChangesKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(PendingChangesKeyPrefix, bz...)
+}
+
+// GetPendingChangesByHeightKey returns the key of the queue of the pending
+// parameter changes activated at the given height.
+func GetPendingChangesByHeightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(PendingChangesHeightQueuePrefix, bz...)
+}
+
+// GetPendingChangesHeightQueueKey returns the key of the pending parameter
+// changes with the given ID in the activation height queue.
+func GetPendingChangesHeightQueueKey(id uint64, height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(GetPendingChangesByHeightKey(height), bz...)
+}
+
+// GetPendingChangesByTimeKey returns the key of the queue of the pending
+// parameter changes activated at the given time.
+func GetPendingChangesByTimeKey(activationTime time.Time) []byte {
+	return append(PendingChangesTimeQueuePrefix, sdk.FormatTimeBytes(activationTime)...)
+}
+
+// GetPendingChangesTimeQueueKey returns the key of the pending parameter
+// changes with the given ID in the activation time queue.
+func GetPendingChangesTimeQueueKey(id uint64, activationTime time.Time) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(GetPendingChangesByTimeKey(activationTime), bz...)
+}
+
+// GetParamHistoryKey returns the prefix of the change records of a parameter.
+// The subspace and the key are length prefixed so that the records of
+// different parameters cannot collide.
+func GetParamHistoryKey(subspace, key string) []byte {
+	bz := append(ParamHistoryKeyPrefix, byte(len(subspace)))
+	bz = append(bz, subspace...)
+	bz = append(bz, byte(len(key)))
+	return append(bz, key...)
+}
+
+// GetParamHistoryByHeightKey returns the prefix of the change records of a
+// parameter applied at the given height.
+func GetParamHistoryByHeightKey(subspace, key string, height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(GetParamHistoryKey(subspace, key), bz...)
+}
+
+// GetParamChangeRecordKey returns the key of a change record of a parameter,
+// the sequence numbering the changes of the parameter applied at the same
+// height.
+func GetParamChangeRecordKey(subspace, key string, height int64, seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(GetParamHistoryByHeightKey(subspace, key, height), bz...)
+}
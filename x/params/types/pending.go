@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PendingParamChanges defines the parameter changes of a passed
+// ParameterChangeProposal, which are scheduled to be applied at an activation
+// height or time.
+type PendingParamChanges struct {
+	ID               uint64        `json:"id" yaml:"id"`                               // ID of the pending changes
+	ProposalID       uint64        `json:"proposal_id" yaml:"proposal_id"`             // ID of the proposal scheduling the changes
+	ActivationHeight int64         `json:"activation_height" yaml:"activation_height"` // height from which the changes apply
+	ActivationTime   time.Time     `json:"activation_time" yaml:"activation_time"`     // block time from which the changes apply
+	Changes          []ParamChange `json:"changes" yaml:"changes"`                     // parameter changes to apply
+}
+
+// NewPendingParamChanges creates a new PendingParamChanges instance
+func NewPendingParamChanges(id, proposalID uint64, activationHeight int64, activationTime time.Time,
+	changes []ParamChange) PendingParamChanges {
+
+	return PendingParamChanges{
+		ID:               id,
+		ProposalID:       proposalID,
+		ActivationHeight: activationHeight,
+		ActivationTime:   activationTime,
+		Changes:          changes,
+	}
+}
+
+// IsActive returns true if the changes apply at the given block height and
+// time.
+func (ppc PendingParamChanges) IsActive(height int64, blockTime time.Time) bool {
+	if !ppc.ActivationTime.IsZero() {
+		return !blockTime.Before(ppc.ActivationTime)
+	}
+	return height >= ppc.ActivationHeight
+}
+
+// Validate performs basic validation of the pending changes.
+func (ppc PendingParamChanges) Validate() error {
+	if ppc.ActivationHeight == 0 && ppc.ActivationTime.IsZero() {
+		return fmt.Errorf("pending parameter changes %d have no activation height or time", ppc.ID)
+	}
+	if err := ValidateActivation(ppc.ActivationHeight, ppc.ActivationTime); err != nil {
+		return err
+	}
+	if err := ValidateChanges(ppc.Changes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ppc PendingParamChanges) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("Pending Param Changes %d:\n", ppc.ID))
+	b.WriteString(fmt.Sprintf("  Proposal ID:       %d\n", ppc.ProposalID))
+	if ppc.ActivationTime.IsZero() {
+		b.WriteString(fmt.Sprintf("  Activation Height: %d\n", ppc.ActivationHeight))
+	} else {
+		b.WriteString(fmt.Sprintf("  Activation Time:   %s\n", ppc.ActivationTime))
+	}
+	b.WriteString("  Changes:\n")
+
+	for _, pc := range ppc.Changes {
+		b.WriteString(fmt.Sprintf(`    Param Change:
+      Subspace: %s
+      Key:      %s
+      Subkey:   %s
+      Value:    %s
+`, pc.Subspace, pc.Key, pc.Subkey, pc.Value))
+	}
+
+	return b.String()
+}
+
+// PendingParamChangesList defines a list of pending parameter changes
+type PendingParamChangesList []PendingParamChanges
+
+// String implements the Stringer interface.
+func (ppcl PendingParamChangesList) String() string {
+	if len(ppcl) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(ppcl))
+	for i, ppc := range ppcl {
+		out[i] = ppc.String()
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -22,15 +23,39 @@ func init() {
 }
 
 // ParameterChangeProposal defines a proposal which contains multiple parameter
-// changes.
+// changes. The changes are applied when the proposal passes, unless an
+// activation height or time is set, in which case they are scheduled and
+// applied at the beginning of the first block at or after the activation.
 type ParameterChangeProposal struct {
 	Title       string        `json:"title" yaml:"title"`
 	Description string        `json:"description" yaml:"description"`
 	Changes     []ParamChange `json:"changes" yaml:"changes"`
+
+	ActivationHeight int64     `json:"activation_height,omitempty" yaml:"activation_height,omitempty"` // height from which the changes apply
+	ActivationTime   time.Time `json:"activation_time,omitempty" yaml:"activation_time,omitempty"`     // block time from which the changes apply
 }
 
 func NewParameterChangeProposal(title, description string, changes []ParamChange) ParameterChangeProposal {
-	return ParameterChangeProposal{title, description, changes}
+	return ParameterChangeProposal{
+		Title:       title,
+		Description: description,
+		Changes:     changes,
+	}
+}
+
+// NewScheduledParameterChangeProposal creates a ParameterChangeProposal whose
+// changes are applied at the given activation height or time. At most one of
+// them may be set.
+func NewScheduledParameterChangeProposal(title, description string, changes []ParamChange,
+	activationHeight int64, activationTime time.Time) ParameterChangeProposal {
+
+	return ParameterChangeProposal{
+		Title:            title,
+		Description:      description,
+		Changes:          changes,
+		ActivationHeight: activationHeight,
+		ActivationTime:   activationTime,
+	}
 }
 
 // GetTitle returns the title of a parameter change proposal.
@@ -52,9 +77,18 @@ func (pcp ParameterChangeProposal) ValidateBasic() sdk.Error {
 		return err
 	}
 
+	if err := ValidateActivation(pcp.ActivationHeight, pcp.ActivationTime); err != nil {
+		return err
+	}
+
 	return ValidateChanges(pcp.Changes)
 }
 
+// IsScheduled returns true if the proposal sets an activation height or time.
+func (pcp ParameterChangeProposal) IsScheduled() bool {
+	return pcp.ActivationHeight != 0 || !pcp.ActivationTime.IsZero()
+}
+
 // String implements the Stringer interface.
 func (pcp ParameterChangeProposal) String() string {
 	var b strings.Builder
@@ -74,6 +108,13 @@ func (pcp ParameterChangeProposal) String() string {
 `, pc.Subspace, pc.Key, pc.Subkey, pc.Value))
 	}
 
+	switch {
+	case pcp.ActivationHeight != 0:
+		b.WriteString(fmt.Sprintf("  Activation Height: %d\n", pcp.ActivationHeight))
+	case !pcp.ActivationTime.IsZero():
+		b.WriteString(fmt.Sprintf("  Activation Time:   %s\n", pcp.ActivationTime))
+	}
+
 	return b.String()
 }
 
@@ -124,3 +165,15 @@ func ValidateChanges(changes []ParamChange) sdk.Error {
 
 	return nil
 }
+
+// ValidateActivation checks that at most one of the activation height and time
+// is set, and that the activation height is not negative.
+func ValidateActivation(activationHeight int64, activationTime time.Time) sdk.Error {
+	if activationHeight < 0 {
+		return ErrInvalidActivation(DefaultCodespace, fmt.Sprintf("negative activation height %d", activationHeight))
+	}
+	if activationHeight != 0 && !activationTime.IsZero() {
+		return ErrInvalidActivation(DefaultCodespace, "only one of activation height and activation time can be set")
+	}
+	return nil
+}
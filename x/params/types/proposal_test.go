@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -29,3 +30,44 @@ func TestParameterChangeProposal(t *testing.T) {
 	pcp = NewParameterChangeProposal("test title", "test description", []ParamChange{pc5})
 	require.Error(t, pcp.ValidateBasic())
 }
+
+func TestScheduledParameterChangeProposal(t *testing.T) {
+	changes := []ParamChange{NewParamChange("sub", "foo", "baz")}
+	activationTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	pcp := NewScheduledParameterChangeProposal("test title", "test description", changes, 100, time.Time{})
+	require.Nil(t, pcp.ValidateBasic())
+	require.True(t, pcp.IsScheduled())
+
+	pcp = NewScheduledParameterChangeProposal("test title", "test description", changes, 0, activationTime)
+	require.Nil(t, pcp.ValidateBasic())
+	require.True(t, pcp.IsScheduled())
+
+	pcp = NewScheduledParameterChangeProposal("test title", "test description", changes, 0, time.Time{})
+	require.Nil(t, pcp.ValidateBasic())
+	require.False(t, pcp.IsScheduled())
+
+	pcp = NewScheduledParameterChangeProposal("test title", "test description", changes, -1, time.Time{})
+	require.Error(t, pcp.ValidateBasic())
+
+	pcp = NewScheduledParameterChangeProposal("test title", "test description", changes, 100, activationTime)
+	require.Error(t, pcp.ValidateBasic())
+}
+
+func TestPendingParamChangesIsActive(t *testing.T) {
+	changes := []ParamChange{NewParamChange("sub", "foo", "baz")}
+	activationTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	pending := NewPendingParamChanges(1, 1, 100, time.Time{}, changes)
+	require.NoError(t, pending.Validate())
+	require.False(t, pending.IsActive(99, activationTime))
+	require.True(t, pending.IsActive(100, time.Time{}))
+
+	pending = NewPendingParamChanges(1, 1, 0, activationTime, changes)
+	require.NoError(t, pending.Validate())
+	require.False(t, pending.IsActive(1000, activationTime.Add(-time.Second)))
+	require.True(t, pending.IsActive(1, activationTime))
+
+	pending = NewPendingParamChanges(1, 1, 0, time.Time{}, changes)
+	require.Error(t, pending.Validate())
+}
@@ -0,0 +1,22 @@
+package types
+
+// query endpoints supported by the params querier
+const (
+	QueryPendingChanges = "pending"
+	QueryParamHistory   = "history"
+)
+
+// QueryParamHistoryParams defines the params for the following queries:
+// - 'custom/params/history'
+type QueryParamHistoryParams struct {
+	Subspace string
+	Key      string
+}
+
+// NewQueryParamHistoryParams creates a new instance of QueryParamHistoryParams
+func NewQueryParamHistoryParams(subspace, key string) QueryParamHistoryParams {
+	return QueryParamHistoryParams{
+		Subspace: subspace,
+		Key:      key,
+	}
+}
@@ -20,4 +20,7 @@ type Config struct {
 
 	OnOperation   bool // run slow invariants every operation
 	AllInvariants bool // print all failed invariants if a broken invariant is found
-}
\ No newline at end of file
+
+	OperationLogPath string // custom file path to record every executed operation to
+	HaltStep         int    // stop the simulation once this many operations were executed; zero runs all blocks
+}
@@ -0,0 +1,139 @@
+package simulation
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// OperationLogHeader is the first line of an operation log. It records the
+// configuration of the simulation the log was recorded from and the genesis
+// the chain was initialized with.
+//
+// The following entries are the operations executed by the simulation, in
+// order: the BeginBlock entries hold the delivered RequestBeginBlock and the
+// message entries hold the delivered message, so that a replay executes them
+// again without regenerating them from the seed.
+type OperationLogHeader struct {
+	Config   Config          `json:"config" yaml:"config"`
+	ChainID  string          `json:"chain_id" yaml:"chain_id"`
+	AppState json.RawMessage `json:"app_state" yaml:"app_state"`
+}
+
+// OperationLogEntry is an operation entry numbered with its step, i.e. its
+// position among all the operations executed by the simulation, starting at 1
+type OperationLogEntry struct {
+	Step int64 `json:"step" yaml:"step"`
+	OperationEntry
+}
+
+// ReadOperationLog reads the header and the entries of an operation log
+// recorded by the simulation.
+func ReadOperationLog(path string) (header OperationLogHeader, entries []OperationLogEntry, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return header, nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return header, nil, err
+		}
+		return header, nil, fmt.Errorf("operation log %s is empty", path)
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return header, nil, fmt.Errorf("invalid operation log header: %v", err)
+	}
+
+	for scanner.Scan() {
+		var entry OperationLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return header, nil, fmt.Errorf("invalid operation log entry %d: %v", len(entries)+1, err)
+		}
+		if entry.Step != int64(len(entries)+1) {
+			return header, nil, fmt.Errorf("operation log entry %d has step %d", len(entries)+1, entry.Step)
+		}
+		entries = append(entries, entry)
+	}
+	return header, entries, scanner.Err()
+}
+
+// operationRecorder numbers the operations executed by the simulation,
+// writes them to the operation log and halts the simulation at the halt step.
+type operationRecorder struct {
+	step     int64
+	haltStep int64
+
+	file   *os.File
+	writer *bufio.Writer
+}
+
+// newOperationRecorder creates the recorder of a simulation, creating its
+// operation log if the config has a path for it
+func newOperationRecorder(config Config, initChain abci.RequestInitChain) (*operationRecorder, error) {
+	rec := &operationRecorder{haltStep: int64(config.HaltStep)}
+	if config.OperationLogPath == "" {
+		return rec, nil
+	}
+
+	f, err := os.Create(config.OperationLogPath)
+	if err != nil {
+		return nil, err
+	}
+	rec.file = f
+	rec.writer = bufio.NewWriter(f)
+	rec.write(OperationLogHeader{
+		Config:   config,
+		ChainID:  initChain.ChainId,
+		AppState: initChain.AppStateBytes,
+	})
+	return rec, nil
+}
+
+// record numbers an executed operation and writes it to the operation log
+func (rec *operationRecorder) record(entry OperationEntry) {
+	rec.step++
+	if rec.writer != nil {
+		rec.write(OperationLogEntry{Step: rec.step, OperationEntry: entry})
+	}
+}
+
+// recordBeginBlock records the BeginBlock of a block along with its request
+func (rec *operationRecorder) recordBeginBlock(height int64, req abci.RequestBeginBlock) {
+	rec.record(NewOperationEntry(BeginBlockEntryKind, height, -1, mustMarshalJSON(req)))
+}
+
+// halted returns true once the simulation reached the halt step
+func (rec *operationRecorder) halted() bool {
+	return rec.haltStep > 0 && rec.step >= rec.haltStep
+}
+func (rec *operationRecorder) write(o interface{}) {
+	if _, err := rec.writer.Write(append(mustMarshalJSON(o), '\n')); err != nil {
+		panic(fmt.Sprintf("failed to write the operation log: %s", err))
+	}
+}
+
+// close flushes and closes the operation log
+func (rec *operationRecorder) close() error {
+	if rec.file == nil {
+		return nil
+	}
+	if err := rec.writer.Flush(); err != nil {
+		return err
+	}
+	return rec.file.Close()
+}
+
+func mustMarshalJSON(o interface{}) []byte {
+	bz, err := json.Marshal(o)
+	if err != nil {
+		panic(fmt.Sprintf("failed to JSON encode: %s", err))
+	}
+	return bz
+}
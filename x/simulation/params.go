@@ -18,32 +18,40 @@ const (
 	maxTimePerBlock int64 = 10000
 
 	// Simulation parameter constants
-	SendEnabled              = "send_enabled"
-	MaxMemoChars             = "max_memo_characters"
-	TxSigLimit               = "tx_sig_limit"
-	TxSizeCostPerByte        = "tx_size_cost_per_byte"
-	SigVerifyCostED25519     = "sig_verify_cost_ed25519"
-	SigVerifyCostSECP256K1   = "sig_verify_cost_secp256k1"
-	DepositParamsMinDeposit  = "deposit_params_min_deposit"
-	VotingParamsVotingPeriod = "voting_params_voting_period"
-	TallyParamsQuorum        = "tally_params_quorum"
-	TallyParamsThreshold     = "tally_params_threshold"
-	TallyParamsVeto          = "tally_params_veto"
-	UnbondingTime            = "unbonding_time"
-	MaxValidators            = "max_validators"
-	SignedBlocksWindow       = "signed_blocks_window"
-	MinSignedPerWindow       = "min_signed_per_window"
-	DowntimeJailDuration     = "downtime_jail_duration"
-	SlashFractionDoubleSign  = "slash_fraction_double_sign"
-	SlashFractionDowntime    = "slash_fraction_downtime"
-	InflationRateChange      = "inflation_rate_change"
-	Inflation                = "inflation"
-	InflationMax             = "inflation_max"
-	InflationMin             = "inflation_min"
-	GoalBonded               = "goal_bonded"
-	CommunityTax             = "community_tax"
-	BaseProposerReward       = "base_proposer_reward"
-	BonusProposerReward      = "bonus_proposer_reward"
+	SendEnabled               = "send_enabled"
+	SendEnabledBondDenom      = "send_enabled_bond_denom"
+	MaxMemoChars              = "max_memo_characters"
+	TxSigLimit                = "tx_sig_limit"
+	TxSizeCostPerByte         = "tx_size_cost_per_byte"
+	SigVerifyCostED25519      = "sig_verify_cost_ed25519"
+	SigVerifyCostSECP256K1    = "sig_verify_cost_secp256k1"
+	DepositParamsMinDeposit   = "deposit_params_min_deposit"
+	VotingParamsVotingPeriod  = "voting_params_voting_period"
+	TallyParamsQuorum         = "tally_params_quorum"
+	TallyParamsThreshold      = "tally_params_threshold"
+	TallyParamsVeto           = "tally_params_veto"
+	UnbondingTime             = "unbonding_time"
+	MaxValidators             = "max_validators"
+	SignedBlocksWindow        = "signed_blocks_window"
+	MinSignedPerWindow        = "min_signed_per_window"
+	DowntimeJailDuration      = "downtime_jail_duration"
+	SlashFractionDoubleSign   = "slash_fraction_double_sign"
+	SlashFractionDowntime     = "slash_fraction_downtime"
+	DowntimePenaltyWindow     = "downtime_penalty_window"
+	DowntimePenaltyMultiplier = "downtime_penalty_multiplier"
+	DowntimePenaltyMaxTier    = "downtime_penalty_max_tier"
+	InflationRateChange       = "inflation_rate_change"
+	Inflation                 = "inflation"
+	InflationMax              = "inflation_max"
+	InflationMin              = "inflation_min"
+	GoalBonded                = "goal_bonded"
+	MintFeeCollectorShare     = "mint_fee_collector_share"
+	CommunityTax              = "community_tax"
+	BaseProposerReward        = "base_proposer_reward"
+	BonusProposerReward       = "bonus_proposer_reward"
+	AutoCompoundInterval      = "auto_compound_interval"
+	MaxAutoCompoundsPerBlock  = "max_auto_compounds_per_block"
+	TokenIssueFee             = "token_issue_fee"
 )
 
 // TODO explain transitional matrix usage
@@ -71,6 +79,9 @@ var (
 		SendEnabled: func(r *rand.Rand) interface{} {
 			return r.Int63n(2) == 0
 		},
+		SendEnabledBondDenom: func(r *rand.Rand) interface{} {
+			return r.Int63n(2) == 0
+		},
 		MaxMemoChars: func(r *rand.Rand) interface{} {
 			return uint64(RandIntBetween(r, 100, 200))
 		},
@@ -122,6 +133,15 @@ var (
 		SlashFractionDowntime: func(r *rand.Rand) interface{} {
 			return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 		},
+		DowntimePenaltyWindow: func(r *rand.Rand) interface{} {
+			return time.Duration(RandIntBetween(r, 0, 60*60*24*7)) * time.Second
+		},
+		DowntimePenaltyMultiplier: func(r *rand.Rand) interface{} {
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 10, 31)), 1)
+		},
+		DowntimePenaltyMaxTier: func(r *rand.Rand) interface{} {
+			return int64(r.Intn(5))
+		},
 		InflationRateChange: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
 		},
@@ -137,6 +157,9 @@ var (
 		GoalBonded: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(67, 2)
 		},
+		MintFeeCollectorShare: func(r *rand.Rand) interface{} {
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 50, 101)), 2)
+		},
 		CommunityTax: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
 		},
@@ -146,6 +169,15 @@ var (
 		BonusProposerReward: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
 		},
+		AutoCompoundInterval: func(r *rand.Rand) interface{} {
+			return int64(RandIntBetween(r, 1, 100))
+		},
+		MaxAutoCompoundsPerBlock: func(r *rand.Rand) interface{} {
+			return uint32(RandIntBetween(r, 1, 50))
+		},
+		TokenIssueFee: func(r *rand.Rand) interface{} {
+			return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(RandIntBetween(r, 1, 1000))))
+		},
 	}
 )
 
@@ -0,0 +1,317 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppInputs groups an application with the inputs needed to simulate it and
+// to replay its operation logs
+type AppInputs struct {
+	App             *baseapp.BaseApp
+	Codec           *codec.Codec
+	Router          sdk.Router
+	AppStateFn      AppStateFn
+	Operations      WeightedOperations
+	Invariants      sdk.Invariants
+	BlackListedAccs map[string]bool
+
+	// Router routes the recorded messages to the handlers of their modules
+	// when an operation log is replayed, and OperationReplayers replay the
+	// recorded operations which do not deliver a message, keyed by
+	// OperationReplayerKey
+	OperationReplayers map[string]OperationReplayer
+}
+
+// AppFactory creates a fresh application, along with its simulation inputs,
+// for the given config. Replaying or bisecting an operation log runs the
+// recorded operations from genesis, so the factory is called once for every
+// run. The returned function releases the resources of the application.
+type AppFactory func(config Config) (inputs AppInputs, cleanup func())
+
+// OperationReplayer executes a recorded operation against the application
+// when an operation log is replayed. It is used for the operations whose
+// recorded message is not an sdk.Msg handled by the router.
+type OperationReplayer func(app *baseapp.BaseApp, ctx sdk.Context, opMsg OperationMsg) error
+
+// OperationReplayerKey returns the key of the replayer of the operations
+// with the given route and name
+func OperationReplayerKey(route, name string) string {
+	return route + "/" + name
+}
+
+// operationReplay executes the entries of an operation log against an
+// application
+type operationReplay struct {
+	inputs   AppInputs
+	header   OperationLogHeader
+	entries  []OperationLogEntry
+	haltStep int
+	ctx      sdk.Context
+}
+
+// run initializes the chain with the recorded genesis and executes the
+// recorded operations in order up to the halt step. The invariants are
+// asserted after every BeginBlock and EndBlock, after every message if the
+// recorded simulation asserted them on every operation, and at the halt step.
+func (rp *operationReplay) run(invariants sdk.Invariants) error {
+	app, config := rp.inputs.App, rp.header.Config
+	app.InitChain(abci.RequestInitChain{
+		AppStateBytes: rp.header.AppState,
+		ChainId:       rp.header.ChainID,
+	})
+
+	for _, entry := range rp.entries[:rp.haltStep] {
+		if err := rp.execute(entry); err != nil {
+			return fmt.Errorf("failed to replay operation %d: %v", entry.Step, err)
+		}
+
+		halted := entry.Step == int64(rp.haltStep)
+		if entry.EntryKind == BeginBlockEntryKind || entry.EntryKind == EndBlockEntryKind ||
+			config.OnOperation || halted {
+			if broken := brokenInvariants(app, invariants); len(broken) > 0 {
+				return fmt.Errorf("invariants broken after operation %d:\n%s",
+					entry.Step, strings.Join(broken, "\n"))
+			}
+		}
+
+		// the last replayed block is not committed, as in a halted simulation
+		if entry.EntryKind == EndBlockEntryKind && config.Commit && !halted {
+			app.Commit()
+		}
+	}
+	return nil
+}
+
+// execute delivers a recorded operation. Operations which failed or were
+// skipped in the recorded simulation left the state unchanged and are not
+// delivered.
+func (rp *operationReplay) execute(entry OperationLogEntry) error {
+	app := rp.inputs.App
+	switch entry.EntryKind {
+	case BeginBlockEntryKind:
+		var req abci.RequestBeginBlock
+		if err := json.Unmarshal(entry.Operation, &req); err != nil {
+			return fmt.Errorf("invalid BeginBlock request: %v", err)
+		}
+		app.BeginBlock(req)
+		rp.ctx = app.NewContext(false, req.Header)
+		return nil
+
+	case EndBlockEntryKind:
+		app.EndBlock(abci.RequestEndBlock{})
+		return nil
+
+	case MsgEntryKind, QueuedMsgEntryKind:
+		var opMsg OperationMsg
+		if err := json.Unmarshal(entry.Operation, &opMsg); err != nil {
+			return fmt.Errorf("invalid operation message: %v", err)
+		}
+		if !opMsg.OK {
+			return nil
+		}
+		if rp.ctx.MultiStore() == nil {
+			return fmt.Errorf("%s operation recorded before the first BeginBlock", entry.EntryKind)
+		}
+
+		ctx, write := rp.ctx.CacheContext()
+		if err := rp.deliver(ctx, opMsg); err != nil {
+			return err
+		}
+		write()
+		return nil
+
+	default:
+		return fmt.Errorf("unknown operation entry kind %s", entry.EntryKind)
+	}
+}
+
+// deliver executes a recorded operation message, either with the replayer
+// registered for the operation or by routing the decoded message to the
+// handler of its module
+func (rp *operationReplay) deliver(ctx sdk.Context, opMsg OperationMsg) error {
+	app := rp.inputs.App
+	if replay, ok := rp.inputs.OperationReplayers[OperationReplayerKey(opMsg.Route, opMsg.Name)]; ok {
+		return replay(app, ctx, opMsg)
+	}
+
+	var msg sdk.Msg
+	if err := rp.inputs.Codec.UnmarshalJSON(opMsg.Msg, &msg); err != nil {
+		return fmt.Errorf("failed to decode the %s/%s message: %v", opMsg.Route, opMsg.Name, err)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid %s/%s message: %s", opMsg.Route, opMsg.Name, err.Result().Log)
+	}
+
+	handler := rp.inputs.Router.Route(msg.Route())
+	if handler == nil {
+		return fmt.Errorf("no handler for the %s/%s message", opMsg.Route, opMsg.Name)
+	}
+	if res := handler(ctx, msg); !res.IsOK() {
+		return fmt.Errorf("failed to deliver the %s/%s message: %s", opMsg.Route, opMsg.Name, res.Log)
+	}
+	return nil
+}
+
+// replayOperationLog replays the entries of an operation log up to the given
+// step against a fresh application, returning its inputs along with the
+// function releasing it
+func replayOperationLog(
+	header OperationLogHeader, entries []OperationLogEntry, haltStep int,
+	newApp AppFactory, invariants func(AppInputs) sdk.Invariants,
+) (AppInputs, func(), error) {
+
+	inputs, cleanup := newApp(replayConfig(header, haltStep))
+	rp := &operationReplay{
+		inputs:   inputs,
+		header:   header,
+		entries:  entries,
+		haltStep: haltStep,
+	}
+	if err := rp.run(invariants(inputs)); err != nil {
+		cleanup()
+		return inputs, nil, err
+	}
+	return inputs, cleanup, nil
+}
+
+// replayConfig returns the config of the simulation recorded in an operation
+// log, without the outputs of the recorded run
+func replayConfig(header OperationLogHeader, haltStep int) Config {
+	config := header.Config
+	config.OperationLogPath = ""
+	config.ExportParamsPath = ""
+	config.ExportStatePath = ""
+	config.ExportStatsPath = ""
+	config.HaltStep = haltStep
+	return config
+}
+
+// ReplayOperationLog replays the simulation recorded in an operation log
+// against a fresh application, up to the given step or to the last recorded
+// operation if haltStep is zero. The chain is initialized with the recorded
+// genesis, and the recorded BeginBlock requests and messages are delivered
+// in order, without regenerating them from the seed, so that a log stays
+// replayable when the simulation code changes. The invariants are asserted
+// as in the recorded run, so that a recorded failure is reproduced.
+//
+// The recorded messages are delivered to the handlers of their modules, as
+// the simulation operations do, and the operations which failed or were
+// skipped in the recorded run are not delivered. An error is returned if a
+// recorded message fails to be delivered, e.g. because the application
+// changed since the log was recorded.
+func ReplayOperationLog(w io.Writer, path string, haltStep int, newApp AppFactory) error {
+	header, entries, err := ReadOperationLog(path)
+	if err != nil {
+		return err
+	}
+	if haltStep <= 0 || haltStep > len(entries) {
+		haltStep = len(entries)
+	}
+
+	fmt.Fprintf(w, "Replaying %d operations of the simulation with seed %d from %s\n", haltStep, header.Config.Seed, path)
+	_, cleanup, err := replayOperationLog(header, entries, haltStep, newApp,
+		func(inputs AppInputs) sdk.Invariants { return inputs.Invariants })
+	if err != nil {
+		return err
+	}
+	cleanup()
+
+	fmt.Fprintf(w, "Replayed %d operations\n", haltStep)
+	return nil
+}
+
+// BisectionReport is the minimal reproduction of an invariant failure found
+// by BisectOperationLog
+type BisectionReport struct {
+	Path   string            // operation log the failure was found in
+	Config Config            // config of the recorded simulation
+	Entry  OperationLogEntry // first operation after which an invariant is broken
+	Broken []string          // results of the broken invariants
+}
+
+// String implements the Stringer interface.
+func (r BisectionReport) String() string {
+	return fmt.Sprintf(`Invariant broken by operation %d of the simulation with seed %d
+  Block height: %d
+  Operation:    %s
+  Broken invariants:
+%s
+Replay the simulation up to this operation with the operation log %s and HaltStep %d`,
+		r.Entry.Step, r.Config.Seed, r.Entry.Height, r.Entry.MustMarshal(),
+		strings.Join(r.Broken, "\n"), r.Path, r.Entry.Step,
+	)
+}
+
+// BisectOperationLog finds the first operation of the simulation recorded in
+// an operation log after which an invariant is broken. The invariants must be
+// broken after the last recorded operation, as is the case for the log of a
+// simulation which failed an invariant assertion, and are assumed to hold at
+// genesis and to stay broken once broken. Every probe of the bisection
+// replays the recorded operations from genesis against a fresh application,
+// as ReplayOperationLog does, halting at the probed operation before checking
+// the invariants, so that only O(log n) replays are needed rather than
+// checking every operation.
+func BisectOperationLog(w io.Writer, path string, newApp AppFactory) (BisectionReport, error) {
+	header, entries, err := ReadOperationLog(path)
+	if err != nil {
+		return BisectionReport{}, err
+	}
+	if len(entries) == 0 {
+		return BisectionReport{}, fmt.Errorf("operation log %s has no operations", path)
+	}
+
+	probe := func(step int) ([]string, error) {
+		// the invariants are only checked once the replay is halted
+		inputs, cleanup, err := replayOperationLog(header, entries, step, newApp,
+			func(AppInputs) sdk.Invariants { return nil })
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+
+		broken := brokenInvariants(inputs.App, inputs.Invariants)
+		fmt.Fprintf(w, "Bisecting: operation %d, %d broken invariants\n", step, len(broken))
+		return broken, nil
+	}
+
+	broken, err := probe(len(entries))
+	if err != nil {
+		return BisectionReport{}, err
+	}
+	if len(broken) == 0 {
+		return BisectionReport{}, fmt.Errorf(
+			"all invariants hold after the %d operations recorded in %s", len(entries), path)
+	}
+
+	// invariant: the invariants hold after operation good and are broken
+	// after operation bad
+	good, bad := 0, len(entries)
+	for bad-good > 1 {
+		mid := good + (bad-good)/2
+		res, err := probe(mid)
+		if err != nil {
+			return BisectionReport{}, err
+		}
+		if len(res) > 0 {
+			bad, broken = mid, res
+		} else {
+			good = mid
+		}
+	}
+
+	return BisectionReport{
+		Path:   path,
+		Config: header.Config,
+		Entry:  entries[bad-1],
+		Broken: broken,
+	}, nil
+}
@@ -26,7 +26,7 @@ type AppStateFn func(r *rand.Rand, accs []Account, config Config) (
 func initChain(
 	r *rand.Rand, params Params, accounts []Account, app *baseapp.BaseApp,
 	appStateFn AppStateFn, config Config,
-) (mockValidators, time.Time, []Account) {
+) (mockValidators, time.Time, []Account, abci.RequestInitChain) {
 
 	appState, accounts, chainID, genesisTimestamp := appStateFn(r, accounts, config)
 
@@ -37,11 +37,14 @@ func initChain(
 	res := app.InitChain(req)
 	validators := newMockValidators(r, res.Validators, params)
 
-	return validators, genesisTimestamp, accounts
+	return validators, genesisTimestamp, accounts, req
 }
 
 // SimulateFromSeed tests an application by running the provided
 // operations, testing the provided invariants, but using the provided config.Seed.
+// If config.OperationLogPath is set, every executed operation is recorded to
+// it, and if config.HaltStep is set, the simulation stops once that many
+// operations were executed, checking the invariants a last time.
 // TODO: split this monster function up
 func SimulateFromSeed(
 	tb testing.TB, w io.Writer, app *baseapp.BaseApp,
@@ -63,7 +66,7 @@ func SimulateFromSeed(
 
 	// Second variable to keep pending validator set (delayed one block since
 	// TM 0.24) Initially this is the same as the initial validator set
-	validators, genesisTimestamp, accs := initChain(r, params, accs, app, appStateFn, config)
+	validators, genesisTimestamp, accs, initChainReq := initChain(r, params, accs, app, appStateFn, config)
 	if len(accs) == 0 {
 		return true, params, fmt.Errorf("must have greater than zero genesis accounts")
 	}
@@ -114,9 +117,21 @@ func SimulateFromSeed(
 
 	logWriter := NewLogWriter(testingMode)
 
+	// the operation log is closed on every exit, including a failed assertion
+	// or a panic, so that it can be replayed up to the failure
+	rec, err := newOperationRecorder(config, initChainReq)
+	if err != nil {
+		return true, params, err
+	}
+	defer func() {
+		if cerr := rec.close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
 	blockSimulator := createBlockSimulator(
 		testingMode, tb, t, w, params, eventStats.Tally, invariants,
-		ops, operationQueue, timeOperationQueue, logWriter, config)
+		ops, operationQueue, timeOperationQueue, logWriter, rec, config)
 
 	if !testingMode {
 		b.ResetTimer()
@@ -146,6 +161,10 @@ func SimulateFromSeed(
 		// Run the BeginBlock handler
 		logWriter.AddEntry(BeginBlockEntry(int64(height)))
 		app.BeginBlock(request)
+		rec.recordBeginBlock(int64(height), request)
+		if rec.halted() {
+			break
+		}
 
 		if testingMode {
 			assertAllInvariants(t, app, invariants, "BeginBlock", logWriter, config.AllInvariants)
@@ -156,11 +175,14 @@ func SimulateFromSeed(
 		// Run queued operations. Ignores blocksize if blocksize is too small
 		numQueuedOpsRan := runQueuedOperations(
 			operationQueue, int(header.Height),
-			tb, r, app, ctx, accs, logWriter, eventStats.Tally, config.Lean)
+			tb, r, app, ctx, accs, logWriter, rec, eventStats.Tally, config.Lean)
 
 		numQueuedTimeOpsRan := runQueuedTimeOperations(
 			timeOperationQueue, int(header.Height), header.Time,
-			tb, r, app, ctx, accs, logWriter, eventStats.Tally, config.Lean)
+			tb, r, app, ctx, accs, logWriter, rec, eventStats.Tally, config.Lean)
+		if rec.halted() {
+			break
+		}
 
 		if testingMode && config.OnOperation {
 			assertAllInvariants(t, app, invariants, "QueuedOperations", logWriter, config.AllInvariants)
@@ -169,6 +191,9 @@ func SimulateFromSeed(
 		// run standard operations
 		operations := blockSimulator(r, app, ctx, accs, header)
 		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
+		if rec.halted() {
+			break
+		}
 		if testingMode {
 			assertAllInvariants(t, app, invariants, "StandardOperations", logWriter, config.AllInvariants)
 		}
@@ -181,6 +206,10 @@ func SimulateFromSeed(
 			time.Duration(int64(r.Intn(int(timeDiff)))) * time.Second)
 		header.ProposerAddress = validators.randomProposer(r)
 		logWriter.AddEntry(EndBlockEntry(int64(height)))
+		rec.record(EndBlockEntry(int64(height)))
+		if rec.halted() {
+			break
+		}
 
 		if testingMode {
 			assertAllInvariants(t, app, invariants, "EndBlock", logWriter, config.AllInvariants)
@@ -212,6 +241,14 @@ func SimulateFromSeed(
 		}
 	}
 
+	if rec.halted() {
+		stopEarly = true
+		fmt.Fprintf(w, "\nSimulation halted after operation %d, on block %d\n", rec.step, header.Height)
+		if testingMode {
+			assertAllInvariants(t, app, invariants, fmt.Sprintf("operation %d", rec.step), logWriter, config.AllInvariants)
+		}
+	}
+
 	if stopEarly {
 		if config.ExportStatsPath != "" {
 			fmt.Println("Exporting simulation statistics...")
@@ -249,7 +286,7 @@ type blockSimFn func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, w io.Writer, params Params,
 	event func(route, op, evResult string), invariants sdk.Invariants, ops WeightedOperations,
 	operationQueue OperationQueue, timeOperationQueue []FutureOperation,
-	logWriter LogWriter, config Config) blockSimFn {
+	logWriter LogWriter, rec *operationRecorder, config Config) blockSimFn {
 
 	lastBlockSizeState := 0 // state for [4 * uniform distribution]
 	blocksize := 0
@@ -290,6 +327,7 @@ func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, w io.Wr
 			if !config.Lean || opMsg.OK {
 				logWriter.AddEntry(MsgEntry(header.Height, int64(i), opMsg))
 			}
+			rec.record(MsgEntry(header.Height, int64(i), opMsg))
 			if err != nil {
 				logWriter.PrintLogs()
 				tb.Fatalf("error on operation %d within block %d, %v",
@@ -309,6 +347,9 @@ func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, w io.Wr
 				}
 			}
 			opCount++
+			if rec.halted() {
+				break
+			}
 		}
 		return opCount
 	}
@@ -317,15 +358,15 @@ func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, w io.Wr
 // nolint: errcheck
 func runQueuedOperations(queueOps map[int][]Operation,
 	height int, tb testing.TB, r *rand.Rand, app *baseapp.BaseApp,
-	ctx sdk.Context, accounts []Account, logWriter LogWriter, event func(route, op, evResult string), lean bool) (numOpsRan int) {
+	ctx sdk.Context, accounts []Account, logWriter LogWriter, rec *operationRecorder,
+	event func(route, op, evResult string), lean bool) (numOpsRan int) {
 
 	queuedOp, ok := queueOps[height]
 	if !ok {
 		return 0
 	}
 
-	numOpsRan = len(queuedOp)
-	for i := 0; i < numOpsRan; i++ {
+	for i := 0; i < len(queuedOp) && !rec.halted(); i++ {
 
 		// For now, queued operations cannot queue more operations.
 		// If a need arises for us to support queued messages to queue more messages, this can
@@ -335,10 +376,12 @@ func runQueuedOperations(queueOps map[int][]Operation,
 		if !lean || opMsg.OK {
 			logWriter.AddEntry((QueuedMsgEntry(int64(height), opMsg)))
 		}
+		rec.record(QueuedMsgEntry(int64(height), opMsg))
 		if err != nil {
 			logWriter.PrintLogs()
 			tb.FailNow()
 		}
+		numOpsRan++
 	}
 	delete(queueOps, height)
 	return numOpsRan
@@ -346,11 +389,11 @@ func runQueuedOperations(queueOps map[int][]Operation,
 
 func runQueuedTimeOperations(queueOps []FutureOperation,
 	height int, currentTime time.Time, tb testing.TB, r *rand.Rand,
-	app *baseapp.BaseApp, ctx sdk.Context, accounts []Account,
-	logWriter LogWriter, event func(route, op, evResult string), lean bool) (numOpsRan int) {
+	app *baseapp.BaseApp, ctx sdk.Context, accounts []Account, logWriter LogWriter,
+	rec *operationRecorder, event func(route, op, evResult string), lean bool) (numOpsRan int) {
 
 	numOpsRan = 0
-	for len(queueOps) > 0 && currentTime.After(queueOps[0].BlockTime) {
+	for len(queueOps) > 0 && currentTime.After(queueOps[0].BlockTime) && !rec.halted() {
 
 		// For now, queued operations cannot queue more operations.
 		// If a need arises for us to support queued messages to queue more messages, this can
@@ -360,6 +403,7 @@ func runQueuedTimeOperations(queueOps []FutureOperation,
 		if !lean || opMsg.OK {
 			logWriter.AddEntry(QueuedMsgEntry(int64(height), opMsg))
 		}
+		rec.record(QueuedMsgEntry(int64(height), opMsg))
 		if err != nil {
 			logWriter.PrintLogs()
 			tb.FailNow()
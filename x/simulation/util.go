@@ -16,6 +16,20 @@ import (
 func assertAllInvariants(t *testing.T, app *baseapp.BaseApp, invs sdk.Invariants,
 	event string, logWriter LogWriter, allInvariants bool) {
 
+	invariantResults := brokenInvariants(app, invs)
+	if len(invariantResults) > 0 {
+		fmt.Printf("Invariants broken after %s\n\n", event)
+		for _, res := range invariantResults {
+			fmt.Printf("%s\n", res)
+		}
+		logWriter.PrintLogs()
+		t.Fatal()
+	}
+}
+
+// brokenInvariants returns the results of the invariants broken by the
+// application state
+func brokenInvariants(app *baseapp.BaseApp, invs sdk.Invariants) []string {
 	ctx := app.NewContext(false, abci.Header{Height: app.LastBlockHeight() + 1})
 
 	var invariantResults []string
@@ -25,15 +39,7 @@ func assertAllInvariants(t *testing.T, app *baseapp.BaseApp, invs sdk.Invariants
 			invariantResults = append(invariantResults, res)
 		}
 	}
-
-	if len(invariantResults) > 0 {
-		fmt.Printf("Invariants broken after %s\n\n", event)
-		for _, res := range invariantResults {
-			fmt.Printf("%s\n", res)
-		}
-		logWriter.PrintLogs()
-		t.Fatal()
-	}
+	return invariantResults
 }
 
 func getTestingMode(tb testing.TB) (testingMode bool, t *testing.T, b *testing.B) {
@@ -11,24 +11,30 @@ import (
 )
 
 const (
-	DefaultCodespace            = types.DefaultCodespace
-	CodeInvalidValidator        = types.CodeInvalidValidator
-	CodeValidatorJailed         = types.CodeValidatorJailed
-	CodeValidatorNotJailed      = types.CodeValidatorNotJailed
-	CodeMissingSelfDelegation   = types.CodeMissingSelfDelegation
-	CodeSelfDelegationTooLow    = types.CodeSelfDelegationTooLow
-	CodeMissingSigningInfo      = types.CodeMissingSigningInfo
-	ModuleName                  = types.ModuleName
-	StoreKey                    = types.StoreKey
-	RouterKey                   = types.RouterKey
-	QuerierRoute                = types.QuerierRoute
-	DefaultParamspace           = types.DefaultParamspace
-	DefaultMaxEvidenceAge       = types.DefaultMaxEvidenceAge
-	DefaultSignedBlocksWindow   = types.DefaultSignedBlocksWindow
-	DefaultDowntimeJailDuration = types.DefaultDowntimeJailDuration
-	QueryParameters             = types.QueryParameters
-	QuerySigningInfo            = types.QuerySigningInfo
-	QuerySigningInfos           = types.QuerySigningInfos
+	DefaultCodespace              = types.DefaultCodespace
+	CodeInvalidValidator          = types.CodeInvalidValidator
+	CodeValidatorJailed           = types.CodeValidatorJailed
+	CodeValidatorNotJailed        = types.CodeValidatorNotJailed
+	CodeMissingSelfDelegation     = types.CodeMissingSelfDelegation
+	CodeSelfDelegationTooLow      = types.CodeSelfDelegationTooLow
+	CodeMissingSigningInfo        = types.CodeMissingSigningInfo
+	ModuleName                    = types.ModuleName
+	StoreKey                      = types.StoreKey
+	RouterKey                     = types.RouterKey
+	QuerierRoute                  = types.QuerierRoute
+	DefaultParamspace             = types.DefaultParamspace
+	DefaultMaxEvidenceAge         = types.DefaultMaxEvidenceAge
+	DefaultSignedBlocksWindow     = types.DefaultSignedBlocksWindow
+	DefaultDowntimeJailDuration   = types.DefaultDowntimeJailDuration
+	DefaultDowntimePenaltyWindow  = types.DefaultDowntimePenaltyWindow
+	DefaultDowntimePenaltyMaxTier = types.DefaultDowntimePenaltyMaxTier
+	QueryParameters               = types.QueryParameters
+	QuerySigningInfo              = types.QuerySigningInfo
+	QuerySigningInfos             = types.QuerySigningInfos
+	QueryDowntimePenalty          = types.QueryDowntimePenalty
+	QueryMissedBlocks             = types.QueryMissedBlocks
+	QueryUptime                   = types.QueryUptime
+	QueryUptimes                  = types.QueryUptimes
 )
 
 var (
@@ -59,22 +65,30 @@ var (
 	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
+	NewDowntimePenalty                       = types.NewDowntimePenalty
+	NewValidatorMissedBlocks                 = types.NewValidatorMissedBlocks
+	NewValidatorUptime                       = types.NewValidatorUptime
 
 	// variable aliases
-	ModuleCdc                       = types.ModuleCdc
-	ValidatorSigningInfoKey         = types.ValidatorSigningInfoKey
-	ValidatorMissedBlockBitArrayKey = types.ValidatorMissedBlockBitArrayKey
-	AddrPubkeyRelationKey           = types.AddrPubkeyRelationKey
-	DoubleSignJailEndTime           = types.DoubleSignJailEndTime
-	DefaultMinSignedPerWindow       = types.DefaultMinSignedPerWindow
-	DefaultSlashFractionDoubleSign  = types.DefaultSlashFractionDoubleSign
-	DefaultSlashFractionDowntime    = types.DefaultSlashFractionDowntime
-	KeyMaxEvidenceAge               = types.KeyMaxEvidenceAge
-	KeySignedBlocksWindow           = types.KeySignedBlocksWindow
-	KeyMinSignedPerWindow           = types.KeyMinSignedPerWindow
-	KeyDowntimeJailDuration         = types.KeyDowntimeJailDuration
-	KeySlashFractionDoubleSign      = types.KeySlashFractionDoubleSign
-	KeySlashFractionDowntime        = types.KeySlashFractionDowntime
+	ModuleCdc                        = types.ModuleCdc
+	ValidatorSigningInfoKey          = types.ValidatorSigningInfoKey
+	ValidatorMissedBlockBitArrayKey  = types.ValidatorMissedBlockBitArrayKey
+	AddrPubkeyRelationKey            = types.AddrPubkeyRelationKey
+	DoubleSignJailEndTime            = types.DoubleSignJailEndTime
+	DefaultMinSignedPerWindow        = types.DefaultMinSignedPerWindow
+	DefaultSlashFractionDoubleSign   = types.DefaultSlashFractionDoubleSign
+	DefaultSlashFractionDowntime     = types.DefaultSlashFractionDowntime
+	KeyMaxEvidenceAge                = types.KeyMaxEvidenceAge
+	KeySignedBlocksWindow            = types.KeySignedBlocksWindow
+	KeyMinSignedPerWindow            = types.KeyMinSignedPerWindow
+	KeyDowntimeJailDuration          = types.KeyDowntimeJailDuration
+	KeySlashFractionDoubleSign       = types.KeySlashFractionDoubleSign
+	KeySlashFractionDowntime         = types.KeySlashFractionDowntime
+	DefaultDowntimePenaltyMultiplier = types.DefaultDowntimePenaltyMultiplier
+	MaxDowntimePenaltyMultiplier     = types.MaxDowntimePenaltyMultiplier
+	KeyDowntimePenaltyWindow         = types.KeyDowntimePenaltyWindow
+	KeyDowntimePenaltyMultiplier     = types.KeyDowntimePenaltyMultiplier
+	KeyDowntimePenaltyMaxTier        = types.KeyDowntimePenaltyMaxTier
 )
 
 type (
@@ -88,4 +102,8 @@ type (
 	QuerySigningInfoParams  = types.QuerySigningInfoParams
 	QuerySigningInfosParams = types.QuerySigningInfosParams
 	ValidatorSigningInfo    = types.ValidatorSigningInfo
+	DowntimePenalty         = types.DowntimePenalty
+	ValidatorMissedBlocks   = types.ValidatorMissedBlocks
+	ValidatorUptime         = types.ValidatorUptime
+	ValidatorUptimes        = types.ValidatorUptimes
 )
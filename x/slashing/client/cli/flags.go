@@ -3,4 +3,6 @@ package cli
 // nolint
 const (
 	FlagAddressValidator = "validator"
+	FlagPage             = "page"
+	FlagLimit            = "limit"
 )
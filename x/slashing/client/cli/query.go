@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -28,6 +29,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	slashingQueryCmd.AddCommand(
 		client.GetCommands(
 			GetCmdQuerySigningInfo(queryRoute, cdc),
+			GetCmdQueryDowntimePenalty(cdc),
+			GetCmdQueryMissedBlocks(cdc),
+			GetCmdQueryUptime(cdc),
+			GetCmdQueryUptimes(cdc),
 			GetCmdQueryParams(cdc),
 		)...,
 	)
@@ -73,6 +78,159 @@ $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdge
 	}
 }
 
+// GetCmdQueryDowntimePenalty implements the command to query the penalty tier
+// of a validator.
+func GetCmdQueryDowntimePenalty(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "downtime-penalty [validator-conspub]",
+		Short: "Query the penalty applied to a validator's next downtime",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the penalty tier of that validator,
+and the slash fraction and jail duration applied to its next downtime:
+
+$ <appcli> query slashing downtime-penalty cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address())))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDowntimePenalty)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var penalty types.DowntimePenalty
+			cdc.MustUnmarshalJSON(res, &penalty)
+			return cliCtx.PrintOutput(penalty)
+		},
+	}
+}
+
+// GetCmdQueryMissedBlocks implements the command to query the missed blocks
+// bitmap of a validator.
+func GetCmdQueryMissedBlocks(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "missed-blocks [validator-conspub]",
+		Short: "Query a validator's missed blocks over the signed blocks window",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find which blocks of the current signed
+blocks window that validator missed, oldest first. Missed blocks are printed as 'x' and signed
+blocks as '.':
+
+$ <appcli> query slashing missed-blocks cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address())))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMissedBlocks)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var missedBlocks types.ValidatorMissedBlocks
+			cdc.MustUnmarshalJSON(res, &missedBlocks)
+			return cliCtx.PrintOutput(missedBlocks)
+		},
+	}
+}
+
+// GetCmdQueryUptime implements the command to query the uptime of a validator.
+func GetCmdQueryUptime(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "uptime [validator-conspub]",
+		Short: "Query a validator's uptime and projected blocks until jail",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the uptime of that validator over
+the current signed blocks window, and the minimum number of blocks it must miss to be jailed:
+
+$ <appcli> query slashing uptime cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address())))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUptime)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var uptime types.ValidatorUptime
+			cdc.MustUnmarshalJSON(res, &uptime)
+			return cliCtx.PrintOutput(uptime)
+		},
+	}
+}
+
+// GetCmdQueryUptimes implements the command to query the uptimes of the bonded
+// validators.
+func GetCmdQueryUptimes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uptimes",
+		Short: "Query the bonded validators' uptimes, lowest first",
+		Long: strings.TrimSpace(`Query the uptime of the bonded validators over the current signed blocks window,
+lowest uptime first, and the minimum number of blocks each must miss to be jailed:
+
+$ <appcli> query slashing uptimes --limit 10
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQuerySigningInfosParams(viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUptimes)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var uptimes types.ValidatorUptimes
+			cdc.MustUnmarshalJSON(res, &uptimes)
+			return cliCtx.PrintOutput(uptimes)
+		},
+	}
+
+	cmd.Flags().Int(FlagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(FlagLimit, 0, "Query number of results per page returned, defaults to the maximum number of validators")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
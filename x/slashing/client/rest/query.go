@@ -18,6 +18,26 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		signingInfoHandlerFn(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/slashing/validators/{validatorPubKey}/downtime_penalty",
+		validatorQueryHandlerFn(cliCtx, types.QueryDowntimePenalty),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/validators/{validatorPubKey}/missed_blocks",
+		validatorQueryHandlerFn(cliCtx, types.QueryMissedBlocks),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/validators/{validatorPubKey}/uptime",
+		validatorQueryHandlerFn(cliCtx, types.QueryUptime),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/uptimes",
+		uptimesHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/slashing/signing_infos",
 		signingInfoHandlerListFn(cliCtx),
@@ -64,6 +84,42 @@ func signingInfoHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// http request handler to query the slashing state of a validator, such as its
+// downtime penalty or uptime
+func validatorQueryHandlerFn(cliCtx context.CLIContext, query string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		pk, err := sdk.GetConsPubKeyBech32(vars["validatorPubKey"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address()))
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // http request handler to query signing info
 func signingInfoHandlerListFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -97,6 +153,39 @@ func signingInfoHandlerListFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// http request handler to query the uptimes of the bonded validators
+func uptimesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQuerySigningInfosParams(page, limit)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUptimes)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -21,6 +21,7 @@ func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _
 			time.Unix(0, 0),
 			false,
 			0,
+			0,
 		)
 		k.SetValidatorSigningInfo(ctx, address, signingInfo)
 	}
@@ -37,6 +38,28 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
 }
 
+// When a validator rotates its consensus pubkey, add the address-pubkey
+// relation of the new key and carry the signing info over to the new address.
+// The relation and signing info of the old key are kept, as evidence and the
+// last votes signed with it may still be submitted.
+func (k Keeper) AfterValidatorConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	validator := k.sk.Validator(ctx, valAddr)
+	k.AddPubkey(ctx, validator.GetConsPubKey())
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return
+	}
+	signingInfo.Address = newConsAddr
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+
+	k.clearValidatorMissedBlockBitArray(ctx, newConsAddr)
+	k.IterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) (stop bool) {
+		k.SetValidatorMissedBlockBitArray(ctx, newConsAddr, index, missed)
+		return false
+	})
+}
+
 //_________________________________________________________________________________________
 
 // Hooks wrapper struct for slashing keeper
@@ -66,6 +89,11 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterValidatorConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.AfterValidatorConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+}
+
 // nolint - unused hooks
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
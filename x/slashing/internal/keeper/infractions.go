@@ -51,10 +51,13 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		return
 	}
 
-	// fetch the validator signing info
-	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	// fetch the validator signing info. The evidence may refer to a consensus
+	// key the validator has since rotated out, in which case the signing info of
+	// its current key is tombstoned.
+	signingAddr := validator.GetConsAddr()
+	signInfo, found := k.GetValidatorSigningInfo(ctx, signingAddr)
 	if !found {
-		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", signingAddr))
 	}
 
 	// validator is already tombstoned
@@ -109,7 +112,7 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	signInfo.JailedUntil = types.DoubleSignJailEndTime
 
 	// Set validator signing info
-	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	k.SetValidatorSigningInfo(ctx, signingAddr, signInfo)
 }
 
 // HandleValidatorSignature handles a validator signature, must be called once per validator per block.
@@ -186,6 +189,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
+			// the penalty escalates with the recent downtime jailings of the validator
+			penalty := k.GetDowntimePenalty(ctx, signInfo)
+
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -193,12 +199,25 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyPenaltyTier, fmt.Sprintf("%d", penalty.Tier)),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, penalty.SlashFraction)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.DowntimeJailCount = k.downtimeJailCount(ctx, signInfo) + 1
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(penalty.JailDuration)
+
+			// the validator may have rotated its consensus key since signing
+			// with this one, in which case unjailing is governed by the
+			// signing info of its current key
+			if currAddr := validator.GetConsAddr(); !currAddr.Equals(consAddr) {
+				if currInfo, found := k.GetValidatorSigningInfo(ctx, currAddr); found {
+					currInfo.JailedUntil = signInfo.JailedUntil
+					currInfo.DowntimeJailCount = signInfo.DowntimeJailCount
+					k.SetValidatorSigningInfo(ctx, currAddr, currInfo)
+				}
+			}
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -310,3 +310,163 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test that evidence against a consensus key the validator has rotated out is
+// still slashed, and that the signing info carries over to the new key
+func TestHandleDoubleSignRotatedKey(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := CreateTestInput(t, TestParams())
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	operatorAddr, oldPk, newPk := Addrs[0], Pks[0], Pks[1]
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, oldPk, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// handle a signature to set signing info
+	keeper.HandleValidatorSignature(ctx, oldPk.Address(), amt.Int64(), false)
+
+	got = staking.NewHandler(sk)(ctx, staking.NewMsgRotateConsPubKey(operatorAddr, newPk))
+	require.True(t, got.IsOK(), "%v", got)
+
+	// the signing info and missed blocks are carried over to the new key
+	oldInfo, found := keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.True(t, found)
+	newInfo, found := keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(newPk.Address()))
+	require.True(t, found)
+	require.Equal(t, sdk.ConsAddress(newPk.Address()), newInfo.Address)
+	require.Equal(t, oldInfo.MissedBlocksCounter, newInfo.MissedBlocksCounter)
+	require.Equal(t, oldInfo.IndexOffset, newInfo.IndexOffset)
+	require.True(t, keeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(newPk.Address()), 0))
+
+	_, err := keeper.GetPubkey(ctx, newPk.Address())
+	require.NoError(t, err)
+
+	// tendermint replaces the old key by the new one
+	updates := staking.EndBlocker(ctx, sk)
+	require.Equal(t, 2, len(updates))
+
+	// the new key signs blocks
+	ctx = ctx.WithBlockHeight(1)
+	keeper.HandleValidatorSignature(ctx, newPk.Address(), amt.Int64(), true)
+
+	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+
+	// double sign with the old key
+	keeper.HandleDoubleSign(ctx, oldPk.Address(), 0, time.Unix(0, 0), power)
+
+	// should be jailed and slashed
+	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
+	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+
+	// the current key is tombstoned
+	newInfo, found = keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(newPk.Address()))
+	require.True(t, found)
+	require.True(t, newInfo.Tombstoned)
+
+	// Jump to past the unbonding period
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(sk.GetParams(ctx).UnbondingTime)})
+
+	// Still shouldn't be able to unjail
+	require.Error(t, keeper.Unjail(ctx, operatorAddr))
+}
+
+// Test that successive downtime jailings escalate the penalty of a validator
+func TestHandleDowntimeEscalation(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := CreateTestInput(t, types.DefaultParams())
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	addr, val := Addrs[0], Pks[0]
+	consAddr := sdk.ConsAddress(addr)
+	sh := staking.NewHandler(sk)
+	got := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// 100 first blocks OK
+	height := int64(0)
+	for ; height < keeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	missBlocks := func() {
+		latest := height
+		for ; height < latest+keeper.SignedBlocksWindow(ctx)-keeper.MinSignedPerWindow(ctx)+1; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+		staking.EndBlocker(ctx, sk)
+	}
+
+	// first downtime: base penalty
+	missBlocks()
+	validator, _ := sk.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, amt.Sub(sdk.TokensFromConsensusPower(1)), validator.GetTokens())
+
+	signInfo, _ := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(1), signInfo.DowntimeJailCount)
+	require.Equal(t, ctx.BlockHeader().Time.Add(keeper.DowntimeJailDuration(ctx)), signInfo.JailedUntil)
+
+	penalty := keeper.GetDowntimePenalty(ctx, signInfo)
+	require.Equal(t, int64(1), penalty.Tier)
+	require.Equal(t, keeper.SlashFractionDowntime(ctx).MulInt64(2), penalty.SlashFraction)
+
+	// second downtime within the penalty window: doubled penalty
+	ctx = ctx.WithBlockHeader(abci.Header{Height: height, Time: signInfo.JailedUntil})
+	sk.Unjail(ctx, consAddr)
+	staking.EndBlocker(ctx, sk)
+	missBlocks()
+
+	validator, _ = sk.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, amt.Sub(sdk.TokensFromConsensusPower(3)), validator.GetTokens())
+
+	signInfo, _ = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(2), signInfo.DowntimeJailCount)
+	require.Equal(t, ctx.BlockHeader().Time.Add(2*keeper.DowntimeJailDuration(ctx)), signInfo.JailedUntil)
+}
+
+func TestGetDowntimePenalty(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, types.DefaultParams())
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	consAddr := sdk.ConsAddress(Addrs[0])
+	baseFraction := keeper.SlashFractionDowntime(ctx)
+	baseDuration := keeper.DowntimeJailDuration(ctx)
+
+	tests := []struct {
+		name          string
+		jailedUntil   time.Time
+		jailCount     int64
+		expectedTier  int64
+		expectedRatio int64
+	}{
+		{"never jailed", time.Unix(0, 0), 0, 0, 1},
+		{"jailed once", now, 1, 1, 2},
+		{"jailed twice", now.Add(-time.Hour), 2, 2, 4},
+		{"tier capped", now, 5, 3, 8},
+		{"window elapsed", now.Add(-keeper.DowntimePenaltyWindow(ctx) - time.Second), 3, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := types.NewValidatorSigningInfo(consAddr, 0, 0, tt.jailedUntil, false, 0, tt.jailCount)
+			penalty := keeper.GetDowntimePenalty(ctx, info)
+			require.Equal(t, tt.expectedTier, penalty.Tier)
+			require.Equal(t, baseFraction.MulInt64(tt.expectedRatio), penalty.SlashFraction)
+			require.Equal(t, baseDuration*time.Duration(tt.expectedRatio), penalty.JailDuration)
+		})
+	}
+
+	// the slash fraction is capped to 1
+	params := keeper.GetParams(ctx)
+	params.SlashFractionDowntime = sdk.NewDecWithPrec(3, 1)
+	keeper.SetParams(ctx, params)
+	penalty := keeper.GetDowntimePenalty(ctx, types.NewValidatorSigningInfo(consAddr, 0, 0, now, false, 0, 3))
+	require.Equal(t, sdk.OneDec(), penalty.SlashFraction)
+}
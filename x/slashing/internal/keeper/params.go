@@ -48,6 +48,25 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimePenaltyWindow - window after the end of a downtime jailing within
+// which a new downtime raises the penalty tier
+func (k Keeper) DowntimePenaltyWindow(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimePenaltyWindow, &res)
+	return
+}
+
+// DowntimePenaltyMultiplier - factor applied to the downtime penalty per tier
+func (k Keeper) DowntimePenaltyMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimePenaltyMultiplier, &res)
+	return
+}
+
+// DowntimePenaltyMaxTier - highest downtime penalty tier
+func (k Keeper) DowntimePenaltyMaxTier(ctx sdk.Context) (res int64) {
+	k.paramspace.Get(ctx, types.KeyDowntimePenaltyMaxTier, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+)
+
+// downtimeJailCount returns the number of successive downtime jailings of a
+// validator at the current block time. The count is reset once the validator
+// went through a whole penalty window after the end of its last jailing.
+func (k Keeper) downtimeJailCount(ctx sdk.Context, info types.ValidatorSigningInfo) int64 {
+	windowEnd := info.JailedUntil.Add(k.DowntimePenaltyWindow(ctx))
+	if ctx.BlockHeader().Time.After(windowEnd) {
+		return 0
+	}
+	return info.DowntimeJailCount
+}
+
+// GetDowntimePenalty returns the penalty applied to the next downtime of the
+// validator with the given signing info. At tier n, the downtime slash fraction
+// and jail duration are multiplied by DowntimePenaltyMultiplier^n, the slash
+// fraction being capped to 1.
+func (k Keeper) GetDowntimePenalty(ctx sdk.Context, info types.ValidatorSigningInfo) types.DowntimePenalty {
+	tier := k.downtimeJailCount(ctx, info)
+	if maxTier := k.DowntimePenaltyMaxTier(ctx); tier > maxTier {
+		tier = maxTier
+	}
+
+	// stop escalating once the jail duration is maxed out, which also bounds
+	// the factor as the jail duration is positive
+	baseDuration := sdk.NewDec(int64(k.DowntimeJailDuration(ctx)))
+	maxDuration := sdk.NewDec(math.MaxInt64)
+	multiplier := k.DowntimePenaltyMultiplier(ctx)
+	factor := sdk.OneDec()
+	for i := int64(0); i < tier && baseDuration.Mul(factor).LT(maxDuration); i++ {
+		factor = factor.Mul(multiplier)
+	}
+
+	slashFraction := k.SlashFractionDowntime(ctx).Mul(factor)
+	if slashFraction.GT(sdk.OneDec()) {
+		slashFraction = sdk.OneDec()
+	}
+
+	jailDuration := time.Duration(math.MaxInt64)
+	if d := baseDuration.Mul(factor); d.LT(maxDuration) {
+		jailDuration = time.Duration(d.TruncateInt64())
+	}
+
+	return types.NewDowntimePenalty(info.Address, tier, slashFraction, jailDuration)
+}
@@ -21,6 +21,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return querySigningInfo(ctx, req, k)
 		case types.QuerySigningInfos:
 			return querySigningInfos(ctx, req, k)
+		case types.QueryDowntimePenalty:
+			return queryDowntimePenalty(ctx, req, k)
+		case types.QueryMissedBlocks:
+			return queryMissedBlocks(ctx, req, k)
+		case types.QueryUptime:
+			return queryUptime(ctx, req, k)
+		case types.QueryUptimes:
+			return queryUptimes(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -88,3 +96,91 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 
 	return res, nil
 }
+
+func queryDowntimePenalty(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySigningInfoParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, params.ConsAddress)
+	if !found {
+		return nil, types.ErrNoSigningInfoFound(types.DefaultCodespace, params.ConsAddress)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetDowntimePenalty(ctx, signingInfo))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryMissedBlocks(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySigningInfoParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, params.ConsAddress)
+	if !found {
+		return nil, types.ErrNoSigningInfoFound(types.DefaultCodespace, params.ConsAddress)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetValidatorMissedBlocks(ctx, signingInfo))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryUptime(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySigningInfoParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, params.ConsAddress)
+	if !found {
+		return nil, types.ErrNoSigningInfoFound(types.DefaultCodespace, params.ConsAddress)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetValidatorUptime(ctx, signingInfo))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryUptimes(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySigningInfosParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	uptimes := k.GetBondedValidatorUptimes(ctx)
+
+	start, end := client.Paginate(len(uptimes), params.Page, params.Limit, int(k.sk.MaxValidators(ctx)))
+	if start < 0 || end < 0 {
+		uptimes = types.ValidatorUptimes{}
+	} else {
+		uptimes = uptimes[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, uptimes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
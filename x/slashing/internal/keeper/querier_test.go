@@ -7,6 +7,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
 
@@ -36,3 +37,68 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, keeper.GetParams(ctx), params)
 }
+
+func TestQueryDowntimePenalty(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, TestParams())
+	consAddr := sdk.ConsAddress(Addrs[0])
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySigningInfoParams(consAddr)),
+	}
+
+	_, err := queryDowntimePenalty(ctx, query, keeper)
+	require.Error(t, err)
+
+	info := types.NewValidatorSigningInfo(consAddr, 0, 0, ctx.BlockHeader().Time, false, 0, 1)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	res, err := queryDowntimePenalty(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var penalty types.DowntimePenalty
+	types.ModuleCdc.MustUnmarshalJSON(res, &penalty)
+	require.Equal(t, int64(1), penalty.Tier)
+	require.Equal(t, 2*keeper.DowntimeJailDuration(ctx), penalty.JailDuration)
+}
+
+func TestQueryUptimes(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, TestParams())
+	consAddr := sdk.ConsAddress(Addrs[0])
+	querier := NewQuerier(keeper)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySigningInfoParams(consAddr)),
+	}
+
+	_, err := querier(ctx, []string{types.QueryMissedBlocks}, query)
+	require.Error(t, err)
+
+	info := types.NewValidatorSigningInfo(consAddr, 0, 3, ctx.BlockHeader().Time, false, 1, 0)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+
+	res, err := querier(ctx, []string{types.QueryMissedBlocks}, query)
+	require.NoError(t, err)
+
+	var missedBlocks types.ValidatorMissedBlocks
+	types.ModuleCdc.MustUnmarshalJSON(res, &missedBlocks)
+	require.Equal(t, []bool{false, true, false}, missedBlocks.MissedBlocks)
+
+	res, err = querier(ctx, []string{types.QueryUptime}, query)
+	require.NoError(t, err)
+
+	var uptime types.ValidatorUptime
+	types.ModuleCdc.MustUnmarshalJSON(res, &uptime)
+	require.Equal(t, int64(1), uptime.MissedBlocks)
+
+	// the validator is not bonded
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQuerySigningInfosParams(1, 0))
+	res, err = querier(ctx, []string{types.QueryUptimes}, query)
+	require.NoError(t, err)
+
+	var uptimes types.ValidatorUptimes
+	types.ModuleCdc.MustUnmarshalJSON(res, &uptimes)
+	require.Empty(t, uptimes)
+}
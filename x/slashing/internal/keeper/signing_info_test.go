@@ -21,6 +21,7 @@ func TestGetSetValidatorSigningInfo(t *testing.T) {
 		time.Unix(2, 0),
 		false,
 		int64(10),
+		int64(1),
 	)
 	keeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(Addrs[0]), newInfo)
 	info, found = keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(Addrs[0]))
@@ -29,6 +30,7 @@ func TestGetSetValidatorSigningInfo(t *testing.T) {
 	require.Equal(t, info.IndexOffset, int64(3))
 	require.Equal(t, info.JailedUntil, time.Unix(2, 0).UTC())
 	require.Equal(t, info.MissedBlocksCounter, int64(10))
+	require.Equal(t, info.DowntimeJailCount, int64(1))
 }
 
 func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
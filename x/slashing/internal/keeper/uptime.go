@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// windowBlocks returns the number of blocks of the current signed blocks
+// window the validator should have signed
+func windowBlocks(info types.ValidatorSigningInfo, window int64) int64 {
+	if info.IndexOffset < window {
+		return info.IndexOffset
+	}
+	return window
+}
+
+// GetValidatorMissedBlocks returns whether the validator missed each block of
+// the current signed blocks window, oldest first. Only the blocks the
+// validator should have signed since it started validating or was last jailed
+// are returned.
+func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, info types.ValidatorSigningInfo) types.ValidatorMissedBlocks {
+	window := k.SignedBlocksWindow(ctx)
+	n := windowBlocks(info, window)
+
+	// the oldest block is at the next index to be written once the window is full
+	start := int64(0)
+	if info.IndexOffset >= window {
+		start = info.IndexOffset % window
+	}
+
+	missedBlocks := make([]bool, n)
+	for i := int64(0); i < n; i++ {
+		missedBlocks[i] = k.GetValidatorMissedBlockBitArray(ctx, info.Address, (start+i)%window)
+	}
+
+	return types.NewValidatorMissedBlocks(info.Address, window, missedBlocks)
+}
+
+// GetValidatorUptime returns the liveness of the validator over the current
+// signed blocks window. The projected blocks until jail is a lower bound, as
+// missing a block already missed in the previous window does not increase the
+// missed blocks counter.
+func (k Keeper) GetValidatorUptime(ctx sdk.Context, info types.ValidatorSigningInfo) types.ValidatorUptime {
+	window := k.SignedBlocksWindow(ctx)
+	n := windowBlocks(info, window)
+
+	uptime := sdk.OneDec()
+	if n > 0 {
+		uptime = sdk.NewDec(n - info.MissedBlocksCounter).QuoInt64(n)
+	}
+
+	// a validator is jailed once past the minimum height with more missed
+	// blocks than allowed, see HandleValidatorSignature
+	maxMissed := window - k.MinSignedPerWindow(ctx)
+	untilJail := maxMissed - info.MissedBlocksCounter + 1
+	if untilHeight := info.StartHeight + window - ctx.BlockHeight() + 1; untilHeight > untilJail {
+		untilJail = untilHeight
+	}
+	if untilJail < 1 {
+		untilJail = 1
+	}
+
+	return types.NewValidatorUptime(info.Address, n, info.MissedBlocksCounter, uptime, untilJail)
+}
+
+// GetBondedValidatorUptimes returns the liveness of the bonded validators,
+// lowest uptime first
+func (k Keeper) GetBondedValidatorUptimes(ctx sdk.Context) types.ValidatorUptimes {
+	uptimes := types.ValidatorUptimes{}
+	k.sk.IterateValidators(ctx, func(_ int64, validator stakingexported.ValidatorI) (stop bool) {
+		if !validator.IsBonded() {
+			return false
+		}
+
+		info, found := k.GetValidatorSigningInfo(ctx, validator.GetConsAddr())
+		if found {
+			uptimes = append(uptimes, k.GetValidatorUptime(ctx, info))
+		}
+		return false
+	})
+
+	sort.SliceStable(uptimes, func(i, j int) bool {
+		if !uptimes[i].Uptime.Equal(uptimes[j].Uptime) {
+			return uptimes[i].Uptime.LT(uptimes[j].Uptime)
+		}
+		return bytes.Compare(uptimes[i].Address, uptimes[j].Address) < 0
+	})
+
+	return uptimes
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestValidatorUptime(t *testing.T) {
+	// SignedBlocksWindow is 100 and MinSignedPerWindow 50
+	ctx, _, sk, _, keeper := CreateTestInput(t, types.DefaultParams())
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	sh := staking.NewHandler(sk)
+	for i := 0; i < 2; i++ {
+		got := sh(ctx, NewTestMsgCreateValidator(Addrs[i], Pks[i], amt))
+		require.True(t, got.IsOK())
+	}
+	staking.EndBlocker(ctx, sk)
+
+	consAddr := sdk.ConsAddress(Pks[0].Address())
+	signBlocks := func(from, to int64, signed bool) {
+		for height := from; height < to; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			keeper.HandleValidatorSignature(ctx, Pks[0].Address(), power, signed)
+			keeper.HandleValidatorSignature(ctx, Pks[1].Address(), power, true)
+		}
+	}
+
+	// 30 blocks signed, then 10 blocks missed
+	signBlocks(0, 30, true)
+	signBlocks(30, 40, false)
+
+	info, _ := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	missedBlocks := keeper.GetValidatorMissedBlocks(ctx, info)
+	require.Equal(t, int64(100), missedBlocks.SignedBlocksWindow)
+	require.Len(t, missedBlocks.MissedBlocks, 40)
+	for i, missed := range missedBlocks.MissedBlocks {
+		require.Equal(t, i >= 30, missed, "block %d", i)
+	}
+
+	// the validator cannot be jailed before the end of its first window
+	uptime := keeper.GetValidatorUptime(ctx, info)
+	require.Equal(t, int64(40), uptime.WindowBlocks)
+	require.Equal(t, int64(10), uptime.MissedBlocks)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), uptime.Uptime)
+	require.Equal(t, int64(62), uptime.BlocksUntilJail)
+
+	// once the window is full, the bitmap starts at the oldest block
+	signBlocks(40, 120, true)
+
+	info, _ = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	missedBlocks = keeper.GetValidatorMissedBlocks(ctx, info)
+	require.Len(t, missedBlocks.MissedBlocks, 100)
+	for i, missed := range missedBlocks.MissedBlocks {
+		require.Equal(t, i >= 10 && i < 20, missed, "block %d", i)
+	}
+
+	uptime = keeper.GetValidatorUptime(ctx, info)
+	require.Equal(t, int64(100), uptime.WindowBlocks)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), uptime.Uptime)
+	require.Equal(t, int64(41), uptime.BlocksUntilJail)
+
+	// lowest uptime first
+	uptimes := keeper.GetBondedValidatorUptimes(ctx)
+	require.Len(t, uptimes, 2)
+	require.Equal(t, consAddr, uptimes[0].Address)
+	require.Equal(t, sdk.OneDec(), uptimes[1].Uptime)
+}
@@ -81,5 +81,21 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	penaltyWindow := data.Params.DowntimePenaltyWindow
+	if penaltyWindow < 0 {
+		return fmt.Errorf("Downtime penalty window cannot be negative, is %s", penaltyWindow.String())
+	}
+
+	penaltyMultiplier := data.Params.DowntimePenaltyMultiplier
+	if penaltyMultiplier.IsNil() || penaltyMultiplier.LT(sdk.OneDec()) || penaltyMultiplier.GT(MaxDowntimePenaltyMultiplier) {
+		return fmt.Errorf("Downtime penalty multiplier should be between one and %s, is %s",
+			MaxDowntimePenaltyMultiplier, penaltyMultiplier.String())
+	}
+
+	penaltyMaxTier := data.Params.DowntimePenaltyMaxTier
+	if penaltyMaxTier < 0 {
+		return fmt.Errorf("Downtime penalty max tier cannot be negative, is %d", penaltyMaxTier)
+	}
+
 	return nil
 }
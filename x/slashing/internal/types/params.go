@@ -10,28 +10,38 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultParamspace           = ModuleName
-	DefaultMaxEvidenceAge       = 60 * 2 * time.Second
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultParamspace             = ModuleName
+	DefaultMaxEvidenceAge         = 60 * 2 * time.Second
+	DefaultSignedBlocksWindow     = int64(100)
+	DefaultDowntimeJailDuration   = 60 * 10 * time.Second
+	DefaultDowntimePenaltyWindow  = 60 * 60 * 24 * 7 * time.Second
+	DefaultDowntimePenaltyMaxTier = int64(3)
 )
 
 // The Double Sign Jail period ends at Max Time supported by Amino (Dec 31, 9999 - 23:59:59 GMT)
 var (
-	DoubleSignJailEndTime          = time.Unix(253402300799, 0)
-	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
-	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
-	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DoubleSignJailEndTime            = time.Unix(253402300799, 0)
+	DefaultMinSignedPerWindow        = sdk.NewDecWithPrec(5, 1)
+	DefaultSlashFractionDoubleSign   = sdk.NewDec(1).Quo(sdk.NewDec(20))
+	DefaultSlashFractionDowntime     = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultDowntimePenaltyMultiplier = sdk.NewDec(2)
+
+	// MaxDowntimePenaltyMultiplier bounds the downtime penalty multiplier so
+	// that escalated penalties cannot overflow
+	MaxDowntimePenaltyMultiplier = sdk.NewDec(100)
 )
 
 // Parameter store keys
 var (
-	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
-	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
-	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
-	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
-	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
-	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyMaxEvidenceAge            = []byte("MaxEvidenceAge")
+	KeySignedBlocksWindow        = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow        = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration      = []byte("DowntimeJailDuration")
+	KeySlashFractionDoubleSign   = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime     = []byte("SlashFractionDowntime")
+	KeyDowntimePenaltyWindow     = []byte("DowntimePenaltyWindow")
+	KeyDowntimePenaltyMultiplier = []byte("DowntimePenaltyMultiplier")
+	KeyDowntimePenaltyMaxTier    = []byte("DowntimePenaltyMaxTier")
 )
 
 // ParamKeyTable for slashing module
@@ -47,20 +57,32 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+
+	// Downtime penalties escalate with the number of downtime jailings of a
+	// validator, each jailing within DowntimePenaltyWindow after the end of the
+	// previous one raising its penalty tier. At tier n, the downtime slash
+	// fraction and jail duration are multiplied by DowntimePenaltyMultiplier^n.
+	DowntimePenaltyWindow     time.Duration `json:"downtime_penalty_window" yaml:"downtime_penalty_window"`
+	DowntimePenaltyMultiplier sdk.Dec       `json:"downtime_penalty_multiplier" yaml:"downtime_penalty_multiplier"`
+	DowntimePenaltyMaxTier    int64         `json:"downtime_penalty_max_tier" yaml:"downtime_penalty_max_tier"`
 }
 
 // NewParams creates a new Params object
 func NewParams(maxEvidenceAge time.Duration, signedBlocksWindow int64,
 	minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign sdk.Dec, slashFractionDowntime sdk.Dec) Params {
+	slashFractionDoubleSign sdk.Dec, slashFractionDowntime sdk.Dec,
+	downtimePenaltyWindow time.Duration, downtimePenaltyMultiplier sdk.Dec, downtimePenaltyMaxTier int64) Params {
 
 	return Params{
-		MaxEvidenceAge:          maxEvidenceAge,
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		MaxEvidenceAge:            maxEvidenceAge,
+		SignedBlocksWindow:        signedBlocksWindow,
+		MinSignedPerWindow:        minSignedPerWindow,
+		DowntimeJailDuration:      downtimeJailDuration,
+		SlashFractionDoubleSign:   slashFractionDoubleSign,
+		SlashFractionDowntime:     slashFractionDowntime,
+		DowntimePenaltyWindow:     downtimePenaltyWindow,
+		DowntimePenaltyMultiplier: downtimePenaltyMultiplier,
+		DowntimePenaltyMaxTier:    downtimePenaltyMaxTier,
 	}
 }
 
@@ -72,21 +94,28 @@ func (p Params) String() string {
   MinSignedPerWindow:      %s
   DowntimeJailDuration:    %s
   SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`, p.MaxEvidenceAge,
+  SlashFractionDowntime:   %s
+  DowntimePenaltyWindow:     %s
+  DowntimePenaltyMultiplier: %s
+  DowntimePenaltyMaxTier:    %d`, p.MaxEvidenceAge,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		p.SlashFractionDowntime, p.DowntimePenaltyWindow,
+		p.DowntimePenaltyMultiplier, p.DowntimePenaltyMaxTier)
 }
 
 // ParamSetPairs - Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		params.NewParamSetPair(KeyMaxEvidenceAge, &p.MaxEvidenceAge),
-		params.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow),
-		params.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow),
-		params.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration),
-		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign),
-		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime),
+		params.NewParamSetPair(KeyMaxEvidenceAge, &p.MaxEvidenceAge, validateMaxEvidenceAge),
+		params.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
+		params.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateMinSignedPerWindow),
+		params.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
+		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
+		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		params.NewParamSetPair(KeyDowntimePenaltyWindow, &p.DowntimePenaltyWindow, validateDowntimePenaltyWindow),
+		params.NewParamSetPair(KeyDowntimePenaltyMultiplier, &p.DowntimePenaltyMultiplier, validateDowntimePenaltyMultiplier),
+		params.NewParamSetPair(KeyDowntimePenaltyMaxTier, &p.DowntimePenaltyMaxTier, validateDowntimePenaltyMaxTier),
 	}
 }
 
@@ -95,5 +124,95 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultMaxEvidenceAge, DefaultSignedBlocksWindow, DefaultMinSignedPerWindow,
 		DefaultDowntimeJailDuration, DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultDowntimePenaltyWindow, DefaultDowntimePenaltyMultiplier, DefaultDowntimePenaltyMaxTier,
 	)
 }
+
+func validateMaxEvidenceAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("max evidence age must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateSignedBlocksWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("signed blocks window must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("downtime jail duration must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateFraction(name string, i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1, is %s", name, v)
+	}
+	return nil
+}
+
+func validateMinSignedPerWindow(i interface{}) error {
+	return validateFraction("min signed per window", i)
+}
+
+func validateSlashFractionDoubleSign(i interface{}) error {
+	return validateFraction("double sign slash fraction", i)
+}
+
+func validateSlashFractionDowntime(i interface{}) error {
+	return validateFraction("downtime slash fraction", i)
+}
+
+func validateDowntimePenaltyWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("downtime penalty window cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateDowntimePenaltyMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.LT(sdk.OneDec()) || v.GT(MaxDowntimePenaltyMultiplier) {
+		return fmt.Errorf("downtime penalty multiplier must be between 1 and %s, is %s", MaxDowntimePenaltyMultiplier, v)
+	}
+	return nil
+}
+
+func validateDowntimePenaltyMaxTier(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("downtime penalty max tier cannot be negative: %d", v)
+	}
+	return nil
+}
@@ -8,13 +8,20 @@ import (
 
 // Query endpoints supported by the slashing querier
 const (
-	QueryParameters   = "parameters"
-	QuerySigningInfo  = "signingInfo"
-	QuerySigningInfos = "signingInfos"
+	QueryParameters      = "parameters"
+	QuerySigningInfo     = "signingInfo"
+	QuerySigningInfos    = "signingInfos"
+	QueryDowntimePenalty = "downtimePenalty"
+	QueryMissedBlocks    = "missedBlocks"
+	QueryUptime          = "uptime"
+	QueryUptimes         = "uptimes"
 )
 
 // QuerySigningInfoParams defines the params for the following queries:
 // - 'custom/slashing/signingInfo'
+// - 'custom/slashing/downtimePenalty'
+// - 'custom/slashing/missedBlocks'
+// - 'custom/slashing/uptime'
 type QuerySigningInfoParams struct {
 	ConsAddress sdk.ConsAddress
 }
@@ -26,6 +33,7 @@ func NewQuerySigningInfoParams(consAddr sdk.ConsAddress) QuerySigningInfoParams
 
 // QuerySigningInfosParams defines the params for the following queries:
 // - 'custom/slashing/signingInfos'
+// - 'custom/slashing/uptimes'
 type QuerySigningInfosParams struct {
 	Page, Limit int
 }
@@ -15,12 +15,13 @@ type ValidatorSigningInfo struct {
 	JailedUntil         time.Time       `json:"jailed_until" yaml:"jailed_until"`                   // timestamp validator cannot be unjailed until
 	Tombstoned          bool            `json:"tombstoned" yaml:"tombstoned"`                       // whether or not a validator has been tombstoned (killed out of validator set)
 	MissedBlocksCounter int64           `json:"missed_blocks_counter" yaml:"missed_blocks_counter"` // missed blocks counter (to avoid scanning the array every time)
+	DowntimeJailCount   int64           `json:"downtime_jail_count" yaml:"downtime_jail_count"`     // number of successive downtime jailings within the penalty window
 }
 
 // NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
 func NewValidatorSigningInfo(
 	condAddr sdk.ConsAddress, startHeight, indexOffset int64,
-	jailedUntil time.Time, tombstoned bool, missedBlocksCounter int64,
+	jailedUntil time.Time, tombstoned bool, missedBlocksCounter, downtimeJailCount int64,
 ) ValidatorSigningInfo {
 
 	return ValidatorSigningInfo{
@@ -30,6 +31,7 @@ func NewValidatorSigningInfo(
 		JailedUntil:         jailedUntil,
 		Tombstoned:          tombstoned,
 		MissedBlocksCounter: missedBlocksCounter,
+		DowntimeJailCount:   downtimeJailCount,
 	}
 }
 
@@ -41,7 +43,39 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Jail Count:   %d`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeJailCount)
+}
+
+// DowntimePenalty defines the penalty applied to a validator for its next
+// downtime, given its recent downtime jailings.
+type DowntimePenalty struct {
+	Address       sdk.ConsAddress `json:"address" yaml:"address"`               // validator consensus address
+	Tier          int64           `json:"tier" yaml:"tier"`                     // penalty tier, 0 for the base penalty
+	SlashFraction sdk.Dec         `json:"slash_fraction" yaml:"slash_fraction"` // fraction of the stake slashed
+	JailDuration  time.Duration   `json:"jail_duration" yaml:"jail_duration"`   // duration of the jailing
+}
+
+// NewDowntimePenalty creates a new DowntimePenalty instance
+func NewDowntimePenalty(consAddr sdk.ConsAddress, tier int64, slashFraction sdk.Dec,
+	jailDuration time.Duration) DowntimePenalty {
+
+	return DowntimePenalty{
+		Address:       consAddr,
+		Tier:          tier,
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+	}
+}
+
+// String implements the stringer interface for DowntimePenalty
+func (p DowntimePenalty) String() string {
+	return fmt.Sprintf(`Downtime Penalty:
+  Address:        %s
+  Tier:           %d
+  Slash Fraction: %s
+  Jail Duration:  %s`,
+		p.Address, p.Tier, p.SlashFraction, p.JailDuration)
 }
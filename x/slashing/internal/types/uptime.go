@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorMissedBlocks defines the missed blocks of a validator over the
+// current signed blocks window.
+type ValidatorMissedBlocks struct {
+	Address            sdk.ConsAddress `json:"address" yaml:"address"`                           // validator consensus address
+	SignedBlocksWindow int64           `json:"signed_blocks_window" yaml:"signed_blocks_window"` // size of the sliding window
+	MissedBlocks       []bool          `json:"missed_blocks" yaml:"missed_blocks"`               // whether each block of the window was missed, oldest first
+}
+
+// NewValidatorMissedBlocks creates a new ValidatorMissedBlocks instance
+func NewValidatorMissedBlocks(consAddr sdk.ConsAddress, signedBlocksWindow int64,
+	missedBlocks []bool) ValidatorMissedBlocks {
+
+	return ValidatorMissedBlocks{
+		Address:            consAddr,
+		SignedBlocksWindow: signedBlocksWindow,
+		MissedBlocks:       missedBlocks,
+	}
+}
+
+// String implements the stringer interface for ValidatorMissedBlocks. Missed
+// blocks are printed as 'x' and signed blocks as '.'.
+func (vmb ValidatorMissedBlocks) String() string {
+	var bitmap strings.Builder
+	for _, missed := range vmb.MissedBlocks {
+		if missed {
+			bitmap.WriteByte('x')
+		} else {
+			bitmap.WriteByte('.')
+		}
+	}
+
+	return fmt.Sprintf(`Validator Missed Blocks:
+  Address:              %s
+  Signed Blocks Window: %d
+  Missed Blocks:        %s`,
+		vmb.Address, vmb.SignedBlocksWindow, bitmap.String())
+}
+
+// ValidatorUptime defines the liveness of a validator over the current signed
+// blocks window.
+type ValidatorUptime struct {
+	Address         sdk.ConsAddress `json:"address" yaml:"address"`                     // validator consensus address
+	WindowBlocks    int64           `json:"window_blocks" yaml:"window_blocks"`         // number of blocks of the window the validator should have signed
+	MissedBlocks    int64           `json:"missed_blocks" yaml:"missed_blocks"`         // number of blocks of the window the validator missed
+	Uptime          sdk.Dec         `json:"uptime" yaml:"uptime"`                       // fraction of the blocks of the window the validator signed
+	BlocksUntilJail int64           `json:"blocks_until_jail" yaml:"blocks_until_jail"` // minimum number of blocks the validator must miss to be jailed
+}
+
+// NewValidatorUptime creates a new ValidatorUptime instance
+func NewValidatorUptime(consAddr sdk.ConsAddress, windowBlocks, missedBlocks int64,
+	uptime sdk.Dec, blocksUntilJail int64) ValidatorUptime {
+
+	return ValidatorUptime{
+		Address:         consAddr,
+		WindowBlocks:    windowBlocks,
+		MissedBlocks:    missedBlocks,
+		Uptime:          uptime,
+		BlocksUntilJail: blocksUntilJail,
+	}
+}
+
+// String implements the stringer interface for ValidatorUptime
+func (vu ValidatorUptime) String() string {
+	return fmt.Sprintf(`Validator Uptime:
+  Address:           %s
+  Window Blocks:     %d
+  Missed Blocks:     %d
+  Uptime:            %s
+  Blocks Until Jail: %d`,
+		vu.Address, vu.WindowBlocks, vu.MissedBlocks, vu.Uptime, vu.BlocksUntilJail)
+}
+
+// ValidatorUptimes defines a list of validator uptimes
+type ValidatorUptimes []ValidatorUptime
+
+// String implements the stringer interface for ValidatorUptimes
+func (vus ValidatorUptimes) String() string {
+	if len(vus) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(vus))
+	for i, vu := range vus {
+		out[i] = vu.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -33,7 +33,7 @@ func makeTestCodec() (cdc *codec.Codec) {
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
 
-	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
+	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0, 0)
 	bechPK := sdk.MustBech32ifyAccPub(delPk1)
 	missed := true
 
@@ -30,8 +30,11 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryRedelegation(queryRoute, cdc),
 		GetCmdQueryRedelegations(queryRoute, cdc),
+		GetCmdQueryRedelegationRestrictions(queryRoute, cdc),
+		GetCmdQueryDelegatorSlashRecords(queryRoute, cdc),
 		GetCmdQueryValidator(queryRoute, cdc),
 		GetCmdQueryValidators(queryRoute, cdc),
+		GetCmdQueryValidatorPendingCommission(queryRoute, cdc),
 		GetCmdQueryValidatorDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorRedelegations(queryRoute, cdc),
@@ -79,6 +82,48 @@ $ %s query staking validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhff
 	}
 }
 
+// GetCmdQueryValidatorPendingCommission implements the validator pending
+// commission change query command.
+func GetCmdQueryValidatorPendingCommission(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-commission [validator-addr]",
+		Short: "Query a validator's pending commission change",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the commission rate change scheduled for a validator and the time it takes effect.
+
+Example:
+$ %s query staking pending-commission cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorPendingCommission)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var change types.PendingCommissionChange
+			cdc.MustUnmarshalJSON(res, &change)
+			return cliCtx.PrintOutput(change)
+		},
+	}
+}
+
 // GetCmdQueryValidators implements the query all validators command.
 func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -427,6 +472,53 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 	}
 }
 
+// GetCmdQueryDelegatorSlashRecords implements the command to query the tokens
+// slashed from a delegator.
+func GetCmdQueryDelegatorSlashRecords(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "slash-records [delegator-addr]",
+		Short: "Query the tokens slashed from one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the slash records of an individual delegator. Each record holds the
+tokens slashed from the delegator's delegation, unbonding delegations and
+redelegations when a validator was slashed.
+
+Example:
+$ %s query staking slash-records cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorSlashRecords)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var records types.DelegatorSlashRecords
+			if err = cdc.UnmarshalJSON(res, &records); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
 // GetCmdQueryRedelegation implements the command to query a single
 // redelegation record.
 func GetCmdQueryRedelegation(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -527,6 +619,63 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	}
 }
 
+// GetCmdQueryRedelegationRestrictions implements the command to query which
+// portions of a delegation are blocked from being redelegated.
+func GetCmdQueryRedelegationRestrictions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegation-restrictions [delegator-addr] [src-validator-addr] [dst-validator-addr:weight]...",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Query which portions of a delegation can not be redelegated and why",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the restrictions preventing a delegator from redelegating its delegation
+away from a source validator. Optional destinations are given as <validator-addr>:<weight>
+pairs, in which case per-destination restrictions are reported as well.
+
+Example:
+$ %s query staking redelegation-restrictions cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosmosvaloper1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj:1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			dsts, err := types.ParseRedelegationDestinations(args[2:])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryRedelegationRestrictionsParams(delAddr, valSrcAddr, dsts))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRedelegationRestrictions)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp types.RedelegationRestrictionsResponse
+			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+}
+
 // GetCmdQueryPool implements the pool query command.
 func GetCmdQueryPool(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -35,8 +35,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	stakingTxCmd.AddCommand(client.PostCommands(
 		GetCmdCreateValidator(cdc),
 		GetCmdEditValidator(cdc),
+		GetCmdRotateConsPubKey(cdc),
 		GetCmdDelegate(cdc),
 		GetCmdRedelegate(storeKey, cdc),
+		GetCmdRedelegateAll(cdc),
 		GetCmdUnbond(storeKey, cdc),
 	)...)
 
@@ -134,6 +136,40 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdRotateConsPubKey implements the consensus pubkey rotation command.
+func GetCmdRotateConsPubKey(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replace the consensus pubkey of your validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the consensus pubkey of the validator operated by the sending account.
+The validator signs with the new key once the validator set update has been applied
+by Tendermint. Evidence of infractions committed with the old key can still be
+submitted and slashed for one unbonding period.
+
+Example:
+$ %s tx staking rotate-cons-pubkey cosmosvalconspub1zcjduepq0vu2zgkgk49efa0nqwzndanq5m4c7pa3u4apz4g2r9gspqg6g9cs3k9cuf --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr := cliCtx.GetFromAddress()
+			msg := types.NewMsgRotateConsPubKey(sdk.ValAddress(valAddr), pk)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdDelegate implements the delegate command.
 func GetCmdDelegate(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -211,6 +247,48 @@ $ %s tx staking redelegate cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	}
 }
 
+// GetCmdRedelegateAll implements the redelegate all command.
+func GetCmdRedelegateAll(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegate-all [src-validator-addr] [dst-validator-addr:weight]...",
+		Short: "Redelegate an entire delegation from one validator to a weighted set of validators",
+		Args:  cobra.MinimumNArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redelegate all illiquid staking tokens from a validator to one or more
+destination validators. Every destination is given as <validator-addr>:<weight> and
+the weights must sum to one.
+
+Example:
+$ %s tx staking redelegate-all cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj cosmosvaloper1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm:0.7 cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0:0.3 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			dsts, err := types.ParseRedelegationDestinations(args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedelegateAll(delAddr, valSrcAddr, dsts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdUnbond implements the unbond validator command.
 func GetCmdUnbond(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -26,6 +26,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		delegatorUnbondingDelegationsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Get the tokens slashed from a delegator
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/slash_records",
+		delegatorSlashRecordsHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get all staking txs (i.e msgs) from a delegator
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/txs",
@@ -62,6 +68,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		redelegationsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Query which portions of a delegation can not be redelegated (destinations in query params)
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redelegation_restrictions/{validatorAddr}",
+		redelegationRestrictionsHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get all validators
 	r.HandleFunc(
 		"/staking/validators",
@@ -86,6 +98,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		validatorUnbondingDelegationsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Get the pending commission change of a validator
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}/pending_commission",
+		validatorPendingCommissionHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get the current state of the staking pool
 	r.HandleFunc(
 		"/staking/pool",
@@ -110,6 +128,11 @@ func delegatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.Hand
 	return queryDelegator(cliCtx, "custom/staking/delegatorUnbondingDelegations")
 }
 
+// HTTP request handler to query the slash records of a delegator
+func delegatorSlashRecordsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorSlashRecords))
+}
+
 // HTTP request handler to query all staking txs (msgs) from a delegator
 func delegatorTxsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -245,6 +268,59 @@ func redelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// HTTP request handler to query the redelegation restrictions of a delegation
+func redelegationRestrictionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		validatorAddr, err := sdk.ValAddressFromBech32(vars["validatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var dstStrs []string
+		if dstsParam := r.URL.Query().Get("destinations"); len(dstsParam) != 0 {
+			dstStrs = strings.Split(dstsParam, ",")
+		}
+
+		dsts, err := types.ParseRedelegationDestinations(dstStrs)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryRedelegationRestrictionsParams(delegatorAddr, validatorAddr, dsts)
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRedelegationRestrictions)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // HTTP request handler to query a delegation
 func delegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryBonds(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegation))
@@ -313,6 +389,11 @@ func validatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.Hand
 	return queryValidator(cliCtx, "custom/staking/validatorUnbondingDelegations")
 }
 
+// HTTP request handler to query the pending commission change of a validator
+func validatorPendingCommissionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryValidator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorPendingCommission))
+}
+
 // HTTP request handler to query the pool information
 func poolHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -26,6 +26,14 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		postRedelegationsHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redelegate_all",
+		postRedelegateAllHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}/rotate_cons_pubkey",
+		postRotateConsPubKeyHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -46,6 +54,21 @@ type (
 		Amount              sdk.Coin       `json:"amount" yaml:"amount"`
 	}
 
+	// RedelegateAllRequest defines the properties of a redelegate all request's body.
+	RedelegateAllRequest struct {
+		BaseReq             rest.BaseReq                    `json:"base_req" yaml:"base_req"`
+		DelegatorAddress    sdk.AccAddress                  `json:"delegator_address" yaml:"delegator_address"`         // in bech32
+		ValidatorSrcAddress sdk.ValAddress                  `json:"validator_src_address" yaml:"validator_src_address"` // in bech32
+		Destinations        []types.RedelegationDestination `json:"destinations" yaml:"destinations"`
+	}
+
+	// RotateConsPubKeyRequest defines the properties of a consensus pubkey
+	// rotation request's body.
+	RotateConsPubKeyRequest struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		PubKey  string       `json:"pubkey" yaml:"pubkey"` // in bech32
+	}
+
 	// UndelegateRequest defines the properties of a undelegate request's body.
 	UndelegateRequest struct {
 		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
@@ -123,6 +146,40 @@ func postRedelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func postRedelegateAllHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RedelegateAllRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgRedelegateAll(req.DelegatorAddress, req.ValidatorSrcAddress, req.Destinations)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 func postUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req UndelegateRequest
@@ -156,3 +213,49 @@ func postUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postRotateConsPubKeyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RotateConsPubKeyRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pk, err := sdk.GetConsPubKeyBech32(req.PubKey)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRotateConsPubKey(valAddr, pk)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, valAddr) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own validator address")
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -87,6 +87,19 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		}
 	}
 
+	for _, change := range data.PendingCommissions {
+		keeper.SetPendingCommissionChange(ctx, change)
+		keeper.InsertCommissionQueue(ctx, change)
+	}
+
+	for _, rotated := range data.RotatedConsAddresses {
+		keeper.SetRotatedConsAddress(ctx, rotated)
+	}
+
+	for _, record := range data.DelegatorSlashRecords {
+		keeper.AppendDelegatorSlashRecord(ctx, record)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -154,6 +167,21 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		redelegations = append(redelegations, red)
 		return false
 	})
+	var pendingCommissions []types.PendingCommissionChange
+	keeper.IteratePendingCommissionChanges(ctx, func(change types.PendingCommissionChange) (stop bool) {
+		pendingCommissions = append(pendingCommissions, change)
+		return false
+	})
+	var rotatedConsAddresses []types.RotatedConsAddress
+	keeper.IterateRotatedConsAddresses(ctx, func(rotated types.RotatedConsAddress) (stop bool) {
+		rotatedConsAddresses = append(rotatedConsAddresses, rotated)
+		return false
+	})
+	var delegatorSlashRecords []types.DelegatorSlashRecord
+	keeper.IterateDelegatorSlashRecords(ctx, func(record types.DelegatorSlashRecord) (stop bool) {
+		delegatorSlashRecords = append(delegatorSlashRecords, record)
+		return false
+	})
 	var lastValidatorPowers []types.LastValidatorPower
 	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
 		lastValidatorPowers = append(lastValidatorPowers, types.LastValidatorPower{addr, power})
@@ -161,14 +189,17 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		Params:               params,
-		LastTotalPower:       lastTotalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           validators,
-		Delegations:          delegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                params,
+		LastTotalPower:        lastTotalPower,
+		LastValidatorPowers:   lastValidatorPowers,
+		Validators:            validators,
+		Delegations:           delegations,
+		UnbondingDelegations:  unbondingDelegations,
+		Redelegations:         redelegations,
+		PendingCommissions:    pendingCommissions,
+		RotatedConsAddresses:  rotatedConsAddresses,
+		DelegatorSlashRecords: delegatorSlashRecords,
+		Exported:              true,
 	}
 }
 
@@ -198,6 +229,11 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	for _, record := range data.DelegatorSlashRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
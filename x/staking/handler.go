@@ -24,12 +24,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgEditValidator:
 			return handleMsgEditValidator(ctx, msg, k)
 
+		case types.MsgRotateConsPubKey:
+			return handleMsgRotateConsPubKey(ctx, msg, k)
+
 		case types.MsgDelegate:
 			return handleMsgDelegate(ctx, msg, k)
 
 		case types.MsgBeginRedelegate:
 			return handleMsgBeginRedelegate(ctx, msg, k)
 
+		case types.MsgRedelegateAll:
+			return handleMsgRedelegateAll(ctx, msg, k)
+
 		case types.MsgUndelegate:
 			return handleMsgUndelegate(ctx, msg, k)
 
@@ -92,6 +98,32 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 		)
 	}
 
+	// Stop resolving consensus addresses rotated out more than an unbonding
+	// period ago.
+	k.ReleaseAllMatureRotatedConsAddrs(ctx)
+
+	// Apply all commission changes whose notice period has elapsed.
+	applied, rejected := k.ApplyAllMatureCommissionChanges(ctx)
+	for _, change := range applied {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteCommission,
+				sdk.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+			),
+		)
+	}
+	for _, change := range rejected {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRejectCommission,
+				sdk.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+				sdk.NewAttribute(types.AttributeKeyMinCommissionRate, k.MinCommissionRate(ctx).String()),
+			),
+		)
+	}
+
 	return validatorUpdates
 }
 
@@ -125,6 +157,10 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		}
 	}
 
+	if minRate := k.MinCommissionRate(ctx); msg.Commission.Rate.LT(minRate) {
+		return ErrCommissionLTMinRate(k.Codespace(), minRate).Result()
+	}
+
 	validator := NewValidator(msg.ValidatorAddress, msg.PubKey, msg.Description)
 	commission := NewCommissionWithTime(
 		msg.Commission.Rate, msg.Commission.MaxRate,
@@ -184,15 +220,34 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	validator.Description = description
 
 	if msg.CommissionRate != nil {
-		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
-		if err != nil {
-			return err.Result()
+		if k.CommissionChangeDelay(ctx) > 0 {
+			// the new rate only takes effect once the notice period has elapsed
+			commission, change, err := k.ScheduleCommissionChange(ctx, validator, *msg.CommissionRate)
+			if err != nil {
+				return err.Result()
+			}
+
+			validator.Commission = commission
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCommissionChange,
+					sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+					sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+					sdk.NewAttribute(types.AttributeKeyEffectiveTime, change.EffectiveTime.Format(time.RFC3339)),
+				),
+			)
+		} else {
+			commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
+			if err != nil {
+				return err.Result()
+			}
+
+			// call the before-modification hook since we're about to update the commission
+			k.BeforeValidatorModified(ctx, msg.ValidatorAddress)
+
+			validator.Commission = commission
 		}
-
-		// call the before-modification hook since we're about to update the commission
-		k.BeforeValidatorModified(ctx, msg.ValidatorAddress)
-
-		validator.Commission = commission
 	}
 
 	if msg.MinSelfDelegation != nil {
@@ -223,6 +278,43 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgRotateConsPubKey(ctx sdk.Context, msg types.MsgRotateConsPubKey, k keeper.Keeper) sdk.Result {
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return ErrNoValidatorFound(k.Codespace()).Result()
+	}
+
+	if ctx.ConsensusParams() != nil {
+		tmPubKey := tmtypes.TM2PB.PubKey(msg.PubKey)
+		if !common.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
+			return ErrValidatorPubKeyTypeNotSupported(k.Codespace(),
+				tmPubKey.Type,
+				ctx.ConsensusParams().Validator.PubKeyTypes).Result()
+		}
+	}
+
+	oldConsAddr := validator.ConsAddress()
+	if err := k.RotateConsPubKey(ctx, msg.ValidatorAddress, msg.PubKey); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.ConsAddress(msg.PubKey.Address()).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper) sdk.Result {
 	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
 	if !found {
@@ -327,3 +419,57 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 
 	return sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}
 }
+
+func handleMsgRedelegateAll(ctx sdk.Context, msg types.MsgRedelegateAll, k keeper.Keeper) sdk.Result {
+	// record the destination balances before redelegating so that the emitted
+	// amounts reflect the tokens actually moved to each destination
+	dstBalances := make([]sdk.Int, len(msg.Destinations))
+	for i, dst := range msg.Destinations {
+		dstBalances[i] = delegationBalance(ctx, k, msg.DelegatorAddress, dst.ValidatorAddress)
+	}
+
+	completionTime, err := k.BeginRedelegateAll(ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.Destinations)
+	if err != nil {
+		return err.Result()
+	}
+
+	for i, dst := range msg.Destinations {
+		amount := delegationBalance(ctx, k, msg.DelegatorAddress, dst.ValidatorAddress).Sub(dstBalances[i])
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRedelegate,
+				sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyDstValidator, dst.ValidatorAddress.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+			),
+		)
+	}
+
+	completionTimeBz := types.ModuleCdc.MustMarshalBinaryLengthPrefixed(completionTime)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}
+}
+
+// delegationBalance returns the tokens backing a delegation, zero if it does
+// not exist.
+func delegationBalance(ctx sdk.Context, k keeper.Keeper, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Int {
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
@@ -910,6 +910,98 @@ func TestTransitiveRedelegation(t *testing.T) {
 	require.True(t, got.IsOK(), "expected no error")
 }
 
+func TestRedelegateAll(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, valAddr2, valAddr3 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1]), sdk.ValAddress(keep.Addrs[2])
+	delAddr := keep.Addrs[3]
+
+	// set the unbonding time
+	params := keeper.GetParams(ctx)
+	params.UnbondingTime = 7 * time.Second
+	keeper.SetParams(ctx, params)
+
+	// create the validators and bond them
+	for i, addr := range []sdk.ValAddress{valAddr, valAddr2, valAddr3} {
+		msgCreateValidator := NewTestMsgCreateValidator(addr, keep.PKs[i], sdk.TokensFromConsensusPower(10))
+		got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+		require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	}
+	EndBlocker(ctx, keeper)
+
+	delTokens := sdk.TokensFromConsensusPower(100)
+	msgDelegate := NewTestMsgDelegate(delAddr, valAddr, delTokens)
+	got := handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgDelegate")
+
+	// redelegate everything, 70% to the second validator and 30% to the third
+	dsts := []RedelegationDestination{
+		NewRedelegationDestination(valAddr2, sdk.NewDecWithPrec(7, 1)),
+		NewRedelegationDestination(valAddr3, sdk.NewDecWithPrec(3, 1)),
+	}
+	got = handleMsgRedelegateAll(ctx, NewMsgRedelegateAll(delAddr, valAddr, dsts), keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+
+	_, found := keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+
+	delegation, found := keeper.GetDelegation(ctx, delAddr, valAddr2)
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec().Mul(sdk.NewDecWithPrec(7, 1)), delegation.Shares)
+
+	delegation, found = keeper.GetDelegation(ctx, delAddr, valAddr3)
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec().Mul(sdk.NewDecWithPrec(3, 1)), delegation.Shares)
+
+	_, found = keeper.GetRedelegation(ctx, delAddr, valAddr, valAddr2)
+	require.True(t, found)
+	_, found = keeper.GetRedelegation(ctx, delAddr, valAddr, valAddr3)
+	require.True(t, found)
+
+	// moving the stake again is blocked by the transitive redelegation rule
+	dsts = []RedelegationDestination{NewRedelegationDestination(valAddr, sdk.OneDec())}
+	restrictions, err := keeper.GetRedelegationRestrictions(ctx, delAddr, valAddr2, dsts)
+	require.NoError(t, err)
+	require.Equal(t, sdk.TokensFromConsensusPower(70), restrictions.Balance)
+	require.Len(t, restrictions.Restrictions, 1)
+	require.Equal(t, types.RestrictionTransitiveRedelegation, restrictions.Restrictions[0].Reason)
+	require.Equal(t, valAddr, restrictions.Restrictions[0].ValidatorSrcAddress)
+	require.Equal(t, sdk.TokensFromConsensusPower(70), restrictions.Restrictions[0].Balance)
+	require.Equal(t, ctx.BlockHeader().Time.Add(params.UnbondingTime), restrictions.Restrictions[0].UnblockTime)
+
+	got = handleMsgRedelegateAll(ctx, NewMsgRedelegateAll(delAddr, valAddr2, dsts), keeper)
+	require.False(t, got.IsOK(), "expected an error")
+
+	// once the redelegations mature the stake can be moved again
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.UnbondingTime))
+	EndBlocker(ctx, keeper)
+
+	restrictions, err = keeper.GetRedelegationRestrictions(ctx, delAddr, valAddr2, dsts)
+	require.NoError(t, err)
+	require.Empty(t, restrictions.Restrictions)
+
+	got = handleMsgRedelegateAll(ctx, NewMsgRedelegateAll(delAddr, valAddr2, dsts), keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+
+	// a destination too small to receive a single token is skipped and its
+	// shares are moved to the last destination
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.UnbondingTime))
+	EndBlocker(ctx, keeper)
+	dsts = []RedelegationDestination{
+		NewRedelegationDestination(valAddr3, sdk.NewDecWithPrec(1, 18)),
+		NewRedelegationDestination(valAddr2, sdk.OneDec().Sub(sdk.NewDecWithPrec(1, 18))),
+	}
+	got = handleMsgRedelegateAll(ctx, NewMsgRedelegateAll(delAddr, valAddr, dsts), keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+
+	_, found = keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+	delegation, found = keeper.GetDelegation(ctx, delAddr, valAddr2)
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(70).ToDec(), delegation.Shares)
+	_, found = keeper.GetRedelegation(ctx, delAddr, valAddr, valAddr3)
+	require.False(t, found)
+}
+
 func TestMultipleRedelegationAtSameTime(t *testing.T) {
 	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	valAddr := sdk.ValAddress(keep.Addrs[0])
@@ -1324,3 +1416,126 @@ func TestInvalidCoinDenom(t *testing.T) {
 	got = handleMsgBeginRedelegate(ctx, msgRedelegate, keeper)
 	require.True(t, got.IsOK())
 }
+
+func TestMinCommissionRate(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	keeper.SetParams(ctx, params)
+
+	// creating a validator below the commission floor must fail
+	lowRates := NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator := types.NewMsgCreateValidator(
+		validatorAddr, keep.PKs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)), Description{}, lowRates, sdk.OneInt(),
+	)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.False(t, got.IsOK(), "expected create-validator below the commission floor to fail")
+
+	rates := NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator.Commission = rates
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	// move past the commission change rate limit
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(25 * time.Hour))
+
+	newRate := sdk.NewDecWithPrec(2, 2)
+	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{}, &newRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.False(t, got.IsOK(), "expected edit-validator below the commission floor to fail")
+
+	newRate = sdk.NewDecWithPrec(6, 2)
+	msgEditValidator = NewMsgEditValidator(validatorAddr, Description{}, &newRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.True(t, got.IsOK(), "expected edit-validator to be ok, got %v", got)
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newRate, validator.Commission.Rate)
+}
+
+func TestDelayedCommissionChange(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+
+	delay := 7 * 24 * time.Hour
+	params := keeper.GetParams(ctx)
+	params.CommissionChangeDelay = delay
+	keeper.SetParams(ctx, params)
+
+	rates := NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator := types.NewMsgCreateValidator(
+		validatorAddr, keep.PKs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)), Description{}, rates, sdk.OneInt(),
+	)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	// move past the commission change rate limit and request a change
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(25 * time.Hour))
+	requestTime := ctx.BlockHeader().Time
+
+	newRate := sdk.NewDecWithPrec(15, 2)
+	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{}, &newRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.True(t, got.IsOK(), "expected edit-validator to be ok, got %v", got)
+
+	// the rate is unchanged until the delay elapses
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, rates.Rate, validator.Commission.Rate)
+	require.Equal(t, requestTime, validator.Commission.UpdateTime)
+
+	change, found := keeper.GetPendingCommissionChange(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newRate, change.Rate)
+	require.Equal(t, requestTime.Add(delay), change.EffectiveTime)
+
+	// only one change may be pending at a time
+	ctx = ctx.WithBlockTime(requestTime.Add(25 * time.Hour))
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.False(t, got.IsOK(), "expected a second commission change to fail while one is pending")
+
+	ctx = ctx.WithBlockTime(requestTime.Add(delay - time.Second))
+	EndBlocker(ctx, keeper)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.Equal(t, rates.Rate, validator.Commission.Rate)
+
+	ctx = ctx.WithBlockTime(requestTime.Add(delay))
+	EndBlocker(ctx, keeper)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.Equal(t, newRate, validator.Commission.Rate)
+
+	_, found = keeper.GetPendingCommissionChange(ctx, validatorAddr)
+	require.False(t, found)
+
+	// a change below a minimum commission rate raised while it was pending is
+	// rejected
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(25 * time.Hour))
+	requestTime = ctx.BlockHeader().Time
+	lowRate := sdk.NewDecWithPrec(12, 2)
+	msgEditValidator = NewMsgEditValidator(validatorAddr, Description{}, &lowRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.True(t, got.IsOK(), "expected edit-validator to be ok, got %v", got)
+
+	params = keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(13, 2)
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockTime(requestTime.Add(delay)).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, keeper)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.Equal(t, newRate, validator.Commission.Rate)
+
+	_, found = keeper.GetPendingCommissionChange(ctx, validatorAddr)
+	require.False(t, found)
+
+	rejected := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRejectCommission {
+			rejected = true
+		}
+	}
+	require.True(t, rejected)
+}
@@ -751,6 +751,129 @@ func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	return completionTime, nil
 }
 
+// BeginRedelegateAll redelegates the entire delegation of a delegator from the
+// source validator to the given destinations, splitting the delegation shares
+// by destination weight. Every individual redelegation is subject to the same
+// rules as BeginRedelegation; the destinations are assumed to have passed
+// ValidateRedelegationDestinations.
+func (k Keeper) BeginRedelegateAll(ctx sdk.Context, delAddr sdk.AccAddress,
+	valSrcAddr sdk.ValAddress, dsts []types.RedelegationDestination) (
+	completionTime time.Time, errSdk sdk.Error) {
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valSrcAddr)
+	if !found {
+		return time.Time{}, types.ErrNoDelegation(k.Codespace())
+	}
+
+	// truncate every share amount but the last one, which receives the
+	// remainder so that the whole delegation is moved. Destinations whose
+	// weight is too small to be worth a single token are skipped and their
+	// shares are left to the remainder.
+	remaining := delegation.Shares
+	for i, dst := range dsts {
+		shares := remaining
+		if i != len(dsts)-1 {
+			shares = delegation.Shares.MulTruncate(dst.Weight)
+
+			validator, found := k.GetValidator(ctx, valSrcAddr)
+			if !found {
+				return time.Time{}, types.ErrNoValidatorFound(k.Codespace())
+			}
+			if validator.TokensFromShares(shares).TruncateInt().IsZero() {
+				continue
+			}
+		}
+		remaining = remaining.Sub(shares)
+
+		completionTime, errSdk = k.BeginRedelegation(ctx, delAddr, valSrcAddr, dst.ValidatorAddress, shares)
+		if errSdk != nil {
+			return time.Time{}, errSdk
+		}
+	}
+
+	return completionTime, nil
+}
+
+// GetRedelegationRestrictions returns which portions of a delegation can not
+// currently be redelegated from the source validator to the given destinations,
+// and the reason for it.
+func (k Keeper) GetRedelegationRestrictions(ctx sdk.Context, delAddr sdk.AccAddress,
+	valSrcAddr sdk.ValAddress, dsts []types.RedelegationDestination) (
+	res types.RedelegationRestrictionsResponse, errSdk sdk.Error) {
+
+	srcValidator, found := k.GetValidator(ctx, valSrcAddr)
+	if !found {
+		return res, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valSrcAddr)
+	if !found {
+		return res, types.ErrNoDelegation(k.Codespace())
+	}
+
+	completionTime, _, completeNow := k.getBeginInfo(ctx, valSrcAddr)
+	res = types.RedelegationRestrictionsResponse{
+		DelegatorAddress:    delAddr,
+		ValidatorSrcAddress: valSrcAddr,
+		Balance:             srcValidator.TokensFromShares(delegation.Shares).TruncateInt(),
+		CompletionTime:      completionTime,
+		CompleteNow:         completeNow,
+		Restrictions:        []types.RedelegationRestriction{},
+	}
+
+	// any immature redelegation into the source validator blocks the whole
+	// delegation, report each of them with the tokens it brought in
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetREDsByDelToValDstIndexKey(delAddr, valSrcAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		red := types.MustUnmarshalRED(k.cdc, store.Get(types.GetREDKeyFromValDstIndexKey(iterator.Key())))
+
+		balance := sdk.ZeroInt()
+		var unblockTime time.Time
+		for _, entry := range red.Entries {
+			balance = balance.Add(srcValidator.TokensFromShares(entry.SharesDst).TruncateInt())
+			if entry.CompletionTime.After(unblockTime) {
+				unblockTime = entry.CompletionTime
+			}
+		}
+
+		res.Restrictions = append(res.Restrictions, types.NewRedelegationRestriction(
+			types.RestrictionTransitiveRedelegation, red.ValidatorSrcAddress, valSrcAddr, balance, unblockTime,
+		))
+	}
+
+	maxEntries := int(k.MaxEntries(ctx))
+	for _, dst := range dsts {
+		balance := res.Balance.ToDec().MulTruncate(dst.Weight).TruncateInt()
+
+		if _, found := k.GetValidator(ctx, dst.ValidatorAddress); !found {
+			res.Restrictions = append(res.Restrictions, types.NewRedelegationRestriction(
+				types.RestrictionNoDstValidator, valSrcAddr, dst.ValidatorAddress, balance, time.Time{},
+			))
+			continue
+		}
+
+		red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, dst.ValidatorAddress)
+		if found && len(red.Entries) >= maxEntries {
+			// the destination unblocks as soon as its earliest entry matures
+			unblockTime := red.Entries[0].CompletionTime
+			for _, entry := range red.Entries[1:] {
+				if entry.CompletionTime.Before(unblockTime) {
+					unblockTime = entry.CompletionTime
+				}
+			}
+
+			res.Restrictions = append(res.Restrictions, types.NewRedelegationRestriction(
+				types.RestrictionMaxEntries, valSrcAddr, dst.ValidatorAddress, balance, unblockTime,
+			))
+		}
+	}
+
+	return res, nil
+}
+
 // CompleteRedelegation completes the unbonding of all mature entries in the
 // retrieved unbonding delegation object.
 func (k Keeper) CompleteRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
@@ -43,6 +43,19 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MinCommissionRate - Network-wide minimum validator commission rate
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
+// CommissionChangeDelay - Notice period before a validator commission rate
+// change takes effect
+func (k Keeper) CommissionChangeDelay(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyCommissionChangeDelay, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -50,6 +63,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxValidators(ctx),
 		k.MaxEntries(ctx),
 		k.BondDenom(ctx),
+		k.MinCommissionRate(ctx),
+		k.CommissionChangeDelay(ctx),
 	)
 }
 
@@ -20,6 +20,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryValidators(ctx, req, k)
 		case types.QueryValidator:
 			return queryValidator(ctx, req, k)
+		case types.QueryValidatorPendingCommission:
+			return queryValidatorPendingCommission(ctx, req, k)
 		case types.QueryValidatorDelegations:
 			return queryValidatorDelegations(ctx, req, k)
 		case types.QueryValidatorUnbondingDelegations:
@@ -34,6 +36,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDelegatorUnbondingDelegations(ctx, req, k)
 		case types.QueryRedelegations:
 			return queryRedelegations(ctx, req, k)
+		case types.QueryRedelegationRestrictions:
+			return queryRedelegationRestrictions(ctx, req, k)
+		case types.QueryDelegatorSlashRecords:
+			return queryDelegatorSlashRecords(ctx, req, k)
 		case types.QueryDelegatorValidators:
 			return queryDelegatorValidators(ctx, req, k)
 		case types.QueryDelegatorValidator:
@@ -101,6 +107,27 @@ func queryValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, s
 	return res, nil
 }
 
+func queryValidatorPendingCommission(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	change, found := k.GetPendingCommissionChange(ctx, params.ValidatorAddr)
+	if !found {
+		return nil, types.ErrNoPendingCommissionChange(types.DefaultCodespace)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, change)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
 func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryValidatorParams
 
@@ -181,6 +208,24 @@ func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 	return res, nil
 }
 
+func queryDelegatorSlashRecords(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	records := k.GetDelegatorSlashRecords(ctx, params.DelegatorAddr)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
 func queryDelegatorValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryDelegatorParams
 
@@ -305,6 +350,29 @@ func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 	return res, nil
 }
 
+func queryRedelegationRestrictions(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryRedelegationRestrictionsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	restrictions, sdkErr := k.GetRedelegationRestrictions(
+		ctx, params.DelegatorAddr, params.SrcValidatorAddr, params.Destinations,
+	)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, restrictions)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
 func queryPool(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	bondDenom := k.BondDenom(ctx)
 	bondedPool := k.GetBondedPool(ctx)
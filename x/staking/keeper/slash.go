@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -9,7 +10,8 @@ import (
 
 // Slash a validator for an infraction committed at a known height
 // Find the contributing stake at that height and burn the specified slashFactor
-// of it, updating unbonding delegations & redelegations appropriately
+// of it, updating unbonding delegations & redelegations appropriately and
+// recording the tokens slashed from each affected delegator
 //
 // CONTRACT:
 //    slashFactor is non-negative
@@ -115,6 +117,18 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
 	}
 
+	// Record the tokens each delegator loses from its delegation
+	if tokensToBurn.IsPositive() && validator.DelegatorShares.IsPositive() {
+		for _, delegation := range k.GetValidatorDelegations(ctx, operatorAddress) {
+			amount := delegation.Shares.MulInt(tokensToBurn).QuoTruncate(validator.DelegatorShares).TruncateInt()
+			if amount.IsZero() {
+				continue
+			}
+			k.addDelegatorSlash(ctx, delegation.DelegatorAddress, operatorAddress,
+				infractionHeight, slashFactor, amount, sdk.ZeroInt(), sdk.ZeroInt())
+		}
+	}
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
@@ -212,6 +226,21 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		panic(err)
 	}
 
+	if burnedAmount.IsPositive() {
+		k.addDelegatorSlash(ctx, unbondingDelegation.DelegatorAddress, unbondingDelegation.ValidatorAddress,
+			infractionHeight, slashFactor, sdk.ZeroInt(), burnedAmount, sdk.ZeroInt())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashUnbonding,
+				sdk.NewAttribute(types.AttributeKeyDelegator, unbondingDelegation.DelegatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, unbondingDelegation.ValidatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", infractionHeight)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burnedAmount.String()),
+			),
+		)
+	}
+
 	return totalSlashAmount
 }
 
@@ -290,5 +319,139 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 		panic(err)
 	}
 
+	burnedAmount := bondedBurnedAmount.Add(notBondedBurnedAmount)
+	if burnedAmount.IsPositive() {
+		k.addDelegatorSlash(ctx, redelegation.DelegatorAddress, redelegation.ValidatorSrcAddress,
+			infractionHeight, slashFactor, sdk.ZeroInt(), sdk.ZeroInt(), burnedAmount)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashRedelegation,
+				sdk.NewAttribute(types.AttributeKeyDelegator, redelegation.DelegatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeySrcValidator, redelegation.ValidatorSrcAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyDstValidator, redelegation.ValidatorDstAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", infractionHeight)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burnedAmount.String()),
+			),
+		)
+	}
+
 	return totalSlashAmount
 }
+
+//_______________________________________________________________________
+// Delegator Slash Records
+
+// get the slash records of a delegator, oldest first
+func (k Keeper) GetDelegatorSlashRecords(ctx sdk.Context, delAddr sdk.AccAddress) (records types.DelegatorSlashRecords) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegatorSlashRecordsKey(delAddr))
+	defer iterator.Close()
+
+	records = types.DelegatorSlashRecords{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DelegatorSlashRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	// records are keyed by validator first, order them by height
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Height < records[j].Height
+	})
+	return records
+}
+
+// set a slash record under the given sequence of its validator and height
+func (k Keeper) setDelegatorSlashRecord(ctx sdk.Context, record types.DelegatorSlashRecord, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(types.GetDelegatorSlashRecordKey(record.DelegatorAddress, record.ValidatorAddress, record.Height, seq), bz)
+}
+
+// append a slash record to the slash records of its delegator, pruning the
+// oldest records of the delegator with the validator beyond
+// MaxDelegatorSlashRecords
+func (k Keeper) AppendDelegatorSlashRecord(ctx sdk.Context, record types.DelegatorSlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegatorSlashRecordsByHeightKey(
+		record.DelegatorAddress, record.ValidatorAddress, record.Height))
+
+	var seq uint64
+	for ; iterator.Valid(); iterator.Next() {
+		seq++
+	}
+	iterator.Close()
+
+	k.setDelegatorSlashRecord(ctx, record, seq)
+	k.pruneDelegatorSlashRecords(ctx, record.DelegatorAddress, record.ValidatorAddress)
+}
+
+// delete the oldest slash records of a delegator with a validator beyond
+// MaxDelegatorSlashRecords
+func (k Keeper) pruneDelegatorSlashRecords(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegatorSlashRecordsByValKey(delAddr, valAddr))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i := 0; i < len(keys)-types.MaxDelegatorSlashRecords; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+// iterate through the slash records of all delegators
+func (k Keeper) IterateDelegatorSlashRecords(ctx sdk.Context,
+	cb func(record types.DelegatorSlashRecord) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegatorSlashRecordsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DelegatorSlashRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
+
+// add tokens slashed from a delegator to its slash records. Amounts slashed
+// by the same slash of a validator are accumulated into a single record.
+func (k Keeper) addDelegatorSlash(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	infractionHeight int64, slashFactor sdk.Dec, bonded, unbonding, redelegating sdk.Int) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegatorSlashRecordsByHeightKey(
+		delAddr, valAddr, ctx.BlockHeight()))
+
+	var (
+		key    []byte
+		record types.DelegatorSlashRecord
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if record.InfractionHeight == infractionHeight && record.SlashFactor.Equal(slashFactor) {
+			key = iterator.Key()
+			break
+		}
+	}
+	iterator.Close()
+
+	if key != nil {
+		record.BondedAmount = record.BondedAmount.Add(bonded)
+		record.UnbondingAmount = record.UnbondingAmount.Add(unbonding)
+		record.RedelegatingAmount = record.RedelegatingAmount.Add(redelegating)
+		store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(record))
+		return
+	}
+
+	record = types.NewDelegatorSlashRecord(delAddr, valAddr, ctx.BlockHeight(), infractionHeight,
+		slashFactor, bonded, unbonding, redelegating)
+	k.AppendDelegatorSlashRecord(ctx, record)
+}
@@ -550,3 +550,118 @@ func TestSlashBoth(t *testing.T) {
 	// power not decreased, all stake was bonded since
 	require.Equal(t, int64(10), validator.GetConsensusPower())
 }
+
+// tests the slash records of the delegators affected by a slash
+func TestSlashDelegatorSlashRecords(t *testing.T) {
+	ctx, keeper, _ := setupHelper(t, 10)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	bondDenom := keeper.BondDenom(ctx)
+
+	// delegation holding half of the shares of the slashed validator
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	del := types.NewDelegation(addrDels[1], addrVals[0], validator.DelegatorShares.QuoInt64(2))
+	keeper.SetDelegation(ctx, del)
+
+	// redelegation away from the slashed validator and its associated delegation
+	rdTokens := sdk.TokensFromConsensusPower(2)
+	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11,
+		time.Unix(0, 0), rdTokens, rdTokens.ToDec())
+	keeper.SetRedelegation(ctx, rd)
+	keeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[1], rdTokens.ToDec()))
+
+	// unbonding delegation from the slashed validator
+	ubdTokens := sdk.TokensFromConsensusPower(2)
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11, time.Unix(0, 0), ubdTokens)
+	keeper.SetUnbondingDelegation(ctx, ubd)
+
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	require.NoError(t, notBondedPool.SetCoins(sdk.NewCoins(sdk.NewCoin(bondDenom, ubdTokens))))
+	keeper.supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// slash validator
+	ctx = ctx.WithBlockHeight(12)
+	keeper.Slash(ctx, sdk.ConsAddress(PKs[0].Address()), 10, 10, fraction)
+
+	// half of the entries is slashed, the rest of the slash amount is taken
+	// from the validator's bonded tokens
+	unbondingAmount := fraction.MulInt(ubdTokens).TruncateInt()
+	redelegatingAmount := fraction.MulInt(rdTokens).TruncateInt()
+	bondedAmount := fraction.MulInt(sdk.TokensFromConsensusPower(10)).TruncateInt().
+		Sub(unbondingAmount).Sub(redelegatingAmount).QuoRaw(2)
+
+	records := keeper.GetDelegatorSlashRecords(ctx, addrDels[0])
+	require.Equal(t, types.DelegatorSlashRecords{
+		types.NewDelegatorSlashRecord(addrDels[0], addrVals[0], 12, 10, fraction,
+			sdk.ZeroInt(), unbondingAmount, redelegatingAmount),
+	}, records)
+	require.True(sdk.IntEq(t, unbondingAmount.Add(redelegatingAmount), records[0].Total()))
+
+	records = keeper.GetDelegatorSlashRecords(ctx, addrDels[1])
+	require.Equal(t, types.DelegatorSlashRecords{
+		types.NewDelegatorSlashRecord(addrDels[1], addrVals[0], 12, 10, fraction,
+			bondedAmount, sdk.ZeroInt(), sdk.ZeroInt()),
+	}, records)
+
+	// unaffected delegator has no records
+	require.Empty(t, keeper.GetDelegatorSlashRecords(ctx, sdk.AccAddress(addrVals[2])))
+
+	// slashing of the unbonding delegation and redelegation emits events
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Contains(t, eventTypes, types.EventTypeSlashUnbonding)
+	require.Contains(t, eventTypes, types.EventTypeSlashRedelegation)
+
+	// a later slash is recorded separately
+	ctx = ctx.WithBlockHeight(13)
+	keeper.Slash(ctx, sdk.ConsAddress(PKs[0].Address()), 13, 10, fraction)
+	records = keeper.GetDelegatorSlashRecords(ctx, addrDels[1])
+	require.Len(t, records, 2)
+	require.Equal(t, int64(13), records[1].Height)
+	require.True(t, records[1].BondedAmount.IsPositive())
+}
+
+func TestDelegatorSlashRecordsPruning(t *testing.T) {
+	ctx, keeper, _ := setupHelper(t, 10)
+	fraction := sdk.NewDecWithPrec(5, 1)
+	one := sdk.OneInt()
+
+	// amounts of the same slash are accumulated, a different slash at the
+	// same height gets its own record
+	ctx = ctx.WithBlockHeight(10)
+	keeper.addDelegatorSlash(ctx, addrDels[0], addrVals[0], 5, fraction, one, sdk.ZeroInt(), sdk.ZeroInt())
+	keeper.addDelegatorSlash(ctx, addrDels[0], addrVals[0], 5, fraction, sdk.ZeroInt(), one, sdk.ZeroInt())
+	keeper.addDelegatorSlash(ctx, addrDels[0], addrVals[0], 7, fraction, one, sdk.ZeroInt(), sdk.ZeroInt())
+	require.Equal(t, types.DelegatorSlashRecords{
+		types.NewDelegatorSlashRecord(addrDels[0], addrVals[0], 10, 5, fraction, one, one, sdk.ZeroInt()),
+		types.NewDelegatorSlashRecord(addrDels[0], addrVals[0], 10, 7, fraction, one, sdk.ZeroInt(), sdk.ZeroInt()),
+	}, keeper.GetDelegatorSlashRecords(ctx, addrDels[0]))
+
+	// records with another validator are kept separately
+	ctx = ctx.WithBlockHeight(11)
+	keeper.addDelegatorSlash(ctx, addrDels[0], addrVals[1], 5, fraction, one, sdk.ZeroInt(), sdk.ZeroInt())
+
+	// only the latest records with the first validator are kept, pruning the
+	// oldest one
+	for height := int64(12); height < 11+types.MaxDelegatorSlashRecords; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.addDelegatorSlash(ctx, addrDels[0], addrVals[0], 5, fraction, one, sdk.ZeroInt(), sdk.ZeroInt())
+	}
+
+	records := keeper.GetDelegatorSlashRecords(ctx, addrDels[0])
+	require.Len(t, records, types.MaxDelegatorSlashRecords+1)
+	require.Equal(t, types.NewDelegatorSlashRecord(addrDels[0], addrVals[0], 10, 7, fraction,
+		one, sdk.ZeroInt(), sdk.ZeroInt()), records[0])
+	require.Equal(t, addrVals[1], records[1].ValidatorAddress)
+	require.Equal(t, int64(10+types.MaxDelegatorSlashRecords), records[len(records)-1].Height)
+
+	var count int
+	keeper.IterateDelegatorSlashRecords(ctx, func(types.DelegatorSlashRecord) bool {
+		count++
+		return false
+	})
+	require.Equal(t, len(records), count)
+}
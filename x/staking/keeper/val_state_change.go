@@ -6,6 +6,8 @@ import (
 	"sort"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -35,6 +37,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Retrieve the consensus pubkeys replaced since the last update, as
+	// Tendermint still knows those validators by their old key.
+	rotated := k.getConsPubKeyRotationsByAddr(ctx)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := sdk.KVStoreReversePrefixIterator(store, types.ValidatorsByPowerIndexKey)
 	defer iterator.Close()
@@ -80,8 +86,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower()
 		newPowerBytes := k.cdc.MustMarshalBinaryLengthPrefixed(newPower)
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		oldPubKey, isRotated := rotated[valAddrBytes]
+
+		switch {
+		case found && isRotated:
+			// replace the old consensus key with the new one
+			updates = append(updates, abciValidatorUpdateZero(oldPubKey), validator.ABCIValidatorUpdate())
+
+			// set validator power on lookup index
+			k.SetLastValidatorPower(ctx, valAddr, newPower)
+
+		case !found || !bytes.Equal(oldPowerBytes, newPowerBytes):
+			// update the validator set if power has changed
 			updates = append(updates, validator.ABCIValidatorUpdate())
 
 			// set validator power on lookup index
@@ -112,10 +128,19 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		// delete from the bonded validator index
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
 
-		// update the validator set
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+		// update the validator set, using the consensus key known to Tendermint
+		var valAddr [sdk.AddrLen]byte
+		copy(valAddr[:], valAddrBytes)
+		if oldPubKey, isRotated := rotated[valAddr]; isRotated {
+			updates = append(updates, abciValidatorUpdateZero(oldPubKey))
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
+	// the rotated consensus keys have now been communicated to Tendermint
+	k.deleteConsPubKeyRotations(ctx)
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
@@ -296,3 +321,12 @@ func sortNoLongerBonded(last validatorsByAddr) [][]byte {
 	})
 	return noLongerBonded
 }
+
+// validator update removing a consensus pubkey that is no longer used by its
+// validator from the Tendermint validator set
+func abciValidatorUpdateZero(pubKey crypto.PubKey) abci.ValidatorUpdate {
+	return abci.ValidatorUpdate{
+		PubKey: tmtypes.TM2PB.PubKey(pubKey),
+		Power:  0,
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -165,6 +167,10 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, err
 	}
 
+	if minRate := k.MinCommissionRate(ctx); newRate.LT(minRate) {
+		return commission, types.ErrCommissionLTMinRate(k.Codespace(), minRate)
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
@@ -196,6 +202,8 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(sdk.ConsAddress(validator.ConsPubKey.Address())))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator))
+	store.Delete(types.GetConsPubKeyRotationKey(address))
+	k.removeRotatedConsAddrs(ctx, address)
 
 	// call hooks
 	k.AfterValidatorRemoved(ctx, validator.ConsAddress(), validator.OperatorAddress)
@@ -444,3 +452,386 @@ func (k Keeper) UnbondAllMatureValidatorQueue(ctx sdk.Context) {
 		store.Delete(validatorTimesliceIterator.Key())
 	}
 }
+
+//_______________________________________________________________________
+// Commission Change Queue
+
+// get a validator's pending commission change
+func (k Keeper) GetPendingCommissionChange(ctx sdk.Context,
+	valAddr sdk.ValAddress) (change types.PendingCommissionChange, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingCommissionKey(valAddr))
+	if bz == nil {
+		return change, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &change)
+	return change, true
+}
+
+// set a validator's pending commission change
+func (k Keeper) SetPendingCommissionChange(ctx sdk.Context, change types.PendingCommissionChange) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(change)
+	store.Set(types.GetPendingCommissionKey(change.ValidatorAddress), bz)
+}
+
+// remove a validator's pending commission change
+func (k Keeper) RemovePendingCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingCommissionKey(valAddr))
+}
+
+// iterate through all pending commission changes
+func (k Keeper) IteratePendingCommissionChanges(ctx sdk.Context,
+	cb func(change types.PendingCommissionChange) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingCommissionKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.PendingCommissionChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &change)
+		if cb(change) {
+			break
+		}
+	}
+}
+
+// gets a specific commission change queue timeslice. A timeslice is a slice of
+// ValAddresses corresponding to validators whose commission changes take effect
+// at a certain time.
+func (k Keeper) GetCommissionQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCommissionQueueTimeKey(timestamp))
+	if bz == nil {
+		return []sdk.ValAddress{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &valAddrs)
+	return valAddrs
+}
+
+// Sets a specific commission change queue timeslice.
+func (k Keeper) SetCommissionQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	store.Set(types.GetCommissionQueueTimeKey(timestamp), bz)
+}
+
+// Insert a pending commission change to the appropriate timeslice in the
+// commission change queue
+func (k Keeper) InsertCommissionQueue(ctx sdk.Context, change types.PendingCommissionChange) {
+	timeSlice := k.GetCommissionQueueTimeSlice(ctx, change.EffectiveTime)
+	timeSlice = append(timeSlice, change.ValidatorAddress)
+	k.SetCommissionQueueTimeSlice(ctx, change.EffectiveTime, timeSlice)
+}
+
+// Returns all the commission change queue timeslices from time 0 until endTime
+func (k Keeper) CommissionQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.CommissionQueueKey, sdk.InclusiveEndBytes(types.GetCommissionQueueTimeKey(endTime)))
+}
+
+// ScheduleCommissionChange validates a new commission rate for a validator and
+// records it as a pending change taking effect after the commission change
+// delay. The returned commission keeps the current rate but carries the new
+// update time, so that the daily change limit applies from the time the change
+// was requested.
+func (k Keeper) ScheduleCommissionChange(ctx sdk.Context, validator types.Validator,
+	newRate sdk.Dec) (types.Commission, types.PendingCommissionChange, sdk.Error) {
+
+	commission := validator.Commission
+	if _, found := k.GetPendingCommissionChange(ctx, validator.OperatorAddress); found {
+		return commission, types.PendingCommissionChange{}, types.ErrCommissionChangePending(k.Codespace())
+	}
+
+	updated, err := k.UpdateValidatorCommission(ctx, validator, newRate)
+	if err != nil {
+		return commission, types.PendingCommissionChange{}, err
+	}
+
+	commission.UpdateTime = updated.UpdateTime
+	change := types.NewPendingCommissionChange(
+		validator.OperatorAddress, newRate, updated.UpdateTime.Add(k.CommissionChangeDelay(ctx)),
+	)
+	k.SetPendingCommissionChange(ctx, change)
+	k.InsertCommissionQueue(ctx, change)
+
+	return commission, change, nil
+}
+
+// ApplyAllMatureCommissionChanges applies all the pending commission changes
+// whose effective time has passed and returns them. Changes to a rate below the
+// current minimum commission rate are dropped and returned as rejected.
+func (k Keeper) ApplyAllMatureCommissionChanges(ctx sdk.Context) (applied, rejected []types.PendingCommissionChange) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockHeader().Time
+	minRate := k.MinCommissionRate(ctx)
+	commissionTimesliceIterator := k.CommissionQueueIterator(ctx, blockTime)
+	defer commissionTimesliceIterator.Close()
+
+	for ; commissionTimesliceIterator.Valid(); commissionTimesliceIterator.Next() {
+		timeslice := []sdk.ValAddress{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(commissionTimesliceIterator.Value(), &timeslice)
+
+		for _, valAddr := range timeslice {
+			change, found := k.GetPendingCommissionChange(ctx, valAddr)
+			if !found {
+				continue
+			}
+			k.RemovePendingCommissionChange(ctx, valAddr)
+
+			// the validator may have been removed while the change was pending
+			validator, found := k.GetValidator(ctx, valAddr)
+			if !found {
+				continue
+			}
+
+			// the commission floor may have been raised while the change was pending
+			if change.Rate.LT(minRate) {
+				k.Logger(ctx).Info(fmt.Sprintf(
+					"rejected commission change of validator %s to %s, below the minimum commission rate %s",
+					valAddr, change.Rate, minRate))
+				rejected = append(rejected, change)
+				continue
+			}
+
+			// call the before-modification hook since we're about to update the commission
+			k.BeforeValidatorModified(ctx, valAddr)
+
+			validator.Commission.Rate = change.Rate
+			validator.Commission.UpdateTime = blockTime
+			k.SetValidator(ctx, validator)
+
+			applied = append(applied, change)
+		}
+
+		store.Delete(commissionTimesliceIterator.Key())
+	}
+
+	return applied, rejected
+}
+
+//_______________________________________________________________________
+// Consensus Key Rotation
+
+// get the consensus pubkey a validator used before it was rotated in the
+// current block
+func (k Keeper) GetConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (oldPubKey crypto.PubKey, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConsPubKeyRotationKey(valAddr))
+	if bz == nil {
+		return nil, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &oldPubKey)
+	return oldPubKey, true
+}
+
+// set the consensus pubkey a validator used before it was rotated
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey crypto.PubKey) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(oldPubKey)
+	store.Set(types.GetConsPubKeyRotationKey(valAddr), bz)
+}
+
+// get all consensus pubkeys replaced since the last validator set update,
+// keyed by validator operator address
+func (k Keeper) getConsPubKeyRotationsByAddr(ctx sdk.Context) map[[sdk.AddrLen]byte]crypto.PubKey {
+	rotations := make(map[[sdk.AddrLen]byte]crypto.PubKey)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var valAddr [sdk.AddrLen]byte
+		copy(valAddr[:], iterator.Key()[1:])
+		var oldPubKey crypto.PubKey
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &oldPubKey)
+		rotations[valAddr] = oldPubKey
+	}
+	return rotations
+}
+
+// delete all the consensus pubkey rotations recorded since the last validator
+// set update
+func (k Keeper) deleteConsPubKeyRotations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// gets a specific rotated consensus address queue timeslice. A timeslice is a
+// slice of ConsAddresses which stop resolving to their validator at a certain
+// time.
+func (k Keeper) GetRotatedConsAddrQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (consAddrs []sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRotatedConsAddrQueueTimeKey(timestamp))
+	if bz == nil {
+		return []sdk.ConsAddress{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &consAddrs)
+	return consAddrs
+}
+
+// Sets a specific rotated consensus address queue timeslice.
+func (k Keeper) SetRotatedConsAddrQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	store.Set(types.GetRotatedConsAddrQueueTimeKey(timestamp), bz)
+}
+
+// Insert a rotated consensus address to the appropriate timeslice in the
+// rotated consensus address queue
+func (k Keeper) InsertRotatedConsAddrQueue(ctx sdk.Context, consAddr sdk.ConsAddress, releaseTime time.Time) {
+	timeSlice := k.GetRotatedConsAddrQueueTimeSlice(ctx, releaseTime)
+	timeSlice = append(timeSlice, consAddr)
+	k.SetRotatedConsAddrQueueTimeSlice(ctx, releaseTime, timeSlice)
+}
+
+// Returns all the rotated consensus address queue timeslices from time 0 until
+// endTime
+func (k Keeper) RotatedConsAddrQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.RotatedConsAddrQueueKey, sdk.InclusiveEndBytes(types.GetRotatedConsAddrQueueTimeKey(endTime)))
+}
+
+// IterateRotatedConsAddresses iterates through all the consensus addresses that
+// still resolve to a validator after it rotated its consensus pubkey
+func (k Keeper) IterateRotatedConsAddresses(ctx sdk.Context, cb func(rotated types.RotatedConsAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RotatedConsAddrQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		releaseTime, err := sdk.ParseTimeBytes(iterator.Key()[1:])
+		if err != nil {
+			panic(err)
+		}
+
+		var consAddrs []sdk.ConsAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &consAddrs)
+		for _, consAddr := range consAddrs {
+			valAddr := store.Get(types.GetValidatorByConsAddrKey(consAddr))
+			rotated := types.RotatedConsAddress{
+				ConsAddress:      consAddr,
+				ValidatorAddress: valAddr,
+				ReleaseTime:      releaseTime,
+			}
+			if cb(rotated) {
+				return
+			}
+		}
+	}
+}
+
+// SetRotatedConsAddress makes a rotated consensus address resolve to its
+// validator until the release time.
+func (k Keeper) SetRotatedConsAddress(ctx sdk.Context, rotated types.RotatedConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorByConsAddrKey(rotated.ConsAddress), rotated.ValidatorAddress)
+	k.InsertRotatedConsAddrQueue(ctx, rotated.ConsAddress, rotated.ReleaseTime)
+}
+
+// removeRotatedConsAddrs stops resolving all the rotated consensus addresses
+// of a validator and removes them from the rotated consensus address queue
+func (k Keeper) removeRotatedConsAddrs(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RotatedConsAddrQueueKey)
+	defer iterator.Close()
+
+	updated := make(map[string][]sdk.ConsAddress)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var consAddrs []sdk.ConsAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &consAddrs)
+
+		remaining := []sdk.ConsAddress{}
+		for _, consAddr := range consAddrs {
+			if bytes.Equal(store.Get(types.GetValidatorByConsAddrKey(consAddr)), valAddr) {
+				store.Delete(types.GetValidatorByConsAddrKey(consAddr))
+				continue
+			}
+			remaining = append(remaining, consAddr)
+		}
+		if len(remaining) != len(consAddrs) {
+			keys = append(keys, iterator.Key())
+			updated[string(iterator.Key())] = remaining
+		}
+	}
+
+	for _, key := range keys {
+		if remaining := updated[string(key)]; len(remaining) > 0 {
+			store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(remaining))
+		} else {
+			store.Delete(key)
+		}
+	}
+}
+
+// RotateConsPubKey replaces the consensus pubkey of a validator. The old
+// consensus address keeps resolving to the validator for an unbonding period so
+// that evidence of infractions committed with the old key can still be
+// slashed. Tendermint is informed of the new key in the next validator set
+// update.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress, newPubKey crypto.PubKey) sdk.Error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the new key must not belong to any current or recently rotated validator key
+	store := ctx.KVStore(k.storeKey)
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+	if store.Has(types.GetValidatorByConsAddrKey(newConsAddr)) {
+		return types.ErrValidatorPubKeyExists(k.Codespace())
+	}
+
+	// only one rotation may happen between two validator set updates, since
+	// Tendermint only knows about the key used before the first one
+	if _, found := k.GetConsPubKeyRotation(ctx, valAddr); found {
+		return types.ErrConsPubKeyRotationPending(k.Codespace())
+	}
+
+	oldConsAddr := validator.ConsAddress()
+	k.SetConsPubKeyRotation(ctx, valAddr, validator.ConsPubKey)
+
+	validator.ConsPubKey = newPubKey
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+
+	releaseTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	k.InsertRotatedConsAddrQueue(ctx, oldConsAddr, releaseTime)
+
+	k.AfterValidatorConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+
+	return nil
+}
+
+// ReleaseAllMatureRotatedConsAddrs removes the validator index of all rotated
+// consensus addresses whose release time has passed.
+func (k Keeper) ReleaseAllMatureRotatedConsAddrs(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	rotatedTimesliceIterator := k.RotatedConsAddrQueueIterator(ctx, ctx.BlockHeader().Time)
+	defer rotatedTimesliceIterator.Close()
+
+	for ; rotatedTimesliceIterator.Valid(); rotatedTimesliceIterator.Next() {
+		timeslice := []sdk.ConsAddress{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(rotatedTimesliceIterator.Value(), &timeslice)
+
+		for _, consAddr := range timeslice {
+			store.Delete(types.GetValidatorByConsAddrKey(consAddr))
+		}
+
+		store.Delete(rotatedTimesliceIterator.Key())
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -1084,3 +1085,99 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+func TestRotateConsPubKey(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	powers := []int64{100, 100}
+	var validators [2]types.Validator
+	for i, power := range powers {
+		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		tokens := sdk.TokensFromConsensusPower(power)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
+		validators[i] = TestingUpdateValidator(keeper, ctx, validators[i], false)
+		keeper.SetValidatorByConsAddr(ctx, validators[i])
+	}
+	require.Equal(t, 2, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
+
+	oldPubKey, newPubKey := PKs[0], PKs[2]
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPubKey.Address()), sdk.ConsAddress(newPubKey.Address())
+
+	// the new key must not be used by another validator
+	err := keeper.RotateConsPubKey(ctx, validators[0].OperatorAddress, PKs[1])
+	require.Error(t, err)
+
+	err = keeper.RotateConsPubKey(ctx, validators[0].OperatorAddress, newPubKey)
+	require.NoError(t, err)
+
+	// both consensus addresses resolve to the validator
+	validator, found := keeper.GetValidatorByConsAddr(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newPubKey, validator.ConsPubKey)
+	_, found = keeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.True(t, found)
+
+	// only one rotation is allowed between validator set updates
+	err = keeper.RotateConsPubKey(ctx, validators[0].OperatorAddress, PKs[3])
+	require.Error(t, err)
+
+	// the rotated out key cannot be reused while it is still indexed
+	err = keeper.RotateConsPubKey(ctx, validators[1].OperatorAddress, oldPubKey)
+	require.Error(t, err)
+
+	// Tendermint removes the old key and adds the new one
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 2, len(updates))
+	require.Equal(t, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0}, updates[0])
+	require.Equal(t, validator.ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
+
+	// a validator leaving the set in the block it rotated is removed by its old key
+	err = keeper.RotateConsPubKey(ctx, validators[1].OperatorAddress, PKs[3])
+	require.NoError(t, err)
+	keeper.Jail(ctx, sdk.ConsAddress(PKs[3].Address()))
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+	require.Equal(t, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(PKs[1]), Power: 0}, updates[0])
+
+	// the old consensus address is released after the unbonding period
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(keeper.UnbondingTime(ctx)))
+	keeper.ReleaseAllMatureRotatedConsAddrs(ctx)
+	_, found = keeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.False(t, found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, newConsAddr)
+	require.True(t, found)
+}
+
+func TestRemoveValidatorWithRotatedConsPubKey(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	validator := types.NewValidator(sdk.ValAddress(Addrs[0]), PKs[0], types.Description{})
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	require.NoError(t, keeper.RotateConsPubKey(ctx, validator.OperatorAddress, PKs[1]))
+	keeper.RemoveValidator(ctx, validator.OperatorAddress)
+
+	// neither the current nor the rotated out consensus address resolve to
+	// the removed validator
+	_, found := keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(PKs[0].Address()))
+	require.False(t, found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(PKs[1].Address()))
+	require.False(t, found)
+	_, found = keeper.GetConsPubKeyRotation(ctx, validator.OperatorAddress)
+	require.False(t, found)
+
+	rotated := 0
+	keeper.IterateRotatedConsAddresses(ctx, func(types.RotatedConsAddress) bool {
+		rotated++
+		return false
+	})
+	require.Zero(t, rotated)
+
+	// the rotated out key can be used by another validator right away
+	validator = types.NewValidator(sdk.ValAddress(Addrs[1]), PKs[2], types.Description{})
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	require.NoError(t, keeper.RotateConsPubKey(ctx, validator.OperatorAddress, PKs[0]))
+}
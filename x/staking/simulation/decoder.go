@@ -51,6 +51,18 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &redB)
 		return fmt.Sprintf("%v\n%v", redA, redB)
 
+	case bytes.Equal(kvA.Key[:1], types.PendingCommissionKey):
+		var changeA, changeB types.PendingCommissionChange
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &changeA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &changeB)
+		return fmt.Sprintf("%v\n%v", changeA, changeB)
+
+	case bytes.Equal(kvA.Key[:1], types.DelegatorSlashRecordsKey):
+		var recordA, recordB types.DelegatorSlashRecord
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
 	default:
 		panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 	}
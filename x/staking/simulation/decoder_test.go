@@ -39,6 +39,7 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	record := types.NewDelegatorSlashRecord(delAddr1, valAddr1, 20, 10, sdk.NewDecWithPrec(5, 2), sdk.OneInt(), sdk.ZeroInt(), sdk.ZeroInt())
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: types.LastTotalPowerKey, Value: cdc.MustMarshalBinaryLengthPrefixed(sdk.OneInt())},
@@ -47,6 +48,7 @@ func TestDecodeStore(t *testing.T) {
 		cmn.KVPair{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(del)},
 		cmn.KVPair{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(ubd)},
 		cmn.KVPair{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(red)},
+		cmn.KVPair{Key: types.GetDelegatorSlashRecordKey(delAddr1, valAddr1, 20, 0), Value: cdc.MustMarshalBinaryLengthPrefixed(record)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -60,6 +62,7 @@ func TestDecodeStore(t *testing.T) {
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"DelegatorSlashRecord", fmt.Sprintf("%v\n%v", record, record)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -120,3 +120,30 @@ func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) sdk.Er
 
 	return nil
 }
+
+// PendingCommissionChange defines a validator commission rate change that
+// takes effect once the commission change delay has elapsed.
+type PendingCommissionChange struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // validator operator address
+	Rate             sdk.Dec        `json:"rate" yaml:"rate"`                           // the new commission rate
+	EffectiveTime    time.Time      `json:"effective_time" yaml:"effective_time"`       // time at which the new rate is applied
+}
+
+// NewPendingCommissionChange returns an initialized pending commission change.
+func NewPendingCommissionChange(valAddr sdk.ValAddress, rate sdk.Dec, effectiveTime time.Time) PendingCommissionChange {
+	return PendingCommissionChange{
+		ValidatorAddress: valAddr,
+		Rate:             rate,
+		EffectiveTime:    effectiveTime,
+	}
+}
+
+// String implements the Stringer interface for a PendingCommissionChange.
+func (c PendingCommissionChange) String() string {
+	return fmt.Sprintf(`Pending Commission Change:
+  Validator:      %s
+  Rate:           %s
+  Effective Time: %s`,
+		c.ValidatorAddress, c.Rate, c.EffectiveTime,
+	)
+}
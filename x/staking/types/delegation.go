@@ -469,3 +469,82 @@ func (r RedelegationResponses) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+// Reasons for which (a portion of) a delegation cannot currently be
+// redelegated.
+const (
+	// the source validator is itself the destination of a redelegation that
+	// has not yet matured; this blocks the entire source delegation
+	RestrictionTransitiveRedelegation = "transitive_redelegation"
+	// the delegator/source/destination trio already holds MaxEntries entries
+	RestrictionMaxEntries = "max_entries"
+	// the destination validator does not exist
+	RestrictionNoDstValidator = "destination_not_found"
+)
+
+// RedelegationRestriction describes a portion of a delegation that cannot be
+// redelegated at the current block, why, and when the restriction lifts.
+type RedelegationRestriction struct {
+	Reason              string         `json:"reason" yaml:"reason"`
+	ValidatorSrcAddress sdk.ValAddress `json:"validator_src_address" yaml:"validator_src_address"` // source of the blocking redelegation, if any
+	ValidatorDstAddress sdk.ValAddress `json:"validator_dst_address" yaml:"validator_dst_address"` // blocked destination, if any
+	Balance             sdk.Int        `json:"balance" yaml:"balance"`                             // tokens affected by the restriction
+	UnblockTime         time.Time      `json:"unblock_time" yaml:"unblock_time"`                   // zero if the restriction is not time bound
+}
+
+// NewRedelegationRestriction creates a new RedelegationRestriction instance.
+func NewRedelegationRestriction(reason string, valSrcAddr, valDstAddr sdk.ValAddress,
+	balance sdk.Int, unblockTime time.Time) RedelegationRestriction {
+
+	return RedelegationRestriction{
+		Reason:              reason,
+		ValidatorSrcAddress: valSrcAddr,
+		ValidatorDstAddress: valDstAddr,
+		Balance:             balance,
+		UnblockTime:         unblockTime,
+	}
+}
+
+// String returns a human readable string representation of a
+// RedelegationRestriction.
+func (r RedelegationRestriction) String() string {
+	return fmt.Sprintf(`Restriction: %s
+  Source Validator:      %s
+  Destination Validator: %s
+  Balance:               %s
+  Unblock Time:          %v`,
+		r.Reason, r.ValidatorSrcAddress, r.ValidatorDstAddress, r.Balance, r.UnblockTime,
+	)
+}
+
+// RedelegationRestrictionsResponse explains which portions of a delegation
+// can not be redelegated away from its validator and why.
+type RedelegationRestrictionsResponse struct {
+	DelegatorAddress    sdk.AccAddress            `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorSrcAddress sdk.ValAddress            `json:"validator_src_address" yaml:"validator_src_address"`
+	Balance             sdk.Int                   `json:"balance" yaml:"balance"`                 // total tokens of the delegation
+	CompletionTime      time.Time                 `json:"completion_time" yaml:"completion_time"` // completion time of a redelegation begun now
+	CompleteNow         bool                      `json:"complete_now" yaml:"complete_now"`       // true if the source validator is unbonded
+	Restrictions        []RedelegationRestriction `json:"restrictions" yaml:"restrictions"`
+}
+
+// String returns a human readable string representation of a
+// RedelegationRestrictionsResponse.
+func (r RedelegationRestrictionsResponse) String() string {
+	out := fmt.Sprintf(`Redelegation restrictions for:
+  Delegator:        %s
+  Source Validator: %s
+  Balance:          %s
+  Completion Time:  %v
+  Complete Now:     %v
+  Restrictions:
+`,
+		r.DelegatorAddress, r.ValidatorSrcAddress, r.Balance, r.CompletionTime, r.CompleteNow,
+	)
+
+	for _, restriction := range r.Restrictions {
+		out += restriction.String() + "\n"
+	}
+
+	return strings.TrimRight(out, "\n")
+}
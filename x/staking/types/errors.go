@@ -50,6 +50,10 @@ func ErrValidatorPubKeyTypeNotSupported(codespace sdk.CodespaceType, keyType str
 	return sdk.NewError(codespace, CodeInvalidValidator, msg)
 }
 
+func ErrConsPubKeyRotationPending(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "validator consensus pubkey was already rotated in this block")
+}
+
 func ErrValidatorJailed(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "validator for this address is currently jailed")
 }
@@ -79,6 +83,20 @@ func ErrCommissionUpdateTime(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "commission cannot be changed more than once in 24h")
 }
 
+func ErrCommissionLTMinRate(codespace sdk.CodespaceType, minRate sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator,
+		fmt.Sprintf("commission cannot be less than the network minimum rate of %s", minRate))
+}
+
+func ErrCommissionChangePending(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator,
+		"a commission change is already pending for this validator, wait for it to take effect")
+}
+
+func ErrNoPendingCommissionChange(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "no pending commission change found for this validator")
+}
+
 func ErrCommissionChangeRateNegative(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "commission change rate must be positive")
 }
@@ -196,6 +214,19 @@ func ErrMaxRedelegationEntries(codespace sdk.CodespaceType) sdk.Error {
 		"too many redelegation entries in this delegator/src-validator/dst-validator trio, please wait for some entries to mature")
 }
 
+func ErrNoRedelegationDestinations(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "at least one redelegation destination must be provided")
+}
+
+func ErrDuplicateRedelegationDst(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "redelegation destinations must be unique")
+}
+
+func ErrBadRedelegationWeights(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput,
+		"redelegation destination weights must be positive and sum to one")
+}
+
 func ErrDelegatorShareExRateInvalid(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation,
 		"cannot delegate to validators with invalid (zero) ex-rate")
@@ -9,13 +9,24 @@ var (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeCommissionChange     = "commission_change"
+	EventTypeCompleteCommission   = "complete_commission_change"
+	EventTypeRejectCommission     = "reject_commission_change"
+	EventTypeRotateConsPubKey     = "rotate_cons_pubkey"
+	EventTypeSlashUnbonding       = "slash_unbonding_delegation"
+	EventTypeSlashRedelegation    = "slash_redelegation"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
+	AttributeKeyMinCommissionRate = "min_commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
 	AttributeKeySrcValidator      = "source_validator"
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyEffectiveTime     = "effective_time"
+	AttributeKeyOldConsAddress    = "old_cons_address"
+	AttributeKeyNewConsAddress    = "new_cons_address"
+	AttributeKeyInfractionHeight  = "infraction_height"
 	AttributeValueCategory        = ModuleName
 )
@@ -1,19 +1,24 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	Params               Params                `json:"params" yaml:"params"`
-	LastTotalPower       sdk.Int               `json:"last_total_power" yaml:"last_total_power"`
-	LastValidatorPowers  []LastValidatorPower  `json:"last_validator_powers" yaml:"last_validator_powers"`
-	Validators           Validators            `json:"validators" yaml:"validators"`
-	Delegations          Delegations           `json:"delegations" yaml:"delegations"`
-	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
-	Redelegations        []Redelegation        `json:"redelegations" yaml:"redelegations"`
-	Exported             bool                  `json:"exported" yaml:"exported"`
+	Params                Params                    `json:"params" yaml:"params"`
+	LastTotalPower        sdk.Int                   `json:"last_total_power" yaml:"last_total_power"`
+	LastValidatorPowers   []LastValidatorPower      `json:"last_validator_powers" yaml:"last_validator_powers"`
+	Validators            Validators                `json:"validators" yaml:"validators"`
+	Delegations           Delegations               `json:"delegations" yaml:"delegations"`
+	UnbondingDelegations  []UnbondingDelegation     `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	Redelegations         []Redelegation            `json:"redelegations" yaml:"redelegations"`
+	PendingCommissions    []PendingCommissionChange `json:"pending_commission_changes" yaml:"pending_commission_changes"`
+	RotatedConsAddresses  []RotatedConsAddress      `json:"rotated_cons_addresses" yaml:"rotated_cons_addresses"`
+	DelegatorSlashRecords []DelegatorSlashRecord    `json:"delegator_slash_records" yaml:"delegator_slash_records"`
+	Exported              bool                      `json:"exported" yaml:"exported"`
 }
 
 // Last validator power, needed for validator set update logic
@@ -22,6 +27,15 @@ type LastValidatorPower struct {
 	Power   int64
 }
 
+// Consensus address a validator signed with before rotating its consensus
+// pubkey. It keeps resolving to the validator, so that evidence against the
+// old key can still be slashed, until the release time has passed.
+type RotatedConsAddress struct {
+	ConsAddress      sdk.ConsAddress `json:"cons_address" yaml:"cons_address"`
+	ValidatorAddress sdk.ValAddress  `json:"validator_address" yaml:"validator_address"`
+	ReleaseTime      time.Time       `json:"release_time" yaml:"release_time"`
+}
+
 func NewGenesisState(params Params, validators []Validator, delegations []Delegation) GenesisState {
 	return GenesisState{
 		Params:      params,
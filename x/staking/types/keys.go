@@ -24,7 +24,7 @@ const (
 	RouterKey = ModuleName
 )
 
-//nolint
+// nolint
 var (
 	// Keys for store prefixes
 	// Last* values are constant during a block.
@@ -34,6 +34,8 @@ var (
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	PendingCommissionKey      = []byte{0x24} // prefix for each key to a validator's pending commission change
+	ConsPubKeyRotationKey     = []byte{0x25} // prefix for each key to a validator's consensus pubkey replaced since the last validator set update
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -41,10 +43,13 @@ var (
 	RedelegationKey                  = []byte{0x34} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
+	DelegatorSlashRecordsKey         = []byte{0x37} // prefix for each key to a slash record of a delegator
 
-	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
-	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
-	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+	UnbondingQueueKey       = []byte{0x41} // prefix for the timestamps in unbonding queue
+	RedelegationQueueKey    = []byte{0x42} // prefix for the timestamps in redelegations queue
+	ValidatorQueueKey       = []byte{0x43} // prefix for the timestamps in validator queue
+	CommissionQueueKey      = []byte{0x44} // prefix for the timestamps in the commission change queue
+	RotatedConsAddrQueueKey = []byte{0x45} // prefix for the timestamps in the rotated consensus address queue
 )
 
 // gets the key for the validator with address
@@ -122,6 +127,31 @@ func GetValidatorQueueTimeKey(timestamp time.Time) []byte {
 	return append(ValidatorQueueKey, bz...)
 }
 
+// gets the key for the pending commission change of a validator
+// VALUE: staking/PendingCommissionChange
+func GetPendingCommissionKey(operatorAddr sdk.ValAddress) []byte {
+	return append(PendingCommissionKey, operatorAddr.Bytes()...)
+}
+
+// gets the prefix for all commission changes taking effect at a given time
+func GetCommissionQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(CommissionQueueKey, bz...)
+}
+
+// gets the key for the consensus pubkey a validator used before its last
+// rotation
+// VALUE: crypto.PubKey
+func GetConsPubKeyRotationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, operatorAddr.Bytes()...)
+}
+
+// gets the prefix for all rotated consensus addresses released at a given time
+func GetRotatedConsAddrQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(RotatedConsAddrQueueKey, bz...)
+}
+
 //______________________________________________________________________________
 
 // gets the key for delegator bond with validator
@@ -278,3 +308,33 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 		GetREDsToValDstIndexKey(valDstAddr),
 		delAddr.Bytes()...)
 }
+
+//________________________________________________________________________________
+
+// gets the prefix keyspace for the slash records of a delegator
+func GetDelegatorSlashRecordsKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorSlashRecordsKey, delAddr.Bytes()...)
+}
+
+// gets the prefix keyspace for the slash records of a delegator with a
+// slashed validator
+func GetDelegatorSlashRecordsByValKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegatorSlashRecordsKey(delAddr), valAddr.Bytes()...)
+}
+
+// gets the prefix keyspace for the slash records of a delegator with a
+// validator slashed at the given height
+func GetDelegatorSlashRecordsByHeightKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(GetDelegatorSlashRecordsByValKey(delAddr, valAddr), bz...)
+}
+
+// gets the key for a slash record of a delegator, the sequence numbering the
+// slashes of the validator at the same height
+// VALUE: staking/DelegatorSlashRecord
+func GetDelegatorSlashRecordKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, height int64, seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(GetDelegatorSlashRecordsByHeightKey(delAddr, valAddr, height), bz...)
+}
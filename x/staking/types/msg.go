@@ -3,6 +3,8 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -13,9 +15,11 @@ import (
 var (
 	_ sdk.Msg = &MsgCreateValidator{}
 	_ sdk.Msg = &MsgEditValidator{}
+	_ sdk.Msg = &MsgRotateConsPubKey{}
 	_ sdk.Msg = &MsgDelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgBeginRedelegate{}
+	_ sdk.Msg = &MsgRedelegateAll{}
 )
 
 //______________________________________________________________________
@@ -211,6 +215,72 @@ func (msg MsgEditValidator) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// MsgRotateConsPubKey - struct for replacing the consensus public key of a
+// validator
+type MsgRotateConsPubKey struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	PubKey           crypto.PubKey  `json:"pubkey" yaml:"pubkey"`
+}
+
+type msgRotateConsPubKeyJSON struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	PubKey           string         `json:"pubkey" yaml:"pubkey"`
+}
+
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, pubKey crypto.PubKey) MsgRotateConsPubKey {
+	return MsgRotateConsPubKey{
+		ValidatorAddress: valAddr,
+		PubKey:           pubKey,
+	}
+}
+
+//nolint
+func (msg MsgRotateConsPubKey) Route() string { return RouterKey }
+func (msg MsgRotateConsPubKey) Type() string  { return "rotate_cons_pubkey" }
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+// MarshalJSON implements the json.Marshaler interface to provide custom JSON
+// serialization of the MsgRotateConsPubKey type.
+func (msg MsgRotateConsPubKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(msgRotateConsPubKeyJSON{
+		ValidatorAddress: msg.ValidatorAddress,
+		PubKey:           sdk.MustBech32ifyConsPub(msg.PubKey),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to provide custom
+// JSON deserialization of the MsgRotateConsPubKey type.
+func (msg *MsgRotateConsPubKey) UnmarshalJSON(bz []byte) error {
+	var msgRotateJSON msgRotateConsPubKeyJSON
+	if err := json.Unmarshal(bz, &msgRotateJSON); err != nil {
+		return err
+	}
+
+	msg.ValidatorAddress = msgRotateJSON.ValidatorAddress
+	var err error
+	msg.PubKey, err = sdk.GetConsPubKeyBech32(msgRotateJSON.PubKey)
+	return err
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgRotateConsPubKey) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.PubKey == nil {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "consensus pubkey must be included")
+	}
+	return nil
+}
+
 // MsgDelegate - struct for bonding transactions
 type MsgDelegate struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
@@ -304,6 +374,130 @@ func (msg MsgBeginRedelegate) ValidateBasic() sdk.Error {
 	return nil
 }
 
+//______________________________________________________________________
+
+// RedelegationDestination defines a destination validator of a
+// MsgRedelegateAll along with the fraction of the source delegation it
+// receives.
+type RedelegationDestination struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Weight           sdk.Dec        `json:"weight" yaml:"weight"`
+}
+
+func NewRedelegationDestination(valAddr sdk.ValAddress, weight sdk.Dec) RedelegationDestination {
+	return RedelegationDestination{
+		ValidatorAddress: valAddr,
+		Weight:           weight,
+	}
+}
+
+// String implements the Stringer interface for a RedelegationDestination.
+func (d RedelegationDestination) String() string {
+	return fmt.Sprintf("%s:%s", d.ValidatorAddress, d.Weight)
+}
+
+// ParseRedelegationDestinations parses a list of destinations of the form
+// "<validator-addr>:<weight>", e.g. "cosmosvaloper1...:0.5".
+func ParseRedelegationDestinations(dstStrs []string) ([]RedelegationDestination, error) {
+	dsts := make([]RedelegationDestination, len(dstStrs))
+	for i, dstStr := range dstStrs {
+		parts := strings.Split(dstStr, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid redelegation destination %q, expected <validator-addr>:<weight>", dstStr)
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for redelegation destination %s: %v", parts[0], err)
+		}
+
+		dsts[i] = NewRedelegationDestination(valAddr, weight)
+	}
+
+	return dsts, nil
+}
+
+// ValidateRedelegationDestinations performs stateless validation of a set of
+// redelegation destinations from a given source validator.
+func ValidateRedelegationDestinations(valSrcAddr sdk.ValAddress, dsts []RedelegationDestination) sdk.Error {
+	if len(dsts) == 0 {
+		return ErrNoRedelegationDestinations(DefaultCodespace)
+	}
+
+	seen := make(map[string]bool, len(dsts))
+	totalWeight := sdk.ZeroDec()
+	for _, dst := range dsts {
+		if dst.ValidatorAddress.Empty() {
+			return ErrNilValidatorAddr(DefaultCodespace)
+		}
+		if dst.ValidatorAddress.Equals(valSrcAddr) {
+			return ErrSelfRedelegation(DefaultCodespace)
+		}
+		if seen[dst.ValidatorAddress.String()] {
+			return ErrDuplicateRedelegationDst(DefaultCodespace)
+		}
+		if dst.Weight.IsNil() || !dst.Weight.IsPositive() {
+			return ErrBadRedelegationWeights(DefaultCodespace)
+		}
+
+		seen[dst.ValidatorAddress.String()] = true
+		totalWeight = totalWeight.Add(dst.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return ErrBadRedelegationWeights(DefaultCodespace)
+	}
+
+	return nil
+}
+
+// MsgRedelegateAll - struct for redelegating an entire delegation from a
+// source validator to a weighted set of destination validators
+type MsgRedelegateAll struct {
+	DelegatorAddress    sdk.AccAddress            `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorSrcAddress sdk.ValAddress            `json:"validator_src_address" yaml:"validator_src_address"`
+	Destinations        []RedelegationDestination `json:"destinations" yaml:"destinations"`
+}
+
+func NewMsgRedelegateAll(delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress,
+	dsts []RedelegationDestination) MsgRedelegateAll {
+
+	return MsgRedelegateAll{
+		DelegatorAddress:    delAddr,
+		ValidatorSrcAddress: valSrcAddr,
+		Destinations:        dsts,
+	}
+}
+
+//nolint
+func (msg MsgRedelegateAll) Route() string { return RouterKey }
+func (msg MsgRedelegateAll) Type() string  { return "redelegate_all" }
+func (msg MsgRedelegateAll) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRedelegateAll) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgRedelegateAll) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorSrcAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return ValidateRedelegationDestinations(msg.ValidatorSrcAddress, msg.Destinations)
+}
+
 // MsgUndelegate - struct for unbonding transactions
 type MsgUndelegate struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
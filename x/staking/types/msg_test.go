@@ -77,6 +77,37 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic and JSON round trip for MsgRotateConsPubKey
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		pubKey        crypto.PubKey
+		expectPass    bool
+	}{
+		{"basic good", valAddr1, pk2, true},
+		{"empty address", emptyAddr, pk2, false},
+		{"empty pubkey", valAddr1, emptyPubkey, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRotateConsPubKey(tc.validatorAddr, tc.pubKey)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+
+	msg := NewMsgRotateConsPubKey(valAddr1, pk2)
+	bz, err := ModuleCdc.MarshalJSON(msg)
+	require.NoError(t, err)
+
+	var decoded MsgRotateConsPubKey
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, msg, decoded)
+}
+
 // test ValidateBasic for MsgDelegate
 func TestMsgDelegate(t *testing.T) {
 	tests := []struct {
@@ -130,6 +161,55 @@ func TestMsgBeginRedelegate(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgRedelegateAll
+func TestMsgRedelegateAll(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name             string
+		delegatorAddr    sdk.AccAddress
+		validatorSrcAddr sdk.ValAddress
+		destinations     []RedelegationDestination
+		expectPass       bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr1, []RedelegationDestination{{valAddr2, half}, {valAddr3, half}}, true},
+		{"single destination", sdk.AccAddress(valAddr1), valAddr1, []RedelegationDestination{{valAddr2, sdk.OneDec()}}, true},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, []RedelegationDestination{{valAddr2, sdk.OneDec()}}, false},
+		{"empty source validator", sdk.AccAddress(valAddr1), emptyAddr, []RedelegationDestination{{valAddr2, sdk.OneDec()}}, false},
+		{"no destinations", sdk.AccAddress(valAddr1), valAddr1, []RedelegationDestination{}, false},
+		{"empty destination validator", sdk.AccAddress(valAddr1), valAddr1, []RedelegationDestination{{emptyAddr, sdk.OneDec()}}, false},
+		{"self redelegation", sdk.AccAddress(valAddr1), valAddr1, []RedelegationDestination{{valAddr1, sdk.OneDec()}}, false},
+		{"duplicate destination", sdk.AccAddress(valAddr1), valAddr1, []RedelegationDestination{{valAddr2, half}, {valAddr2, half}}, false},
+		{"weights below one", sdk.AccAddress(valAddr1), valAddr1, []RedelegationDestination{{valAddr2, half}}, false},
+		{"weights above one", sdk.AccAddress(valAddr1), valAddr1, []RedelegationDestination{{valAddr2, sdk.OneDec()}, {valAddr3, half}}, false},
+		{"zero weight", sdk.AccAddress(valAddr1), valAddr1, []RedelegationDestination{{valAddr2, sdk.OneDec()}, {valAddr3, sdk.ZeroDec()}}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedelegateAll(tc.delegatorAddr, tc.validatorSrcAddr, tc.destinations)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestParseRedelegationDestinations(t *testing.T) {
+	dsts, err := ParseRedelegationDestinations([]string{valAddr1.String() + ":0.25", valAddr2.String() + ":0.75"})
+	require.NoError(t, err)
+	require.Equal(t, []RedelegationDestination{
+		NewRedelegationDestination(valAddr1, sdk.NewDecWithPrec(25, 2)),
+		NewRedelegationDestination(valAddr2, sdk.NewDecWithPrec(75, 2)),
+	}, dsts)
+
+	_, err = ParseRedelegationDestinations([]string{valAddr1.String()})
+	require.Error(t, err)
+
+	_, err = ParseRedelegationDestinations([]string{valAddr1.String() + ":abc"})
+	require.Error(t, err)
+}
+
 // test ValidateBasic for MsgUnbond
 func TestMsgUndelegate(t *testing.T) {
 	tests := []struct {
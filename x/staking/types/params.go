@@ -7,7 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/params"
+	params "github.com/cosmos/cosmos-sdk/x/params/subspace"
 )
 
 // Staking params default values
@@ -22,14 +22,24 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint16 = 7
+
+	// DefaultCommissionChangeDelay of zero applies commission changes
+	// immediately.
+	DefaultCommissionChangeDelay time.Duration = 0
 )
 
+// DefaultMinCommissionRate does not impose any network-wide commission floor.
+var DefaultMinCommissionRate = sdk.ZeroDec()
+
 // nolint - Keys for parameter access
 var (
 	KeyUnbondingTime = []byte("UnbondingTime")
 	KeyMaxValidators = []byte("MaxValidators")
 	KeyMaxEntries    = []byte("KeyMaxEntries")
 	KeyBondDenom     = []byte("BondDenom")
+
+	KeyMinCommissionRate     = []byte("MinCommissionRate")
+	KeyCommissionChangeDelay = []byte("CommissionChangeDelay")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -41,27 +51,34 @@ type Params struct {
 	MaxEntries    uint16        `json:"max_entries" yaml:"max_entries"`       // max entries for either unbonding delegation or redelegation (per pair/trio)
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
 	BondDenom string `json:"bond_denom" yaml:"bond_denom"` // bondable coin denomination
+
+	MinCommissionRate     sdk.Dec       `json:"min_commission_rate" yaml:"min_commission_rate"`         // network-wide minimum validator commission rate
+	CommissionChangeDelay time.Duration `json:"commission_change_delay" yaml:"commission_change_delay"` // notice period before a commission rate change takes effect
 }
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+	bondDenom string, minCommissionRate sdk.Dec, commissionChangeDelay time.Duration) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:         unbondingTime,
+		MaxValidators:         maxValidators,
+		MaxEntries:            maxEntries,
+		BondDenom:             bondDenom,
+		MinCommissionRate:     minCommissionRate,
+		CommissionChangeDelay: commissionChangeDelay,
 	}
 }
 
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{KeyUnbondingTime, &p.UnbondingTime},
-		{KeyMaxValidators, &p.MaxValidators},
-		{KeyMaxEntries, &p.MaxEntries},
-		{KeyBondDenom, &p.BondDenom},
+		params.NewParamSetPair(KeyUnbondingTime, &p.UnbondingTime, validateUnbondingTime),
+		params.NewParamSetPair(KeyMaxValidators, &p.MaxValidators, validateMaxValidators),
+		params.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
+		params.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		params.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		params.NewParamSetPair(KeyCommissionChangeDelay, &p.CommissionChangeDelay, validateCommissionChangeDelay),
 	}
 }
 
@@ -75,17 +92,23 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(
+		DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom,
+		DefaultMinCommissionRate, DefaultCommissionChangeDelay,
+	)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Unbonding Time:    %s
-  Max Validators:    %d
-  Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Unbonding Time:          %s
+  Max Validators:          %d
+  Max Entries:             %d
+  Bonded Coin Denom:       %s
+  Min Commission Rate:     %s
+  Commission Change Delay: %s`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.BondDenom,
+		p.MinCommissionRate, p.CommissionChangeDelay)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -114,5 +137,77 @@ func (p Params) Validate() error {
 	if p.MaxValidators == 0 {
 		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
 	}
+	if p.MinCommissionRate.IsNil() || p.MinCommissionRate.IsNegative() || p.MinCommissionRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MinCommissionRate must be between 0 and 1, is %s", p.MinCommissionRate)
+	}
+	if p.CommissionChangeDelay < 0 {
+		return fmt.Errorf("staking parameter CommissionChangeDelay cannot be negative: %s", p.CommissionChangeDelay)
+	}
+	return nil
+}
+
+func validateUnbondingTime(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("staking parameter UnbondingTime cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
+	}
+	return nil
+}
+
+func validateMaxEntries(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("staking parameter MaxEntries must be a positive integer")
+	}
+	return nil
+}
+
+func validateBondDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
+	}
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MinCommissionRate must be between 0 and 1, is %s", v)
+	}
+	return nil
+}
+
+func validateCommissionChangeDelay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("staking parameter CommissionChangeDelay cannot be negative: %s", v)
+	}
 	return nil
 }
@@ -1,9 +1,13 @@
 package types
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,3 +23,37 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	p := DefaultParams()
+	p.MinCommissionRate = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, p.Validate())
+
+	p = DefaultParams()
+	p.MinCommissionRate = sdk.NewDec(-1)
+	require.Error(t, p.Validate())
+
+	p = DefaultParams()
+	p.CommissionChangeDelay = -time.Second
+	require.Error(t, p.Validate())
+}
+
+func TestParamSetPairsValidators(t *testing.T) {
+	p := DefaultParams()
+	for _, pair := range p.ParamSetPairs() {
+		require.NoError(t, pair.ValidatorFn(reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()), string(pair.Key))
+	}
+
+	require.Error(t, validateMaxValidators(uint16(0)))
+	require.Error(t, validateMaxEntries(uint16(0)))
+	require.Error(t, validateBondDenom(""))
+	require.Error(t, validateUnbondingTime(-time.Second))
+	require.NoError(t, validateUnbondingTime(time.Duration(0)))
+	require.Error(t, validateMinCommissionRate(sdk.NewDec(2)))
+	require.Error(t, validateCommissionChangeDelay(-time.Second))
+
+	// values of the wrong type are rejected
+	require.Error(t, validateMaxValidators(uint32(1)))
+}
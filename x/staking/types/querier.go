@@ -11,15 +11,18 @@ const (
 	QueryDelegatorDelegations          = "delegatorDelegations"
 	QueryDelegatorUnbondingDelegations = "delegatorUnbondingDelegations"
 	QueryRedelegations                 = "redelegations"
+	QueryRedelegationRestrictions      = "redelegationRestrictions"
 	QueryValidatorDelegations          = "validatorDelegations"
 	QueryValidatorRedelegations        = "validatorRedelegations"
 	QueryValidatorUnbondingDelegations = "validatorUnbondingDelegations"
+	QueryValidatorPendingCommission    = "validatorPendingCommission"
 	QueryDelegation                    = "delegation"
 	QueryUnbondingDelegation           = "unbondingDelegation"
 	QueryDelegatorValidators           = "delegatorValidators"
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryDelegatorSlashRecords         = "delegatorSlashRecords"
 )
 
 // defines the params for the following queries:
@@ -27,6 +30,7 @@ const (
 // - 'custom/staking/delegatorUnbondingDelegations'
 // - 'custom/staking/delegatorRedelegations'
 // - 'custom/staking/delegatorValidators'
+// - 'custom/staking/delegatorSlashRecords'
 type QueryDelegatorParams struct {
 	DelegatorAddr sdk.AccAddress
 }
@@ -42,6 +46,7 @@ func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams
 // - 'custom/staking/validatorDelegations'
 // - 'custom/staking/validatorUnbondingDelegations'
 // - 'custom/staking/validatorRedelegations'
+// - 'custom/staking/validatorPendingCommission'
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
 }
@@ -86,6 +91,24 @@ func NewQueryRedelegationParams(delegatorAddr sdk.AccAddress,
 	}
 }
 
+// QueryRedelegationRestrictionsParams defines the params for the following queries:
+// - 'custom/staking/redelegationRestrictions'
+type QueryRedelegationRestrictionsParams struct {
+	DelegatorAddr    sdk.AccAddress
+	SrcValidatorAddr sdk.ValAddress
+	Destinations     []RedelegationDestination
+}
+
+func NewQueryRedelegationRestrictionsParams(delegatorAddr sdk.AccAddress,
+	srcValidatorAddr sdk.ValAddress, dsts []RedelegationDestination) QueryRedelegationRestrictionsParams {
+
+	return QueryRedelegationRestrictionsParams{
+		DelegatorAddr:    delegatorAddr,
+		SrcValidatorAddr: srcValidatorAddr,
+		Destinations:     dsts,
+	}
+}
+
 // QueryValidatorsParams defines the params for the following queries:
 // - 'custom/staking/validators'
 type QueryValidatorsParams struct {
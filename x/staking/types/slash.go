@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxDelegatorSlashRecords is the number of slash records kept for each
+// delegator and slashed validator. Older records are pruned.
+const MaxDelegatorSlashRecords = 10
+
+// DelegatorSlashRecord defines the tokens a delegator lost when a validator
+// was slashed, split by the balance they were slashed from: the delegation
+// to the slashed validator, unbonding delegations from it and redelegations
+// away from it.
+type DelegatorSlashRecord struct {
+	DelegatorAddress   sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`     // delegator
+	ValidatorAddress   sdk.ValAddress `json:"validator_address" yaml:"validator_address"`     // slashed validator operator address
+	Height             int64          `json:"height" yaml:"height"`                           // height at which the slash was applied
+	InfractionHeight   int64          `json:"infraction_height" yaml:"infraction_height"`     // height at which the infraction was committed
+	SlashFactor        sdk.Dec        `json:"slash_factor" yaml:"slash_factor"`               // slash factor applied to the validator
+	BondedAmount       sdk.Int        `json:"bonded_amount" yaml:"bonded_amount"`             // tokens slashed from the delegation
+	UnbondingAmount    sdk.Int        `json:"unbonding_amount" yaml:"unbonding_amount"`       // tokens slashed from unbonding delegations
+	RedelegatingAmount sdk.Int        `json:"redelegating_amount" yaml:"redelegating_amount"` // tokens slashed from redelegations
+}
+
+// NewDelegatorSlashRecord creates a new DelegatorSlashRecord instance
+func NewDelegatorSlashRecord(delAddr sdk.AccAddress, valAddr sdk.ValAddress, height, infractionHeight int64,
+	slashFactor sdk.Dec, bonded, unbonding, redelegating sdk.Int) DelegatorSlashRecord {
+
+	return DelegatorSlashRecord{
+		DelegatorAddress:   delAddr,
+		ValidatorAddress:   valAddr,
+		Height:             height,
+		InfractionHeight:   infractionHeight,
+		SlashFactor:        slashFactor,
+		BondedAmount:       bonded,
+		UnbondingAmount:    unbonding,
+		RedelegatingAmount: redelegating,
+	}
+}
+
+// Total returns the total amount of tokens slashed from the delegator
+func (r DelegatorSlashRecord) Total() sdk.Int {
+	return r.BondedAmount.Add(r.UnbondingAmount).Add(r.RedelegatingAmount)
+}
+
+// Validate performs basic validation of the record
+func (r DelegatorSlashRecord) Validate() error {
+	if r.DelegatorAddress.Empty() {
+		return fmt.Errorf("delegator slash record delegator address cannot be empty")
+	}
+	if r.ValidatorAddress.Empty() {
+		return fmt.Errorf("delegator slash record validator address cannot be empty")
+	}
+	if r.InfractionHeight < 0 || r.InfractionHeight > r.Height {
+		return fmt.Errorf("delegator slash record infraction height %d must be between 0 and height %d",
+			r.InfractionHeight, r.Height)
+	}
+	if r.SlashFactor.IsNegative() {
+		return fmt.Errorf("delegator slash record slash factor cannot be negative: %s", r.SlashFactor)
+	}
+	if r.BondedAmount.IsNegative() || r.UnbondingAmount.IsNegative() || r.RedelegatingAmount.IsNegative() {
+		return fmt.Errorf("delegator slash record amounts cannot be negative")
+	}
+	return nil
+}
+
+// String implements the Stringer interface for a DelegatorSlashRecord
+func (r DelegatorSlashRecord) String() string {
+	return fmt.Sprintf(`Delegator Slash Record:
+  Delegator:           %s
+  Validator:           %s
+  Height:              %d
+  Infraction Height:   %d
+  Slash Factor:        %s
+  Bonded Amount:       %s
+  Unbonding Amount:    %s
+  Redelegating Amount: %s`,
+		r.DelegatorAddress, r.ValidatorAddress, r.Height, r.InfractionHeight, r.SlashFactor,
+		r.BondedAmount, r.UnbondingAmount, r.RedelegatingAmount,
+	)
+}
+
+// DelegatorSlashRecords is a collection of the slash records of a delegator,
+// oldest first
+type DelegatorSlashRecords []DelegatorSlashRecord
+
+func (rs DelegatorSlashRecords) String() (out string) {
+	for _, r := range rs {
+		out += r.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -6,40 +6,70 @@
 package supply
 
 import (
+	"github.com/cosmos/cosmos-sdk/x/supply/client"
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
 )
 
 const (
-	ModuleName   = types.ModuleName
-	StoreKey     = types.StoreKey
-	RouterKey    = types.RouterKey
-	QuerierRoute = types.QuerierRoute
-	Minter       = types.Minter
-	Burner       = types.Burner
-	Staking      = types.Staking
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	Minter                       = types.Minter
+	Burner                       = types.Burner
+	Staking                      = types.Staking
+	QueryTotalSupply             = types.QueryTotalSupply
+	QuerySupplyOf                = types.QuerySupplyOf
+	QueryDenomMetadata           = types.QueryDenomMetadata
+	QueryAllDenomMetadata        = types.QueryAllDenomMetadata
+	ProposalTypeSetDenomMetadata = types.ProposalTypeSetDenomMetadata
+	CodeInvalidDenomMetadata     = types.CodeInvalidDenomMetadata
+
+	ProposalTypeModuleAccountPermissions = types.ProposalTypeModuleAccountPermissions
+	CodeUnknownModuleAccount             = types.CodeUnknownModuleAccount
+	CodeInvalidModulePermissions         = types.CodeInvalidModulePermissions
 )
 
 var (
 	// functions aliases
-	RegisterInvariants    = keeper.RegisterInvariants
-	AllInvariants         = keeper.AllInvariants
-	TotalSupply           = keeper.TotalSupply
-	NewKeeper             = keeper.NewKeeper
-	NewQuerier            = keeper.NewQuerier
-	SupplyKey             = keeper.SupplyKey
-	NewModuleAddress      = types.NewModuleAddress
-	NewEmptyModuleAccount = types.NewEmptyModuleAccount
-	NewModuleAccount      = types.NewModuleAccount
-	RegisterCodec         = types.RegisterCodec
-	NewGenesisState       = types.NewGenesisState
-	DefaultGenesisState   = types.DefaultGenesisState
-	NewSupply             = types.NewSupply
-	DefaultSupply         = types.DefaultSupply
+	RegisterInvariants      = keeper.RegisterInvariants
+	AllInvariants           = keeper.AllInvariants
+	TotalSupply             = keeper.TotalSupply
+	NewKeeper               = keeper.NewKeeper
+	NewQuerier              = keeper.NewQuerier
+	SupplyKey               = keeper.SupplyKey
+	NewModuleAddress        = types.NewModuleAddress
+	NewEmptyModuleAccount   = types.NewEmptyModuleAccount
+	NewModuleAccount        = types.NewModuleAccount
+	RegisterCodec           = types.RegisterCodec
+	NewGenesisState         = types.NewGenesisState
+	DefaultGenesisState     = types.DefaultGenesisState
+	NewSupply               = types.NewSupply
+	DefaultSupply           = types.DefaultSupply
+	GetDenomMetadataKey     = keeper.GetDenomMetadataKey
+	GetModulePermissionsKey = keeper.GetModulePermissionsKey
+
+	NewDenomUnit                = types.NewDenomUnit
+	NewDenomMetadata            = types.NewDenomMetadata
+	NewSetDenomMetadataProposal = types.NewSetDenomMetadataProposal
+	NewQueryDenomMetadataParams = types.NewQueryDenomMetadataParams
+	ErrInvalidDenomMetadata     = types.ErrInvalidDenomMetadata
+
+	NewModuleAccountPermissions         = types.NewModuleAccountPermissions
+	UpdatePermissions                   = types.UpdatePermissions
+	NewModuleAccountPermissionsProposal = types.NewModuleAccountPermissionsProposal
+	ErrUnknownModuleAccount             = types.ErrUnknownModuleAccount
+	ErrInvalidModulePermissions         = types.ErrInvalidModulePermissions
 
 	// variable aliases
-	DefaultCodespace = keeper.DefaultCodespace
-	ModuleCdc        = types.ModuleCdc
+	DefaultCodespace       = keeper.DefaultCodespace
+	ModuleCdc              = types.ModuleCdc
+	DenomMetadataKeyPrefix = keeper.DenomMetadataKeyPrefix
+	ProposalHandler        = client.ProposalHandler
+
+	ModulePermissionsKeyPrefix              = keeper.ModulePermissionsKeyPrefix
+	ModuleAccountPermissionsProposalHandler = client.ModuleAccountPermissionsProposalHandler
 )
 
 type (
@@ -47,4 +77,12 @@ type (
 	ModuleAccount = types.ModuleAccount
 	GenesisState  = types.GenesisState
 	Supply        = types.Supply
+
+	DenomUnit                = types.DenomUnit
+	DenomMetadata            = types.DenomMetadata
+	DenomMetadatas           = types.DenomMetadatas
+	SetDenomMetadataProposal = types.SetDenomMetadataProposal
+
+	ModuleAccountPermissions         = types.ModuleAccountPermissions
+	ModuleAccountPermissionsProposal = types.ModuleAccountPermissionsProposal
 )
@@ -27,6 +27,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	supplyQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryTotalSupply(cdc),
+		GetCmdQueryDenomMetadata(cdc),
 	)...)
 
 	return supplyQueryCmd
@@ -103,3 +104,52 @@ func querySupplyOf(cliCtx context.CLIContext, cdc *codec.Codec, denom string) er
 
 	return cliCtx.PrintOutput(supply)
 }
+
+// GetCmdQueryDenomMetadata implements the query denom metadata command.
+func GetCmdQueryDenomMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-metadata [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the metadata of the registered coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the metadata of all the coin denominations registered in the chain.
+
+Example:
+$ %s query %s denom-metadata
+
+To query for the metadata of a specific base denomination use:
+$ %s query %s denom-metadata uatom
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllDenomMetadata), nil)
+				if err != nil {
+					return err
+				}
+
+				var metadatas types.DenomMetadatas
+				cdc.MustUnmarshalJSON(res, &metadatas)
+				return cliCtx.PrintOutput(metadatas)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDenomMetadataParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomMetadata), bz)
+			if err != nil {
+				return err
+			}
+
+			var metadata types.DenomMetadata
+			cdc.MustUnmarshalJSON(res, &metadata)
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
+)
+
+// GetCmdSubmitProposal implements the command to submit a set-denom-metadata proposal
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the metadata of a denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to set the metadata of a coin denomination along with
+an initial deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-denom-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Atom Metadata",
+  "description": "Display uatom as atom",
+  "metadata": {
+    "description": "The native staking token",
+    "base": "uatom",
+    "display": "atom",
+    "denom_units": [
+      {
+        "denom": "uatom",
+        "exponent": 0
+      },
+      {
+        "denom": "matom",
+        "exponent": 3
+      },
+      {
+        "denom": "atom",
+        "exponent": 6
+      }
+    ]
+  },
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseSetDenomMetadataProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewSetDenomMetadataProposal(proposal.Title, proposal.Description, proposal.Metadata)
+
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitModuleAccountPermissionsProposal implements the command to submit a module-account-permissions proposal
+func GetCmdSubmitModuleAccountPermissionsProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-account-permissions [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add and remove permissions of a module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add and remove permissions of a module account along
+with an initial deposit. The permissions can be the %s, %s and %s permissions or
+custom ones. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal module-account-permissions <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Gov Minter",
+  "description": "Allow the gov module account to mint coins, and no longer burn them",
+  "module_name": "gov",
+  "add": [
+    "minter"
+  ],
+  "remove": [
+    "burner"
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				types.Minter, types.Burner, types.Staking, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseModuleAccountPermissionsProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewModuleAccountPermissionsProposal(
+				proposal.Title, proposal.Description, proposal.ModuleName, proposal.Add, proposal.Remove,
+			)
+
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
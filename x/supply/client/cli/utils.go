@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
+)
+
+type (
+	// SetDenomMetadataProposalJSON defines a SetDenomMetadataProposal with a deposit
+	SetDenomMetadataProposalJSON struct {
+		Title       string              `json:"title" yaml:"title"`
+		Description string              `json:"description" yaml:"description"`
+		Metadata    types.DenomMetadata `json:"metadata" yaml:"metadata"`
+		Deposit     sdk.Coins           `json:"deposit" yaml:"deposit"`
+	}
+
+	// ModuleAccountPermissionsProposalJSON defines a ModuleAccountPermissionsProposal with a deposit
+	ModuleAccountPermissionsProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		ModuleName  string    `json:"module_name" yaml:"module_name"`
+		Add         []string  `json:"add" yaml:"add"`
+		Remove      []string  `json:"remove" yaml:"remove"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseSetDenomMetadataProposalJSON reads and parses a SetDenomMetadataProposalJSON from a file.
+func ParseSetDenomMetadataProposalJSON(cdc *codec.Codec, proposalFile string) (SetDenomMetadataProposalJSON, error) {
+	proposal := SetDenomMetadataProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseModuleAccountPermissionsProposalJSON reads and parses a ModuleAccountPermissionsProposalJSON from a file.
+func ParseModuleAccountPermissionsProposalJSON(cdc *codec.Codec, proposalFile string) (ModuleAccountPermissionsProposalJSON, error) {
+	proposal := ModuleAccountPermissionsProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
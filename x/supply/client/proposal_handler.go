@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/supply/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/supply/client/rest"
+)
+
+// set denom metadata and module account permissions proposal handlers
+var (
+	ProposalHandler                         = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ModuleAccountPermissionsProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitModuleAccountPermissionsProposal, rest.ModuleAccountPermissionsProposalRESTHandler,
+	)
+)
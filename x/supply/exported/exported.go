@@ -12,6 +12,7 @@ type ModuleAccountI interface {
 
 	GetName() string
 	GetPermissions() []string
+	SetPermissions([]string) error
 	HasPermission(string) bool
 }
 
@@ -1,6 +1,8 @@
 package supply
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
@@ -24,15 +26,62 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, ak types.AccountKeeper, data Ge
 	}
 
 	keeper.SetSupply(ctx, types.NewSupply(data.Supply))
+
+	for _, metadata := range data.DenomMetadata {
+		keeper.SetDenomMetadata(ctx, metadata)
+	}
+
+	// the module accounts not in the permission table keep the permissions
+	// they are registered with
+	permissions := make(map[string][]string)
+	for _, mp := range data.ModuleAccountPermissions {
+		if !keeper.HasModuleAccount(mp.ModuleName) {
+			panic(fmt.Sprintf("permissions of unknown module account %s", mp.ModuleName))
+		}
+		permissions[mp.ModuleName] = mp.Permissions
+	}
+	for _, name := range keeper.GetModuleAccountNames() {
+		perms, ok := permissions[name]
+		if !ok {
+			perms = keeper.GetModulePermissions(ctx, name)
+		}
+		keeper.SetModulePermissions(ctx, name, perms)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSupply(ctx).GetTotal())
+	return NewGenesisState(keeper.GetSupply(ctx).GetTotal(), keeper.GetAllDenomMetadata(ctx),
+		keeper.GetAllModulePermissions(ctx))
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
-	return types.NewSupply(data.Supply).ValidateBasic()
+	if err := types.NewSupply(data.Supply).ValidateBasic(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, metadata := range data.DenomMetadata {
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+		if seen[metadata.Base] {
+			return fmt.Errorf("duplicate metadata for denom %s", metadata.Base)
+		}
+		seen[metadata.Base] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, mp := range data.ModuleAccountPermissions {
+		if err := mp.Validate(); err != nil {
+			return err
+		}
+		if seen[mp.ModuleName] {
+			return fmt.Errorf("duplicate permissions for module account %s", mp.ModuleName)
+		}
+		seen[mp.ModuleName] = true
+	}
+	return nil
 }
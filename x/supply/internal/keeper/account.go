@@ -16,18 +16,18 @@ func (k Keeper) GetModuleAddress(moduleName string) sdk.AccAddress {
 }
 
 // GetModuleAddressAndPermissions returns an address and permissions based on the module name
-func (k Keeper) GetModuleAddressAndPermissions(moduleName string) (addr sdk.AccAddress, permissions []string) {
+func (k Keeper) GetModuleAddressAndPermissions(ctx sdk.Context, moduleName string) (addr sdk.AccAddress, permissions []string) {
 	permAddr, ok := k.permAddrs[moduleName]
 	if !ok {
 		return addr, permissions
 	}
-	return permAddr.GetAddress(), permAddr.GetPermissions()
+	return permAddr.GetAddress(), k.GetModulePermissions(ctx, moduleName)
 }
 
 // GetModuleAccountAndPermissions gets the module account from the auth account store and its
 // registered permissions
 func (k Keeper) GetModuleAccountAndPermissions(ctx sdk.Context, moduleName string) (exported.ModuleAccountI, []string) {
-	addr, perms := k.GetModuleAddressAndPermissions(moduleName)
+	addr, perms := k.GetModuleAddressAndPermissions(ctx, moduleName)
 	if addr == nil {
 		return nil, []string{}
 	}
@@ -61,9 +61,9 @@ func (k Keeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
 }
 
 // ValidatePermissions validates that the module account has been granted
-// permissions within its set of allowed permissions.
-func (k Keeper) ValidatePermissions(macc exported.ModuleAccountI) error {
-	permAddr := k.permAddrs[macc.GetName()]
+// permissions within its set of allowed permissions in the permission table.
+func (k Keeper) ValidatePermissions(ctx sdk.Context, macc exported.ModuleAccountI) error {
+	permAddr := types.NewPermissionsForAddress(macc.GetName(), k.GetModulePermissions(ctx, macc.GetName()))
 	for _, perm := range macc.GetPermissions() {
 		if !permAddr.HasPermission(perm) {
 			return fmt.Errorf("invalid module permission %s", perm)
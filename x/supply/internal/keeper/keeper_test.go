@@ -23,16 +23,21 @@ func TestSupply(t *testing.T) {
 }
 
 func TestValidatePermissions(t *testing.T) {
-	app, _ := createTestApp(false)
+	app, ctx := createTestApp(false)
 
-	err := app.SupplyKeeper.ValidatePermissions(multiPermAcc)
+	err := app.SupplyKeeper.ValidatePermissions(ctx, multiPermAcc)
 	require.NoError(t, err)
 
-	err = app.SupplyKeeper.ValidatePermissions(randomPermAcc)
+	err = app.SupplyKeeper.ValidatePermissions(ctx, randomPermAcc)
 	require.NoError(t, err)
 
 	// unregistered permissions
 	otherAcc := types.NewEmptyModuleAccount("other", "other")
-	err = app.SupplyKeeper.ValidatePermissions(otherAcc)
+	err = app.SupplyKeeper.ValidatePermissions(ctx, otherAcc)
+	require.Error(t, err)
+
+	// permissions removed from the permission table
+	app.SupplyKeeper.SetModulePermissions(ctx, randomPerm, []string{})
+	err = app.SupplyKeeper.ValidatePermissions(ctx, randomPermAcc)
 	require.Error(t, err)
 }
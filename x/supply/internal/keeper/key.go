@@ -12,6 +12,23 @@ var DefaultCodespace sdk.CodespaceType = types.ModuleName
 // Items are stored with the following key: values
 //
 // - 0x00: Supply
+//
+// - 0x01<base_denom_bytes>: DenomMetadata
+//
+// - 0x02<module_name_bytes>: []string permissions
 var (
-	SupplyKey = []byte{0x00}
+	SupplyKey                  = []byte{0x00}
+	DenomMetadataKeyPrefix     = []byte{0x01}
+	ModulePermissionsKeyPrefix = []byte{0x02}
 )
+
+// GetDenomMetadataKey returns the store key of the metadata of a base denom
+func GetDenomMetadataKey(denom string) []byte {
+	return append(DenomMetadataKeyPrefix, []byte(denom)...)
+}
+
+// GetModulePermissionsKey returns the store key of the permissions of a
+// module account
+func GetModulePermissionsKey(moduleName string) []byte {
+	return append(ModulePermissionsKeyPrefix, []byte(moduleName)...)
+}
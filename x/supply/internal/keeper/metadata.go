@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
+)
+
+// GetDenomMetadata returns the metadata of a base denom
+func (k Keeper) GetDenomMetadata(ctx sdk.Context, denom string) (metadata types.DenomMetadata, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetDenomMetadataKey(denom))
+	if b == nil {
+		return metadata, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &metadata)
+	return metadata, true
+}
+
+// SetDenomMetadata sets the metadata of a base denom
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, metadata types.DenomMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(metadata)
+	store.Set(GetDenomMetadataKey(metadata.Base), b)
+}
+
+// IterateDenomMetadata iterates over the metadata of all denoms and performs
+// a callback function
+func (k Keeper) IterateDenomMetadata(ctx sdk.Context, cb func(metadata types.DenomMetadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DenomMetadataKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomMetadata
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &metadata)
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
+// GetAllDenomMetadata returns the metadata of all denoms
+func (k Keeper) GetAllDenomMetadata(ctx sdk.Context) (metadatas []types.DenomMetadata) {
+	k.IterateDenomMetadata(ctx, func(metadata types.DenomMetadata) bool {
+		metadatas = append(metadatas, metadata)
+		return false
+	})
+	return metadatas
+}
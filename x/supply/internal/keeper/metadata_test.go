@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keep "github.com/cosmos/cosmos-sdk/x/supply/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
+)
+
+func TestDenomMetadata(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.SupplyKeeper
+	cdc := app.Codec()
+
+	atom := types.NewDenomMetadata("The native staking token", "uatom", "atom",
+		[]types.DenomUnit{types.NewDenomUnit("uatom", 0), types.NewDenomUnit("atom", 6)})
+	btc := types.NewDenomMetadata("Bitcoin", "sat", "btc",
+		[]types.DenomUnit{types.NewDenomUnit("sat", 0), types.NewDenomUnit("btc", 8)})
+
+	_, found := keeper.GetDenomMetadata(ctx, "uatom")
+	require.False(t, found)
+
+	keeper.SetDenomMetadata(ctx, atom)
+	keeper.SetDenomMetadata(ctx, btc)
+
+	metadata, found := keeper.GetDenomMetadata(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, atom, metadata)
+	require.Equal(t, []types.DenomMetadata{btc, atom}, keeper.GetAllDenomMetadata(ctx))
+
+	querier := keep.NewQuerier(keeper)
+
+	bz, err := querier(ctx, []string{types.QueryAllDenomMetadata}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var metadatas []types.DenomMetadata
+	require.NoError(t, cdc.UnmarshalJSON(bz, &metadatas))
+	require.Equal(t, []types.DenomMetadata{btc, atom}, metadatas)
+
+	query := abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryDenomMetadataParams("sat"))}
+	bz, err = querier(ctx, []string{types.QueryDenomMetadata}, query)
+	require.Nil(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(bz, &metadata))
+	require.Equal(t, btc, metadata)
+
+	query = abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryDenomMetadataParams("eth"))}
+	_, err = querier(ctx, []string{types.QueryDenomMetadata}, query)
+	require.Error(t, err)
+}
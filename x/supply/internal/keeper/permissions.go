@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
+)
+
+// HasModuleAccount returns true if the module account is registered
+func (k Keeper) HasModuleAccount(moduleName string) bool {
+	_, ok := k.permAddrs[moduleName]
+	return ok
+}
+
+// GetModuleAccountNames returns the names of the registered module accounts,
+// sorted
+func (k Keeper) GetModuleAccountNames() []string {
+	names := make([]string, 0, len(k.permAddrs))
+	for name := range k.permAddrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetModulePermissions returns the permissions of a module account from the
+// permission table, defaulting to the permissions the module account is
+// registered with if it has no entry in the table
+func (k Keeper) GetModulePermissions(ctx sdk.Context, moduleName string) (permissions []string) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetModulePermissionsKey(moduleName))
+	if b == nil {
+		return k.permAddrs[moduleName].GetPermissions()
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &permissions)
+	return permissions
+}
+
+// SetModulePermissions sets the permissions of a module account in the
+// permission table, and grants them to the module account if it exists
+func (k Keeper) SetModulePermissions(ctx sdk.Context, moduleName string, permissions []string) {
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(permissions)
+	store.Set(GetModulePermissionsKey(moduleName), b)
+
+	acc := k.ak.GetAccount(ctx, k.GetModuleAddress(moduleName))
+	if acc == nil {
+		return
+	}
+	macc, ok := acc.(exported.ModuleAccountI)
+	if !ok {
+		panic("account is not a module account")
+	}
+	if err := macc.SetPermissions(permissions); err != nil {
+		panic(err)
+	}
+	k.SetModuleAccount(ctx, macc)
+}
+
+// GetAllModulePermissions returns the permissions of all the registered module
+// accounts, sorted by module name
+func (k Keeper) GetAllModulePermissions(ctx sdk.Context) []types.ModuleAccountPermissions {
+	names := k.GetModuleAccountNames()
+	permissions := make([]types.ModuleAccountPermissions, len(names))
+	for i, name := range names {
+		permissions[i] = types.NewModuleAccountPermissions(name, k.GetModulePermissions(ctx, name))
+	}
+	return permissions
+}
+
+// UpdateModulePermissions adds and removes permissions of a registered module
+// account
+func (k Keeper) UpdateModulePermissions(ctx sdk.Context, moduleName string, add, remove []string) sdk.Error {
+	if !k.HasModuleAccount(moduleName) {
+		return types.ErrUnknownModuleAccount(DefaultCodespace, moduleName)
+	}
+
+	permissions := types.UpdatePermissions(k.GetModulePermissions(ctx, moduleName), add, remove)
+	if err := types.NewModuleAccountPermissions(moduleName, permissions).Validate(); err != nil {
+		return types.ErrInvalidModulePermissions(DefaultCodespace, err.Error())
+	}
+
+	k.SetModulePermissions(ctx, moduleName, permissions)
+	return nil
+}
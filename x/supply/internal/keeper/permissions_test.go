@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
+)
+
+func TestModulePermissions(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.SupplyKeeper
+
+	// module accounts without an entry in the table keep their registered permissions
+	require.Equal(t, []string{types.Burner, types.Minter, types.Staking}, keeper.GetModulePermissions(ctx, multiPerm))
+	require.Nil(t, keeper.GetModulePermissions(ctx, holder))
+	require.True(t, keeper.HasModuleAccount(holder))
+	require.False(t, keeper.HasModuleAccount("other"))
+
+	// the holder cannot mint until granted the minter permission
+	require.Panics(t, func() { keeper.MintCoins(ctx, holder, initCoins) })
+	require.Empty(t, keeper.GetModuleAccount(ctx, holder).GetPermissions())
+
+	require.Nil(t, keeper.UpdateModulePermissions(ctx, holder, []string{types.Minter, "custom"}, nil))
+	require.Equal(t, []string{types.Minter, "custom"}, keeper.GetModulePermissions(ctx, holder))
+	require.Equal(t, []string{types.Minter, "custom"}, keeper.GetModuleAccount(ctx, holder).GetPermissions())
+	require.NoError(t, keeper.MintCoins(ctx, holder, initCoins))
+
+	// removed permissions are revoked from the module account
+	require.Nil(t, keeper.UpdateModulePermissions(ctx, holder, nil, []string{types.Minter}))
+	require.Equal(t, []string{"custom"}, keeper.GetModuleAccount(ctx, holder).GetPermissions())
+	require.Panics(t, func() { keeper.MintCoins(ctx, holder, initCoins) })
+
+	// module accounts created after the update are granted the table permissions
+	require.Nil(t, keeper.UpdateModulePermissions(ctx, types.Burner, []string{types.Staking}, nil))
+	require.Equal(t, []string{types.Burner, types.Staking}, keeper.GetModuleAccount(ctx, types.Burner).GetPermissions())
+
+	// unknown module accounts are rejected
+	err := keeper.UpdateModulePermissions(ctx, "other", []string{types.Minter}, nil)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeUnknownModuleAccount, err.Code())
+
+	all := keeper.GetAllModulePermissions(ctx)
+	require.Len(t, all, len(keeper.GetModuleAccountNames()))
+	for _, mp := range all {
+		require.Equal(t, keeper.GetModulePermissions(ctx, mp.ModuleName), mp.Permissions)
+	}
+}
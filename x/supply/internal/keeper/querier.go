@@ -6,6 +6,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
 )
@@ -21,6 +22,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QuerySupplyOf:
 			return querySupplyOf(ctx, req, k)
 
+		case types.QueryDenomMetadata:
+			return queryDenomMetadata(ctx, req, k)
+
+		case types.QueryAllDenomMetadata:
+			return queryAllDenomMetadata(ctx, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown supply query endpoint")
 		}
@@ -69,3 +76,38 @@ func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sd
 
 	return res, nil
 }
+
+func queryDenomMetadata(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDenomMetadataParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	metadata, found := k.GetDenomMetadata(ctx, params.Denom)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("no metadata for denom %s", params.Denom))
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, metadata)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryAllDenomMetadata(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	metadatas := k.GetAllDenomMetadata(ctx)
+	if metadatas == nil {
+		metadatas = []types.DenomMetadata{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, metadatas)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
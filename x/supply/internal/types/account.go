@@ -76,6 +76,15 @@ func (ma ModuleAccount) GetPermissions() []string {
 	return ma.Permissions
 }
 
+// SetPermissions sets the permissions granted to the module account
+func (ma *ModuleAccount) SetPermissions(permissions []string) error {
+	if err := validatePermissions(permissions...); err != nil {
+		return err
+	}
+	ma.Permissions = permissions
+	return nil
+}
+
 // SetPubKey - Implements Account
 func (ma ModuleAccount) SetPubKey(pubKey crypto.PubKey) error {
 	return fmt.Errorf("not supported for module accounts")
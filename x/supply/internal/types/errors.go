@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace from the supply module
+const DefaultCodespace sdk.CodespaceType = ModuleName
+
+// Supply error codes
+const (
+	CodeInvalidDenomMetadata     sdk.CodeType = 101
+	CodeUnknownModuleAccount     sdk.CodeType = 102
+	CodeInvalidModulePermissions sdk.CodeType = 103
+)
+
+// ErrInvalidDenomMetadata is returned for an invalid denom metadata
+func ErrInvalidDenomMetadata(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDenomMetadata, msg)
+}
+
+// ErrUnknownModuleAccount is returned for a module account name which is not
+// registered
+func ErrUnknownModuleAccount(codespace sdk.CodespaceType, moduleName string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownModuleAccount, fmt.Sprintf("unknown module account %s", moduleName))
+}
+
+// ErrInvalidModulePermissions is returned for invalid module account
+// permissions
+func ErrInvalidModulePermissions(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidModulePermissions, msg)
+}
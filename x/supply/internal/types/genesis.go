@@ -6,15 +6,21 @@ import (
 
 // GenesisState is the supply state that must be provided at genesis.
 type GenesisState struct {
-	Supply sdk.Coins `json:"supply" yaml:"supply"`
+	Supply                   sdk.Coins                  `json:"supply" yaml:"supply"`
+	DenomMetadata            []DenomMetadata            `json:"denom_metadata" yaml:"denom_metadata"`
+	ModuleAccountPermissions []ModuleAccountPermissions `json:"module_account_permissions" yaml:"module_account_permissions"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(supply sdk.Coins) GenesisState {
-	return GenesisState{supply}
+func NewGenesisState(supply sdk.Coins, denomMetadata []DenomMetadata,
+	moduleAccountPermissions []ModuleAccountPermissions) GenesisState {
+
+	return GenesisState{supply, denomMetadata, moduleAccountPermissions}
 }
 
-// DefaultGenesisState returns a default genesis state
+// DefaultGenesisState returns a default genesis state. The module accounts
+// not in the permission table are granted the permissions they are
+// registered with.
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultSupply().GetTotal())
+	return NewGenesisState(DefaultSupply().GetTotal(), []DenomMetadata{}, []ModuleAccountPermissions{})
 }
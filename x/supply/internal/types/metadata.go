@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomUnit represents a unit of a denomination, where one unit equals
+// 10^Exponent of the base denomination.
+type DenomUnit struct {
+	Denom    string `json:"denom" yaml:"denom"`
+	Exponent uint32 `json:"exponent" yaml:"exponent"`
+}
+
+// NewDenomUnit creates a new DenomUnit object
+func NewDenomUnit(denom string, exponent uint32) DenomUnit {
+	return DenomUnit{
+		Denom:    denom,
+		Exponent: exponent,
+	}
+}
+
+// DenomMetadata describes a denomination along with the units it can be
+// displayed in, e.g. a "uatom" base denom displayed as "atom" with an
+// exponent of 6.
+type DenomMetadata struct {
+	Description string      `json:"description" yaml:"description"` // description of the denomination
+	Base        string      `json:"base" yaml:"base"`               // denom of the coins held in accounts
+	Display     string      `json:"display" yaml:"display"`         // denom of the unit to display the coins in
+	DenomUnits  []DenomUnit `json:"denom_units" yaml:"denom_units"` // units of the denomination, in increasing exponent order
+}
+
+// NewDenomMetadata creates a new DenomMetadata object
+func NewDenomMetadata(description, base, display string, denomUnits []DenomUnit) DenomMetadata {
+	return DenomMetadata{
+		Description: description,
+		Base:        base,
+		Display:     display,
+		DenomUnits:  denomUnits,
+	}
+}
+
+// Validate performs a basic validation of the denom metadata. The first unit
+// must be the base denom with an exponent of zero, the exponents must be
+// strictly increasing, and the display denom must be one of the units.
+func (m DenomMetadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return fmt.Errorf("invalid metadata base denom: %s", err)
+	}
+	if len(m.DenomUnits) == 0 || m.DenomUnits[0].Denom != m.Base || m.DenomUnits[0].Exponent != 0 {
+		return fmt.Errorf("the first unit of denom %s must be the base denom with an exponent of 0", m.Base)
+	}
+
+	seen := make(map[string]bool)
+	var hasDisplay bool
+	for i, unit := range m.DenomUnits {
+		if err := sdk.ValidateDenom(unit.Denom); err != nil {
+			return fmt.Errorf("invalid metadata unit of denom %s: %s", m.Base, err)
+		}
+		if seen[unit.Denom] {
+			return fmt.Errorf("duplicate unit %s of denom %s", unit.Denom, m.Base)
+		}
+		seen[unit.Denom] = true
+
+		if i > 0 && unit.Exponent <= m.DenomUnits[i-1].Exponent {
+			return fmt.Errorf("units of denom %s must be sorted by strictly increasing exponent", m.Base)
+		}
+		if unit.Denom == m.Display {
+			hasDisplay = true
+		}
+	}
+
+	if !hasDisplay {
+		return fmt.Errorf("display denom %s is not a unit of denom %s", m.Display, m.Base)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (m DenomMetadata) String() string {
+	units := make([]string, len(m.DenomUnits))
+	for i, unit := range m.DenomUnits {
+		units[i] = fmt.Sprintf("%s (10^%d)", unit.Denom, unit.Exponent)
+	}
+
+	return fmt.Sprintf(`Denom Metadata:
+  Base:        %s
+  Display:     %s
+  Units:       %s
+  Description: %s`,
+		m.Base, m.Display, strings.Join(units, ", "), m.Description,
+	)
+}
+
+// DenomMetadatas is a collection of DenomMetadata
+type DenomMetadatas []DenomMetadata
+
+// String implements fmt.Stringer
+func (ms DenomMetadatas) String() string {
+	out := make([]string, len(ms))
+	for i, m := range ms {
+		out[i] = m.String()
+	}
+	return strings.Join(out, "\n")
+}
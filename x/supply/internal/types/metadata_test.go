@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomMetadataValidate(t *testing.T) {
+	units := []DenomUnit{
+		NewDenomUnit("uatom", 0),
+		NewDenomUnit("matom", 3),
+		NewDenomUnit("atom", 6),
+	}
+
+	tests := []struct {
+		name     string
+		metadata DenomMetadata
+		expPass  bool
+	}{
+		{"valid", NewDenomMetadata("atom", "uatom", "atom", units), true},
+		{"display is base", NewDenomMetadata("atom", "uatom", "uatom", units[:1]), true},
+		{"invalid base", NewDenomMetadata("atom", "UATOM", "atom", units), false},
+		{"no units", NewDenomMetadata("atom", "uatom", "uatom", nil), false},
+		{"first unit is not base", NewDenomMetadata("atom", "uatom", "atom", units[1:]), false},
+		{"base exponent not zero", NewDenomMetadata("atom", "uatom", "uatom",
+			[]DenomUnit{NewDenomUnit("uatom", 1)}), false},
+		{"unsorted exponents", NewDenomMetadata("atom", "uatom", "atom",
+			[]DenomUnit{units[0], units[2], units[1]}), false},
+		{"duplicate unit", NewDenomMetadata("atom", "uatom", "atom",
+			[]DenomUnit{units[0], units[1], NewDenomUnit("matom", 6)}), false},
+		{"invalid unit", NewDenomMetadata("atom", "uatom", "atom",
+			[]DenomUnit{units[0], NewDenomUnit("a", 6)}), false},
+		{"unknown display", NewDenomMetadata("atom", "uatom", "katom", units), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.metadata.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -57,3 +57,62 @@ func validatePermissions(permissions ...string) error {
 	}
 	return nil
 }
+
+// ModuleAccountPermissions defines the permissions granted to a module account
+// in the on-chain permission table
+type ModuleAccountPermissions struct {
+	ModuleName  string   `json:"module_name" yaml:"module_name"`
+	Permissions []string `json:"permissions" yaml:"permissions"`
+}
+
+// NewModuleAccountPermissions creates a new ModuleAccountPermissions instance
+func NewModuleAccountPermissions(moduleName string, permissions []string) ModuleAccountPermissions {
+	return ModuleAccountPermissions{
+		ModuleName:  moduleName,
+		Permissions: permissions,
+	}
+}
+
+// Validate performs a basic validation of the module account permissions
+func (mp ModuleAccountPermissions) Validate() error {
+	if strings.TrimSpace(mp.ModuleName) == "" {
+		return fmt.Errorf("module account name cannot be blank")
+	}
+	if err := validatePermissions(mp.Permissions...); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, perm := range mp.Permissions {
+		if seen[perm] {
+			return fmt.Errorf("duplicate permission %s for module account %s", perm, mp.ModuleName)
+		}
+		seen[perm] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (mp ModuleAccountPermissions) String() string {
+	return fmt.Sprintf("%s: %s", mp.ModuleName, strings.Join(mp.Permissions, ", "))
+}
+
+// UpdatePermissions returns the permissions with the given permissions added
+// and removed, keeping the order of the existing permissions
+func UpdatePermissions(permissions, add, remove []string) []string {
+	removed := make(map[string]bool)
+	for _, perm := range remove {
+		removed[perm] = true
+	}
+
+	updated := make([]string, 0, len(permissions)+len(add))
+	seen := make(map[string]bool)
+	for _, perm := range append(append([]string{}, permissions...), add...) {
+		if removed[perm] || seen[perm] {
+			continue
+		}
+		seen[perm] = true
+		updated = append(updated, perm)
+	}
+	return updated
+}
@@ -52,3 +52,40 @@ func TestValidatePermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestModuleAccountPermissionsValidate(t *testing.T) {
+	cases := []struct {
+		name       string
+		mp         ModuleAccountPermissions
+		expectPass bool
+	}{
+		{"no permissions", NewModuleAccountPermissions("test", []string{}), true},
+		{"valid permissions", NewModuleAccountPermissions("test", []string{Minter, "custom"}), true},
+		{"blank module name", NewModuleAccountPermissions(" ", []string{Minter}), false},
+		{"invalid permission", NewModuleAccountPermissions("test", []string{""}), false},
+		{"duplicate permission", NewModuleAccountPermissions("test", []string{Minter, Minter}), false},
+	}
+
+	for i, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.mp.Validate()
+			if tc.expectPass {
+				require.NoError(t, err, "test case #%d", i)
+			} else {
+				require.Error(t, err, "test case #%d", i)
+			}
+		})
+	}
+}
+
+func TestUpdatePermissions(t *testing.T) {
+	permissions := []string{Burner, Staking}
+
+	require.Equal(t, []string{Burner, Staking, Minter}, UpdatePermissions(permissions, []string{Minter, Burner}, nil))
+	require.Equal(t, []string{Staking}, UpdatePermissions(permissions, nil, []string{Burner, Minter}))
+	require.Equal(t, []string{Staking, "custom"}, UpdatePermissions(permissions, []string{"custom"}, []string{Burner}))
+	require.Equal(t, []string{}, UpdatePermissions(nil, nil, nil))
+
+	// the given permissions are not modified
+	require.Equal(t, []string{Burner, Staking}, permissions)
+}
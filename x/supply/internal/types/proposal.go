@@ -0,0 +1,149 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetDenomMetadata defines the type for a SetDenomMetadataProposal
+	ProposalTypeSetDenomMetadata = "SetDenomMetadata"
+	// ProposalTypeModuleAccountPermissions defines the type for a ModuleAccountPermissionsProposal
+	ProposalTypeModuleAccountPermissions = "ModuleAccountPermissions"
+)
+
+// Assert the supply proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = SetDenomMetadataProposal{}
+	_ govtypes.Content = ModuleAccountPermissionsProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
+	govtypes.RegisterProposalTypeCodec(SetDenomMetadataProposal{}, "cosmos-sdk/SetDenomMetadataProposal")
+	govtypes.RegisterProposalType(ProposalTypeModuleAccountPermissions)
+	govtypes.RegisterProposalTypeCodec(ModuleAccountPermissionsProposal{}, "cosmos-sdk/ModuleAccountPermissionsProposal")
+}
+
+// SetDenomMetadataProposal sets the metadata of a denomination
+type SetDenomMetadataProposal struct {
+	Title       string        `json:"title" yaml:"title"`
+	Description string        `json:"description" yaml:"description"`
+	Metadata    DenomMetadata `json:"metadata" yaml:"metadata"`
+}
+
+// NewSetDenomMetadataProposal creates a new set denom metadata proposal.
+func NewSetDenomMetadataProposal(title, description string, metadata DenomMetadata) SetDenomMetadataProposal {
+	return SetDenomMetadataProposal{title, description, metadata}
+}
+
+// GetTitle returns the title of a set denom metadata proposal.
+func (sdp SetDenomMetadataProposal) GetTitle() string { return sdp.Title }
+
+// GetDescription returns the description of a set denom metadata proposal.
+func (sdp SetDenomMetadataProposal) GetDescription() string { return sdp.Description }
+
+// ProposalRoute returns the routing key of a set denom metadata proposal.
+func (sdp SetDenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set denom metadata proposal.
+func (sdp SetDenomMetadataProposal) ProposalType() string { return ProposalTypeSetDenomMetadata }
+
+// ValidateBasic runs basic stateless validity checks
+func (sdp SetDenomMetadataProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, sdp)
+	if err != nil {
+		return err
+	}
+	if err := sdp.Metadata.Validate(); err != nil {
+		return ErrInvalidDenomMetadata(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (sdp SetDenomMetadataProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Denom Metadata Proposal:
+  Title:       %s
+  Description: %s
+%s
+`, sdp.Title, sdp.Description, sdp.Metadata))
+	return b.String()
+}
+
+// ModuleAccountPermissionsProposal adds and removes permissions of a module
+// account
+type ModuleAccountPermissionsProposal struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	ModuleName  string   `json:"module_name" yaml:"module_name"`
+	Add         []string `json:"add" yaml:"add"`
+	Remove      []string `json:"remove" yaml:"remove"`
+}
+
+// NewModuleAccountPermissionsProposal creates a new module account
+// permissions proposal.
+func NewModuleAccountPermissionsProposal(title, description, moduleName string,
+	add, remove []string) ModuleAccountPermissionsProposal {
+
+	return ModuleAccountPermissionsProposal{title, description, moduleName, add, remove}
+}
+
+// GetTitle returns the title of a module account permissions proposal.
+func (mpp ModuleAccountPermissionsProposal) GetTitle() string { return mpp.Title }
+
+// GetDescription returns the description of a module account permissions proposal.
+func (mpp ModuleAccountPermissionsProposal) GetDescription() string { return mpp.Description }
+
+// ProposalRoute returns the routing key of a module account permissions proposal.
+func (mpp ModuleAccountPermissionsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a module account permissions proposal.
+func (mpp ModuleAccountPermissionsProposal) ProposalType() string {
+	return ProposalTypeModuleAccountPermissions
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (mpp ModuleAccountPermissionsProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, mpp)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(mpp.ModuleName) == "" {
+		return ErrInvalidModulePermissions(DefaultCodespace, "module account name cannot be blank")
+	}
+	if len(mpp.Add) == 0 && len(mpp.Remove) == 0 {
+		return ErrInvalidModulePermissions(DefaultCodespace, "no permission to add or remove")
+	}
+	if err := validatePermissions(append(append([]string{}, mpp.Add...), mpp.Remove...)...); err != nil {
+		return ErrInvalidModulePermissions(DefaultCodespace, err.Error())
+	}
+
+	added := make(map[string]bool)
+	for _, perm := range mpp.Add {
+		added[perm] = true
+	}
+	for _, perm := range mpp.Remove {
+		if added[perm] {
+			return ErrInvalidModulePermissions(DefaultCodespace,
+				fmt.Sprintf("permission %s cannot be both added and removed", perm))
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (mpp ModuleAccountPermissionsProposal) String() string {
+	return fmt.Sprintf(`Module Account Permissions Proposal:
+  Title:       %s
+  Description: %s
+  Module Name: %s
+  Add:         %s
+  Remove:      %s
+`, mpp.Title, mpp.Description, mpp.ModuleName,
+		strings.Join(mpp.Add, ", "), strings.Join(mpp.Remove, ", "))
+}
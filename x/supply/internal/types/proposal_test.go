@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleAccountPermissionsProposalValidateBasic(t *testing.T) {
+	cases := []struct {
+		name       string
+		proposal   ModuleAccountPermissionsProposal
+		expectPass bool
+	}{
+		{"add and remove", NewModuleAccountPermissionsProposal("title", "description", "gov", []string{Minter}, []string{Burner}), true},
+		{"custom permission", NewModuleAccountPermissionsProposal("title", "description", "gov", []string{"custom"}, nil), true},
+		{"blank title", NewModuleAccountPermissionsProposal("", "description", "gov", []string{Minter}, nil), false},
+		{"blank module name", NewModuleAccountPermissionsProposal("title", "description", "", []string{Minter}, nil), false},
+		{"no change", NewModuleAccountPermissionsProposal("title", "description", "gov", nil, nil), false},
+		{"empty permission", NewModuleAccountPermissionsProposal("title", "description", "gov", nil, []string{""}), false},
+		{"added and removed", NewModuleAccountPermissionsProposal("title", "description", "gov", []string{Minter}, []string{Minter}), false},
+	}
+
+	for i, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectPass {
+				require.Nil(t, err, "test case #%d", i)
+			} else {
+				require.NotNil(t, err, "test case #%d", i)
+			}
+		})
+	}
+}
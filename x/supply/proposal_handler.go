@@ -0,0 +1,64 @@
+package supply
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
+)
+
+// NewProposalHandler creates a new governance Handler for the supply
+// proposals, setting denom metadata or module account permissions
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.SetDenomMetadataProposal:
+			return handleSetDenomMetadataProposal(ctx, k, c)
+
+		case types.ModuleAccountPermissionsProposal:
+			return handleModuleAccountPermissionsProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized supply proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// NewDenomMetadataProposalHandler creates a new governance Handler for the
+// SetDenomMetadataProposal only.
+//
+// Deprecated: use NewProposalHandler, which handles all the supply proposals.
+func NewDenomMetadataProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.SetDenomMetadataProposal:
+			return handleSetDenomMetadataProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized denom metadata proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, p types.SetDenomMetadataProposal) sdk.Error {
+	if err := p.Metadata.Validate(); err != nil {
+		return types.ErrInvalidDenomMetadata(DefaultCodespace, err.Error())
+	}
+
+	k.SetDenomMetadata(ctx, p.Metadata)
+	k.Logger(ctx).Info(fmt.Sprintf("set metadata of denom %s", p.Metadata.Base))
+	return nil
+}
+
+func handleModuleAccountPermissionsProposal(ctx sdk.Context, k Keeper, p types.ModuleAccountPermissionsProposal) sdk.Error {
+	if err := k.UpdateModulePermissions(ctx, p.ModuleName, p.Add, p.Remove); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("set permissions of module account %s to %v",
+		p.ModuleName, k.GetModulePermissions(ctx, p.ModuleName)))
+	return nil
+}
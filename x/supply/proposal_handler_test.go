@@ -0,0 +1,64 @@
+package supply_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+func TestSetDenomMetadataProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := supply.NewProposalHandler(app.SupplyKeeper)
+
+	metadata := supply.NewDenomMetadata("The native staking token", "uatom", "atom",
+		[]supply.DenomUnit{supply.NewDenomUnit("uatom", 0), supply.NewDenomUnit("atom", 6)})
+	proposal := supply.NewSetDenomMetadataProposal("Atom Metadata", "Display uatom as atom", metadata)
+	require.NoError(t, proposal.ValidateBasic())
+	require.Nil(t, handler(ctx, proposal))
+
+	stored, found := app.SupplyKeeper.GetDenomMetadata(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, metadata, stored)
+
+	// invalid metadata is rejected
+	metadata.Display = "katom"
+	proposal = supply.NewSetDenomMetadataProposal("Atom Metadata", "Display uatom as katom", metadata)
+	require.Error(t, proposal.ValidateBasic())
+	require.NotNil(t, handler(ctx, proposal))
+
+	stored, _ = app.SupplyKeeper.GetDenomMetadata(ctx, "uatom")
+	require.Equal(t, "atom", stored.Display)
+}
+
+func TestModuleAccountPermissionsProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := supply.NewProposalHandler(app.SupplyKeeper)
+
+	proposal := supply.NewModuleAccountPermissionsProposal("Gov Minter", "Allow gov to mint instead of burn",
+		gov.ModuleName, []string{supply.Minter}, []string{supply.Burner})
+	require.Nil(t, proposal.ValidateBasic())
+
+	// the deprecated denom metadata handler only handles denom metadata
+	require.NotNil(t, supply.NewDenomMetadataProposalHandler(app.SupplyKeeper)(ctx, proposal))
+	require.Equal(t, []string{supply.Burner}, app.SupplyKeeper.GetModulePermissions(ctx, gov.ModuleName))
+
+	require.Nil(t, handler(ctx, proposal))
+
+	require.Equal(t, []string{supply.Minter}, app.SupplyKeeper.GetModulePermissions(ctx, gov.ModuleName))
+	macc := app.SupplyKeeper.GetModuleAccount(ctx, gov.ModuleName)
+	require.True(t, macc.HasPermission(supply.Minter))
+	require.False(t, macc.HasPermission(supply.Burner))
+
+	// unknown module accounts are rejected
+	proposal = supply.NewModuleAccountPermissionsProposal("Other Minter", "Allow other to mint",
+		"other", []string{supply.Minter}, nil)
+	require.Nil(t, proposal.ValidateBasic())
+	require.NotNil(t, handler(ctx, proposal))
+}
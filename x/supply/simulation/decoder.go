@@ -19,6 +19,16 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &supplyA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &supplyB)
 		return fmt.Sprintf("%v\n%v", supplyB, supplyB)
+	case bytes.Equal(kvA.Key[:1], keeper.DenomMetadataKeyPrefix):
+		var metadataA, metadataB types.DenomMetadata
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &metadataA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &metadataB)
+		return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+	case bytes.Equal(kvA.Key[:1], keeper.ModulePermissionsKeyPrefix):
+		var permissionsA, permissionsB []string
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &permissionsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &permissionsB)
+		return fmt.Sprintf("%v\n%v", permissionsA, permissionsB)
 	default:
 		panic(fmt.Sprintf("invalid supply key %X", kvA.Key))
 	}
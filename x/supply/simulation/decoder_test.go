@@ -25,9 +25,11 @@ func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
 
 	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	permissions := []string{types.Minter, types.Burner}
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: keeper.SupplyKey, Value: cdc.MustMarshalBinaryLengthPrefixed(totalSupply)},
+		cmn.KVPair{Key: keeper.GetModulePermissionsKey("test"), Value: cdc.MustMarshalBinaryLengthPrefixed(permissions)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -36,6 +38,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"ModulePermissions", fmt.Sprintf("%v\n%v", permissions, permissions)},
 		{"other", ""},
 	}
 
@@ -0,0 +1,86 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/token/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/token/internal/types
+package token
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/token/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	StoreKey          = types.StoreKey
+	RouterKey         = types.RouterKey
+	QuerierRoute      = types.QuerierRoute
+	DefaultParamspace = types.DefaultParamspace
+	DenomSeparator    = types.DenomSeparator
+	DefaultCodespace  = types.DefaultCodespace
+	CodeInvalidDenom  = types.CodeInvalidDenom
+	CodeTokenExists   = types.CodeTokenExists
+	CodeUnknownToken  = types.CodeUnknownToken
+	CodeNotTokenAdmin = types.CodeNotTokenAdmin
+	QueryParameters   = types.QueryParameters
+	QueryToken        = types.QueryToken
+	QueryTokens       = types.QueryTokens
+)
+
+var (
+	// functions aliases
+	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
+	RegisterInvariants       = keeper.RegisterInvariants
+	AllInvariants            = keeper.AllInvariants
+	IssuedTokensInvariant    = keeper.IssuedTokensInvariant
+	RegisterCodec            = types.RegisterCodec
+	ErrInvalidDenom          = types.ErrInvalidDenom
+	ErrTokenExists           = types.ErrTokenExists
+	ErrUnknownToken          = types.ErrUnknownToken
+	ErrNotTokenAdmin         = types.ErrNotTokenAdmin
+	NewGenesisState          = types.NewGenesisState
+	DefaultGenesisState      = types.DefaultGenesisState
+	ValidateGenesis          = types.ValidateGenesis
+	GetTokenKey              = types.GetTokenKey
+	NewMsgIssueToken         = types.NewMsgIssueToken
+	NewMsgMintTokens         = types.NewMsgMintTokens
+	NewMsgBurnTokens         = types.NewMsgBurnTokens
+	NewMsgTransferTokenAdmin = types.NewMsgTransferTokenAdmin
+	ParamKeyTable            = types.ParamKeyTable
+	NewParams                = types.NewParams
+	DefaultParams            = types.DefaultParams
+	ValidateParams           = types.ValidateParams
+	NewQueryTokenParams      = types.NewQueryTokenParams
+	NewToken                 = types.NewToken
+	ValidateSubdenom         = types.ValidateSubdenom
+	GetTokenDenom            = types.GetTokenDenom
+	IsTokenDenom             = types.IsTokenDenom
+	ParseTokenDenom          = types.ParseTokenDenom
+
+	// variable aliases
+	ModuleCdc                   = types.ModuleCdc
+	TokenKeyPrefix              = types.TokenKeyPrefix
+	KeyIssueFee                 = types.KeyIssueFee
+	EventTypeIssueToken         = types.EventTypeIssueToken
+	EventTypeMintTokens         = types.EventTypeMintTokens
+	EventTypeBurnTokens         = types.EventTypeBurnTokens
+	EventTypeTransferTokenAdmin = types.EventTypeTransferTokenAdmin
+	AttributeKeyDenom           = types.AttributeKeyDenom
+	AttributeKeyRecipient       = types.AttributeKeyRecipient
+	AttributeKeyNewAdmin        = types.AttributeKeyNewAdmin
+	AttributeValueCategory      = types.AttributeValueCategory
+)
+
+type (
+	Keeper                = keeper.Keeper
+	GenesisState          = types.GenesisState
+	MsgIssueToken         = types.MsgIssueToken
+	MsgMintTokens         = types.MsgMintTokens
+	MsgBurnTokens         = types.MsgBurnTokens
+	MsgTransferTokenAdmin = types.MsgTransferTokenAdmin
+	Params                = types.Params
+	QueryTokenParams      = types.QueryTokenParams
+	Token                 = types.Token
+	Tokens                = types.Tokens
+)
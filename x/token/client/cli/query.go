@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the token module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	tokenQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the token module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryParams(cdc),
+			GetCmdQueryTokens(cdc),
+		)...,
+	)
+
+	return tokenQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current token
+// parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current token parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryTokens implements a command to return the issued tokens.
+func GetCmdQueryTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokens [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the issued tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the tokens issued through the token module.
+
+Example:
+$ %s query %s tokens
+
+To query for a specific token use:
+$ %s query %s tokens token/cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p/foo
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokens), nil)
+				if err != nil {
+					return err
+				}
+
+				var tokens types.Tokens
+				cdc.MustUnmarshalJSON(res, &tokens)
+				return cliCtx.PrintOutput(tokens)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTokenParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryToken), bz)
+			if err != nil {
+				return err
+			}
+
+			var token types.Token
+			cdc.MustUnmarshalJSON(res, &token)
+			return cliCtx.PrintOutput(token)
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+// GetTxCmd returns the transaction commands for the token module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	tokenTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Token transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenTxCmd.AddCommand(client.PostCommands(
+		GetCmdIssueToken(cdc),
+		GetCmdMintTokens(cdc),
+		GetCmdBurnTokens(cdc),
+		GetCmdTransferTokenAdmin(cdc),
+	)...)
+
+	return tokenTxCmd
+}
+
+// GetCmdIssueToken implements the issue token command.
+func GetCmdIssueToken(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "issue [subdenom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Issue a new token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Issue a new token under your own namespace, paying the issue fee. The denom of
+the token is %s/[your address]/[subdenom] and you become its admin.
+
+Example:
+$ %s tx %s issue foo --from mykey
+`,
+				types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgIssueToken(cliCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdMintTokens implements the mint tokens command.
+func GetCmdMintTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "mint [recipient] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Mint tokens of a token you administer to a recipient",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintTokens(cliCtx.GetFromAddress(), recipient, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdBurnTokens implements the burn tokens command.
+func GetCmdBurnTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "burn [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Burn tokens of a token you administer from your account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnTokens(cliCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTransferTokenAdmin implements the transfer token admin command.
+func GetCmdTransferTokenAdmin(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-admin [denom] [new-admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the admin rights of a token you administer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTokenAdmin(cliCtx.GetFromAddress(), args[0], newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,190 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/token/tokens",
+		issueTokenHandlerFn(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/token/tokens/{creator}/{subdenom}/mint",
+		mintTokensHandlerFn(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/token/tokens/{creator}/{subdenom}/burn",
+		burnTokensHandlerFn(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/token/tokens/{creator}/{subdenom}/admin",
+		transferTokenAdminHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+type (
+	// IssueTokenReq defines the properties of an issue token request's body.
+	IssueTokenReq struct {
+		BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Subdenom string       `json:"subdenom" yaml:"subdenom"`
+	}
+
+	// MintTokensReq defines the properties of a mint tokens request's body.
+	MintTokensReq struct {
+		BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount    sdk.Int        `json:"amount" yaml:"amount"`
+	}
+
+	// BurnTokensReq defines the properties of a burn tokens request's body.
+	BurnTokensReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  sdk.Int      `json:"amount" yaml:"amount"`
+	}
+
+	// TransferTokenAdminReq defines the properties of a transfer token admin
+	// request's body.
+	TransferTokenAdminReq struct {
+		BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+	}
+)
+
+func issueTokenHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req IssueTokenReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgIssueToken(fromAddr, req.Subdenom)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func mintTokensHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom, ok := tokenDenomFromPath(w, r)
+		if !ok {
+			return
+		}
+
+		var req MintTokensReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgMintTokens(fromAddr, req.Recipient, sdk.Coin{Denom: denom, Amount: req.Amount})
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func burnTokensHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom, ok := tokenDenomFromPath(w, r)
+		if !ok {
+			return
+		}
+
+		var req BurnTokensReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgBurnTokens(fromAddr, sdk.Coin{Denom: denom, Amount: req.Amount})
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func transferTokenAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom, ok := tokenDenomFromPath(w, r)
+		if !ok {
+			return
+		}
+
+		var req TransferTokenAdminReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgTransferTokenAdmin(fromAddr, denom, req.NewAdmin)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,23 @@
+package token
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the token parameters and issued tokens for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	for _, token := range data.Tokens {
+		keeper.SetToken(ctx, token)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	tokens := keeper.GetAllTokens(ctx)
+	if tokens == nil {
+		tokens = Tokens{}
+	}
+	return NewGenesisState(params, tokens)
+}
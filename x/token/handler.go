@@ -0,0 +1,100 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns a handler for token type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgIssueToken:
+			return handleMsgIssueToken(ctx, msg, k)
+
+		case MsgMintTokens:
+			return handleMsgMintTokens(ctx, msg, k)
+
+		case MsgBurnTokens:
+			return handleMsgBurnTokens(ctx, msg, k)
+
+		case MsgTransferTokenAdmin:
+			return handleMsgTransferTokenAdmin(ctx, msg, k)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized token message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgIssueToken(ctx sdk.Context, msg MsgIssueToken, k Keeper) sdk.Result {
+	denom, err := k.IssueToken(ctx, msg.Creator, msg.Subdenom)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator.String()),
+		),
+	)
+
+	return sdk.Result{Data: []byte(denom), Events: ctx.EventManager().Events()}
+}
+
+func handleMsgMintTokens(ctx sdk.Context, msg MsgMintTokens, k Keeper) sdk.Result {
+	err := k.MintTokens(ctx, msg.Admin, msg.Recipient, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Admin.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgBurnTokens(ctx sdk.Context, msg MsgBurnTokens, k Keeper) sdk.Result {
+	err := k.BurnTokens(ctx, msg.Admin, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Admin.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgTransferTokenAdmin(ctx sdk.Context, msg MsgTransferTokenAdmin, k Keeper) sdk.Result {
+	err := k.TransferTokenAdmin(ctx, msg.Admin, msg.Denom, msg.NewAdmin)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Admin.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,53 @@
+package token_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/token"
+)
+
+func TestInvalidMsg(t *testing.T) {
+	h := token.NewHandler(token.Keeper{})
+
+	res := h(sdk.NewContext(nil, abci.Header{}, false, nil), sdk.NewTestMsg())
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "unrecognized token message type"))
+}
+
+func TestHandleMsgs(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	h := token.NewHandler(app.TokenKeeper)
+
+	creator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	newAdmin := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, creator)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	res := h(ctx, token.NewMsgIssueToken(creator, "foo"))
+	require.True(t, res.IsOK(), res.Log)
+	denom := string(res.Data)
+	require.Equal(t, token.GetTokenDenom(creator, "foo"), denom)
+
+	res = h(ctx, token.NewMsgMintTokens(creator, creator, sdk.NewInt64Coin(denom, 100)))
+	require.True(t, res.IsOK(), res.Log)
+
+	res = h(ctx, token.NewMsgBurnTokens(creator, sdk.NewInt64Coin(denom, 30)))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(70), app.BankKeeper.GetCoins(ctx, creator).AmountOf(denom))
+
+	res = h(ctx, token.NewMsgTransferTokenAdmin(creator, denom, newAdmin))
+	require.True(t, res.IsOK(), res.Log)
+
+	res = h(ctx, token.NewMsgMintTokens(creator, creator, sdk.NewInt64Coin(denom, 100)))
+	require.False(t, res.IsOK())
+}
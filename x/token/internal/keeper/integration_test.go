@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+var (
+	creatorAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherAddr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	initCoins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+)
+
+// returns context and an app with updated token keeper and two funded
+// accounts
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+	app.TokenKeeper.SetParams(ctx, types.DefaultParams())
+
+	for _, addr := range []sdk.AccAddress{creatorAddr, otherAddr} {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		if err := acc.SetCoins(initCoins); err != nil {
+			panic(err)
+		}
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	return app, ctx
+}
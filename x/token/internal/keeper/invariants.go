@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+// RegisterInvariants registers all token invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "issued-tokens", IssuedTokensInvariant(k))
+}
+
+// AllInvariants runs all invariants of the token module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return IssuedTokensInvariant(k)(ctx)
+	}
+}
+
+// IssuedTokensInvariant checks that every denom of the total supply namespaced
+// by the token module has been issued
+func IssuedTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, coin := range k.supplyKeeper.GetSupply(ctx).GetTotal() {
+			if !types.IsTokenDenom(coin.Denom) {
+				continue
+			}
+			if _, found := k.GetToken(ctx, coin.Denom); !found {
+				broken = true
+				msg += fmt.Sprintf("\t%s has a supply of %s but has not been issued\n", coin.Denom, coin.Amount)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "issued tokens", msg), broken
+	}
+}
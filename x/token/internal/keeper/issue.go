@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+// IssueToken issues a new token under the namespace of its creator, who
+// becomes its admin. The issue fee is sent to the fee collector.
+func (k Keeper) IssueToken(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, sdk.Error) {
+	if err := types.ValidateSubdenom(subdenom); err != nil {
+		return "", types.ErrInvalidDenom(k.codespace, err.Error())
+	}
+
+	denom := types.GetTokenDenom(creator, subdenom)
+	if _, found := k.GetToken(ctx, denom); found {
+		return "", types.ErrTokenExists(k.codespace, denom)
+	}
+
+	fee := k.GetParams(ctx).IssueFee
+	if !fee.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, creator, k.feeCollectorName, fee); err != nil {
+			return "", err
+		}
+	}
+
+	k.SetToken(ctx, types.NewToken(denom, creator))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIssueToken,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+		),
+	)
+
+	return denom, nil
+}
+
+// MintTokens mints tokens of an issued denom to the recipient. Only the admin
+// of the token can mint it, and not to a blacklisted address such as a module
+// account.
+func (k Keeper) MintTokens(ctx sdk.Context, admin, recipient sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	if k.blacklistedAddrs[recipient.String()] {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is blacklisted from receiving external funds", recipient))
+	}
+
+	if _, err := k.getAdministeredToken(ctx, amount.Denom, admin); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintTokens,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// BurnTokens burns tokens of an issued denom held by its admin. Only the admin
+// of the token can burn it.
+func (k Keeper) BurnTokens(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	if _, err := k.getAdministeredToken(ctx, amount.Denom, admin); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, admin, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnTokens,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// TransferTokenAdmin transfers the admin rights of an issued token to a new
+// admin.
+func (k Keeper) TransferTokenAdmin(ctx sdk.Context, admin sdk.AccAddress, denom string, newAdmin sdk.AccAddress) sdk.Error {
+	token, err := k.getAdministeredToken(ctx, denom, admin)
+	if err != nil {
+		return err
+	}
+
+	token.Admin = newAdmin
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferTokenAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdmin.String()),
+		),
+	)
+
+	return nil
+}
+
+// getAdministeredToken returns the token of a denom if it is administered by
+// the given address
+func (k Keeper) getAdministeredToken(ctx sdk.Context, denom string, admin sdk.AccAddress) (types.Token, sdk.Error) {
+	token, found := k.GetToken(ctx, denom)
+	if !found {
+		return types.Token{}, types.ErrUnknownToken(k.codespace, denom)
+	}
+	if !token.Admin.Equals(admin) {
+		return types.Token{}, types.ErrNotTokenAdmin(k.codespace, denom, admin)
+	}
+	return token, nil
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+// Keeper of the token store
+type Keeper struct {
+	cdc              *codec.Codec
+	storeKey         sdk.StoreKey
+	paramSpace       params.Subspace
+	supplyKeeper     types.SupplyKeeper
+	codespace        sdk.CodespaceType
+	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// list of addresses that are restricted from receiving minted tokens
+	blacklistedAddrs map[string]bool
+}
+
+// NewKeeper creates a new token Keeper instance
+func NewKeeper(
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	supplyKeeper types.SupplyKeeper, codespace sdk.CodespaceType, feeCollectorName string,
+	blacklistedAddrs map[string]bool,
+) Keeper {
+
+	// ensure token module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper:     supplyKeeper,
+		codespace:        codespace,
+		feeCollectorName: feeCollectorName,
+		blacklistedAddrs: blacklistedAddrs,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the keeper's codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetToken returns the token of a denom
+func (k Keeper) GetToken(ctx sdk.Context, denom string) (token types.Token, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenKey(denom))
+	if bz == nil {
+		return token, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &token)
+	return token, true
+}
+
+// SetToken sets a token
+func (k Keeper) SetToken(ctx sdk.Context, token types.Token) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(token)
+	store.Set(types.GetTokenKey(token.Denom), bz)
+}
+
+// IterateTokens iterates over the issued tokens and performs a callback
+// function
+func (k Keeper) IterateTokens(ctx sdk.Context, cb func(token types.Token) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.Token
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &token)
+
+		if cb(token) {
+			break
+		}
+	}
+}
+
+// GetAllTokens returns all the issued tokens
+func (k Keeper) GetAllTokens(ctx sdk.Context) (tokens types.Tokens) {
+	k.IterateTokens(ctx, func(token types.Token) bool {
+		tokens = append(tokens, token)
+		return false
+	})
+	return tokens
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	keep "github.com/cosmos/cosmos-sdk/x/token/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+func TestIssueToken(t *testing.T) {
+	app, ctx := createTestApp(false)
+	fee := types.DefaultParams().IssueFee
+
+	denom, err := app.TokenKeeper.IssueToken(ctx, creatorAddr, "foo")
+	require.NoError(t, err)
+	require.Equal(t, types.GetTokenDenom(creatorAddr, "foo"), denom)
+
+	token, found := app.TokenKeeper.GetToken(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, types.NewToken(denom, creatorAddr), token)
+
+	// the issue fee is paid to the fee collector
+	require.Equal(t, initCoins.Sub(fee), app.BankKeeper.GetCoins(ctx, creatorAddr))
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	require.Equal(t, fee, feeCollector.GetCoins())
+
+	// the same subdenom cannot be issued twice by the same creator
+	_, err = app.TokenKeeper.IssueToken(ctx, creatorAddr, "foo")
+	require.Error(t, err)
+	require.Equal(t, types.CodeTokenExists, err.Code())
+
+	// but denoms are namespaced by creator
+	otherDenom, err := app.TokenKeeper.IssueToken(ctx, otherAddr, "foo")
+	require.NoError(t, err)
+	require.NotEqual(t, denom, otherDenom)
+	require.Len(t, app.TokenKeeper.GetAllTokens(ctx), 2)
+
+	// the issue fee must be paid
+	app.TokenKeeper.SetParams(ctx, types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000000))))
+	_, err = app.TokenKeeper.IssueToken(ctx, creatorAddr, "bar")
+	require.Error(t, err)
+	_, found = app.TokenKeeper.GetToken(ctx, types.GetTokenDenom(creatorAddr, "bar"))
+	require.False(t, found)
+}
+
+func TestMintBurnTokens(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	denom, err := app.TokenKeeper.IssueToken(ctx, creatorAddr, "foo")
+	require.NoError(t, err)
+
+	// only the admin can mint
+	amount := sdk.NewInt64Coin(denom, 100)
+	err = app.TokenKeeper.MintTokens(ctx, otherAddr, otherAddr, amount)
+	require.Error(t, err)
+	require.Equal(t, types.CodeNotTokenAdmin, err.Code())
+
+	err = app.TokenKeeper.MintTokens(ctx, creatorAddr, otherAddr, sdk.NewInt64Coin(types.GetTokenDenom(otherAddr, "foo"), 100))
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnknownToken, err.Code())
+
+	// tokens cannot be minted to a module account
+	distrAddr := app.SupplyKeeper.GetModuleAddress(distr.ModuleName)
+	err = app.TokenKeeper.MintTokens(ctx, creatorAddr, distrAddr, amount)
+	require.Error(t, err)
+	require.Equal(t, sdk.CodeUnauthorized, err.Code())
+
+	require.NoError(t, app.TokenKeeper.MintTokens(ctx, creatorAddr, otherAddr, amount))
+	require.NoError(t, app.TokenKeeper.MintTokens(ctx, creatorAddr, creatorAddr, amount))
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetCoins(ctx, otherAddr).AmountOf(denom))
+	require.Equal(t, sdk.NewInt(200), app.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom))
+
+	// only the admin can burn, from its own account
+	err = app.TokenKeeper.BurnTokens(ctx, otherAddr, sdk.NewInt64Coin(denom, 50))
+	require.Error(t, err)
+	require.Equal(t, types.CodeNotTokenAdmin, err.Code())
+
+	require.Error(t, app.TokenKeeper.BurnTokens(ctx, creatorAddr, sdk.NewInt64Coin(denom, 150)))
+	require.NoError(t, app.TokenKeeper.BurnTokens(ctx, creatorAddr, sdk.NewInt64Coin(denom, 40)))
+	require.Equal(t, sdk.NewInt(60), app.BankKeeper.GetCoins(ctx, creatorAddr).AmountOf(denom))
+	require.Equal(t, sdk.NewInt(160), app.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom))
+
+	_, broken := keep.AllInvariants(app.TokenKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestTransferTokenAdmin(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	denom, err := app.TokenKeeper.IssueToken(ctx, creatorAddr, "foo")
+	require.NoError(t, err)
+
+	err = app.TokenKeeper.TransferTokenAdmin(ctx, otherAddr, denom, otherAddr)
+	require.Error(t, err)
+	require.Equal(t, types.CodeNotTokenAdmin, err.Code())
+
+	require.NoError(t, app.TokenKeeper.TransferTokenAdmin(ctx, creatorAddr, denom, otherAddr))
+	token, found := app.TokenKeeper.GetToken(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, otherAddr, token.Admin)
+
+	// the previous admin lost its rights
+	amount := sdk.NewInt64Coin(denom, 100)
+	require.Error(t, app.TokenKeeper.MintTokens(ctx, creatorAddr, creatorAddr, amount))
+	require.NoError(t, app.TokenKeeper.MintTokens(ctx, otherAddr, creatorAddr, amount))
+}
+
+func TestIssuedTokensInvariant(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	_, broken := keep.IssuedTokensInvariant(app.TokenKeeper)(ctx)
+	require.False(t, broken)
+
+	// coins of a token namespaced denom minted outside of the token module
+	unissued := sdk.NewCoins(sdk.NewInt64Coin(types.GetTokenDenom(creatorAddr, "foo"), 100))
+	require.NoError(t, app.SupplyKeeper.MintCoins(ctx, types.ModuleName, unissued))
+
+	_, broken = keep.IssuedTokensInvariant(app.TokenKeeper)(ctx)
+	require.True(t, broken)
+}
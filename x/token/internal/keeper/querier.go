@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+// NewQuerier returns a token Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+
+		case types.QueryToken:
+			return queryToken(ctx, req, k)
+
+		case types.QueryTokens:
+			return queryTokens(ctx, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown token query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryToken(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	token, found := k.GetToken(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrUnknownToken(k.codespace, params.Denom)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, token)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryTokens(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	tokens := k.GetAllTokens(ctx)
+	if tokens == nil {
+		tokens = types.Tokens{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, tokens)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keep "github.com/cosmos/cosmos-sdk/x/token/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+func TestQueryTokens(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keep.NewQuerier(app.TokenKeeper)
+
+	denom, err := app.TokenKeeper.IssueToken(ctx, creatorAddr, "foo")
+	require.NoError(t, err)
+
+	res, sdkErr := querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
+	require.NoError(t, sdkErr)
+	var params types.Params
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &params))
+	require.Equal(t, app.TokenKeeper.GetParams(ctx), params)
+
+	res, sdkErr = querier(ctx, []string{types.QueryTokens}, abci.RequestQuery{})
+	require.NoError(t, sdkErr)
+	var tokens types.Tokens
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &tokens))
+	require.Equal(t, types.Tokens{types.NewToken(denom, creatorAddr)}, tokens)
+
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryToken),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryTokenParams(denom)),
+	}
+	res, sdkErr = querier(ctx, []string{types.QueryToken}, query)
+	require.NoError(t, sdkErr)
+	var token types.Token
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &token))
+	require.Equal(t, types.NewToken(denom, creatorAddr), token)
+
+	query.Data = app.Codec().MustMarshalJSON(types.NewQueryTokenParams(types.GetTokenDenom(otherAddr, "foo")))
+	_, sdkErr = querier(ctx, []string{types.QueryToken}, query)
+	require.Error(t, sdkErr)
+
+	_, sdkErr = querier(ctx, []string{"other"}, abci.RequestQuery{})
+	require.Error(t, sdkErr)
+}
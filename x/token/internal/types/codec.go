@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the token module's concrete types on the codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgIssueToken{}, "cosmos-sdk/MsgIssueToken", nil)
+	cdc.RegisterConcrete(MsgMintTokens{}, "cosmos-sdk/MsgMintTokens", nil)
+	cdc.RegisterConcrete(MsgBurnTokens{}, "cosmos-sdk/MsgBurnTokens", nil)
+	cdc.RegisterConcrete(MsgTransferTokenAdmin{}, "cosmos-sdk/MsgTransferTokenAdmin", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
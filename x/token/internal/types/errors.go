@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace is the default codespace for the token module
+const DefaultCodespace sdk.CodespaceType = ModuleName
+
+// Token module error codes
+const (
+	CodeInvalidDenom  sdk.CodeType = 101
+	CodeTokenExists   sdk.CodeType = 102
+	CodeUnknownToken  sdk.CodeType = 103
+	CodeNotTokenAdmin sdk.CodeType = 104
+)
+
+// ErrInvalidDenom is an error
+func ErrInvalidDenom(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDenom, msg)
+}
+
+// ErrTokenExists is an error
+func ErrTokenExists(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenExists, fmt.Sprintf("token %s has already been issued", denom))
+}
+
+// ErrUnknownToken is an error
+func ErrUnknownToken(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownToken, fmt.Sprintf("token %s has not been issued", denom))
+}
+
+// ErrNotTokenAdmin is an error
+func ErrNotTokenAdmin(codespace sdk.CodespaceType, denom string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotTokenAdmin, fmt.Sprintf("%s is not the admin of token %s", addr, denom))
+}
@@ -0,0 +1,15 @@
+package types
+
+// Token module event types
+var (
+	EventTypeIssueToken         = "issue_token"
+	EventTypeMintTokens         = "mint_tokens"
+	EventTypeBurnTokens         = "burn_tokens"
+	EventTypeTransferTokenAdmin = "transfer_token_admin"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyNewAdmin  = "new_admin"
+
+	AttributeValueCategory = ModuleName
+)
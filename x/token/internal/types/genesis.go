@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState - token genesis state
+type GenesisState struct {
+	Params Params `json:"params" yaml:"params"`
+	Tokens Tokens `json:"tokens" yaml:"tokens"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, tokens Tokens) GenesisState {
+	return GenesisState{
+		Params: params,
+		Tokens: tokens,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), Tokens{})
+}
+
+// ValidateGenesis validates the token genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, token := range data.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		if seen[token.Denom] {
+			return fmt.Errorf("duplicate token %s", token.Denom)
+		}
+		seen[token.Denom] = true
+	}
+	return nil
+}
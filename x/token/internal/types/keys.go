@@ -0,0 +1,31 @@
+package types
+
+const (
+	// ModuleName is the name of the token module
+	ModuleName = "token"
+
+	// StoreKey is the default store key for token
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for token
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for token
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default paramspace for the token module
+	DefaultParamspace = ModuleName
+)
+
+// Keys for token store
+// Items are stored with the following key: values
+//
+// - 0x00<denom_Bytes>: Token
+var (
+	TokenKeyPrefix = []byte{0x00}
+)
+
+// GetTokenKey gets the key for the token of a denom
+func GetTokenKey(denom string) []byte {
+	return append(TokenKeyPrefix, []byte(denom)...)
+}
@@ -0,0 +1,196 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgIssueToken{}
+	_ sdk.Msg = MsgMintTokens{}
+	_ sdk.Msg = MsgBurnTokens{}
+	_ sdk.Msg = MsgTransferTokenAdmin{}
+)
+
+// MsgIssueToken issues a new token under the namespace of its creator, who
+// becomes the admin of the token
+type MsgIssueToken struct {
+	Creator  sdk.AccAddress `json:"creator" yaml:"creator"`
+	Subdenom string         `json:"subdenom" yaml:"subdenom"`
+}
+
+// NewMsgIssueToken creates a new MsgIssueToken instance
+func NewMsgIssueToken(creator sdk.AccAddress, subdenom string) MsgIssueToken {
+	return MsgIssueToken{
+		Creator:  creator,
+		Subdenom: subdenom,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgIssueToken) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgIssueToken) Type() string { return "issue_token" }
+
+// GetSigners implements sdk.Msg
+func (msg MsgIssueToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgIssueToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgIssueToken) ValidateBasic() sdk.Error {
+	if msg.Creator.Empty() {
+		return sdk.ErrInvalidAddress("missing creator address")
+	}
+	if err := ValidateSubdenom(msg.Subdenom); err != nil {
+		return ErrInvalidDenom(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// MsgMintTokens mints tokens of an issued denom to a recipient
+type MsgMintTokens struct {
+	Admin     sdk.AccAddress `json:"admin" yaml:"admin"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgMintTokens creates a new MsgMintTokens instance
+func NewMsgMintTokens(admin, recipient sdk.AccAddress, amount sdk.Coin) MsgMintTokens {
+	return MsgMintTokens{
+		Admin:     admin,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgMintTokens) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgMintTokens) Type() string { return "mint_tokens" }
+
+// GetSigners implements sdk.Msg
+func (msg MsgMintTokens) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgMintTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgMintTokens) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	return validateTokenAmount(msg.Amount)
+}
+
+// MsgBurnTokens burns tokens of an issued denom held by its admin
+type MsgBurnTokens struct {
+	Admin  sdk.AccAddress `json:"admin" yaml:"admin"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgBurnTokens creates a new MsgBurnTokens instance
+func NewMsgBurnTokens(admin sdk.AccAddress, amount sdk.Coin) MsgBurnTokens {
+	return MsgBurnTokens{
+		Admin:  admin,
+		Amount: amount,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgBurnTokens) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgBurnTokens) Type() string { return "burn_tokens" }
+
+// GetSigners implements sdk.Msg
+func (msg MsgBurnTokens) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgBurnTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgBurnTokens) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	return validateTokenAmount(msg.Amount)
+}
+
+// MsgTransferTokenAdmin transfers the admin rights of an issued token
+type MsgTransferTokenAdmin struct {
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+}
+
+// NewMsgTransferTokenAdmin creates a new MsgTransferTokenAdmin instance
+func NewMsgTransferTokenAdmin(admin sdk.AccAddress, denom string, newAdmin sdk.AccAddress) MsgTransferTokenAdmin {
+	return MsgTransferTokenAdmin{
+		Admin:    admin,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgTransferTokenAdmin) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgTransferTokenAdmin) Type() string { return "transfer_token_admin" }
+
+// GetSigners implements sdk.Msg
+func (msg MsgTransferTokenAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgTransferTokenAdmin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTransferTokenAdmin) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	if msg.NewAdmin.Empty() {
+		return sdk.ErrInvalidAddress("missing new admin address")
+	}
+	if _, _, err := ParseTokenDenom(msg.Denom); err != nil {
+		return ErrInvalidDenom(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+func validateTokenAmount(amount sdk.Coin) sdk.Error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return sdk.ErrInvalidCoins(amount.String())
+	}
+	if _, _, err := ParseTokenDenom(amount.Denom); err != nil {
+		return ErrInvalidDenom(DefaultCodespace, err.Error())
+	}
+	return nil
+}
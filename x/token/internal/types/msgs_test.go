@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgIssueTokenValidateBasic(t *testing.T) {
+	require.NoError(t, NewMsgIssueToken(creatorAddr, "foo").ValidateBasic())
+	require.Error(t, NewMsgIssueToken(nil, "foo").ValidateBasic())
+	require.Error(t, NewMsgIssueToken(creatorAddr, "f").ValidateBasic())
+	require.Error(t, NewMsgIssueToken(creatorAddr, "foo/bar").ValidateBasic())
+}
+
+func TestMsgMintTokensValidateBasic(t *testing.T) {
+	denom := GetTokenDenom(creatorAddr, "foo")
+
+	require.NoError(t, NewMsgMintTokens(adminAddr, creatorAddr, sdk.NewInt64Coin(denom, 10)).ValidateBasic())
+	require.Error(t, NewMsgMintTokens(nil, creatorAddr, sdk.NewInt64Coin(denom, 10)).ValidateBasic())
+	require.Error(t, NewMsgMintTokens(adminAddr, nil, sdk.NewInt64Coin(denom, 10)).ValidateBasic())
+	require.Error(t, NewMsgMintTokens(adminAddr, creatorAddr, sdk.NewInt64Coin(denom, 0)).ValidateBasic())
+	require.Error(t, NewMsgMintTokens(adminAddr, creatorAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)).ValidateBasic())
+}
+
+func TestMsgBurnTokensValidateBasic(t *testing.T) {
+	denom := GetTokenDenom(creatorAddr, "foo")
+
+	require.NoError(t, NewMsgBurnTokens(adminAddr, sdk.NewInt64Coin(denom, 10)).ValidateBasic())
+	require.Error(t, NewMsgBurnTokens(nil, sdk.NewInt64Coin(denom, 10)).ValidateBasic())
+	require.Error(t, NewMsgBurnTokens(adminAddr, sdk.NewInt64Coin(denom, 0)).ValidateBasic())
+	require.Error(t, NewMsgBurnTokens(adminAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)).ValidateBasic())
+}
+
+func TestMsgTransferTokenAdminValidateBasic(t *testing.T) {
+	denom := GetTokenDenom(creatorAddr, "foo")
+
+	require.NoError(t, NewMsgTransferTokenAdmin(adminAddr, denom, creatorAddr).ValidateBasic())
+	require.Error(t, NewMsgTransferTokenAdmin(nil, denom, creatorAddr).ValidateBasic())
+	require.Error(t, NewMsgTransferTokenAdmin(adminAddr, denom, nil).ValidateBasic())
+	require.Error(t, NewMsgTransferTokenAdmin(adminAddr, sdk.DefaultBondDenom, creatorAddr).ValidateBasic())
+}
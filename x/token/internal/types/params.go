@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter store keys
+var (
+	KeyIssueFee = []byte("IssueFee")
+)
+
+// Params defines the parameters for the token module
+type Params struct {
+	IssueFee sdk.Coins `json:"issue_fee" yaml:"issue_fee"` // fee paid to the fee collector to issue a token
+}
+
+// ParamKeyTable for token module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(issueFee sdk.Coins) Params {
+	return Params{
+		IssueFee: issueFee,
+	}
+}
+
+// DefaultParams returns the default token module parameters
+func DefaultParams() Params {
+	return Params{
+		IssueFee: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+	}
+}
+
+// ValidateParams validates the token module parameters
+func ValidateParams(params Params) error {
+	if !params.IssueFee.IsValid() {
+		return fmt.Errorf("token parameter IssueFee is invalid: %s", params.IssueFee)
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Token Params:
+  Issue Fee: %s
+`, p.IssueFee)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyIssueFee, &p.IssueFee, validateIssueFee),
+	}
+}
+
+func validateIssueFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return fmt.Errorf("token parameter IssueFee is invalid: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+// Query endpoints supported by the token querier
+const (
+	QueryParameters = "parameters"
+	QueryToken      = "token"
+	QueryTokens     = "tokens"
+)
+
+// QueryTokenParams defines the params for the following queries:
+// - 'custom/token/token'
+type QueryTokenParams struct {
+	Denom string
+}
+
+// NewQueryTokenParams creates a new instance of QueryTokenParams
+func NewQueryTokenParams(denom string) QueryTokenParams {
+	return QueryTokenParams{
+		Denom: denom,
+	}
+}
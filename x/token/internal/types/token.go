@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomSeparator separates the module name, creator and subdenom of the denom
+// of an issued token
+const DenomSeparator = "/"
+
+// subdenoms follow the original 3 ~ 16 characters rule of the coin denoms
+var reSubdenom = regexp.MustCompile(`^[a-z][a-z0-9]{2,15}$`)
+
+// Token is a fungible token issued by an account. The denom of the token is
+// namespaced by its creator, and its admin can mint and burn it.
+type Token struct {
+	Denom string         `json:"denom" yaml:"denom"`
+	Admin sdk.AccAddress `json:"admin" yaml:"admin"`
+}
+
+// NewToken creates a new Token instance
+func NewToken(denom string, admin sdk.AccAddress) Token {
+	return Token{
+		Denom: denom,
+		Admin: admin,
+	}
+}
+
+// String implements fmt.Stringer
+func (t Token) String() string {
+	return fmt.Sprintf(`Token:
+  Denom: %s
+  Admin: %s`, t.Denom, t.Admin)
+}
+
+// Validate performs a stateless validation of the token
+func (t Token) Validate() error {
+	if _, _, err := ParseTokenDenom(t.Denom); err != nil {
+		return err
+	}
+	if t.Admin.Empty() {
+		return fmt.Errorf("token %s has no admin", t.Denom)
+	}
+	return nil
+}
+
+// Tokens is a collection of Token
+type Tokens []Token
+
+// String implements fmt.Stringer
+func (ts Tokens) String() string {
+	if len(ts) == 0 {
+		return "[]"
+	}
+	out := ""
+	for _, t := range ts {
+		out += fmt.Sprintf("%s\n", t.String())
+	}
+	return strings.TrimSpace(out)
+}
+
+// ValidateSubdenom validates the subdenom of a token
+func ValidateSubdenom(subdenom string) error {
+	if !reSubdenom.MatchString(subdenom) {
+		return fmt.Errorf("invalid subdenom: %s", subdenom)
+	}
+	return nil
+}
+
+// GetTokenDenom returns the denom of the token issued by creator under the
+// given subdenom
+func GetTokenDenom(creator sdk.AccAddress, subdenom string) string {
+	return strings.Join([]string{ModuleName, creator.String(), subdenom}, DenomSeparator)
+}
+
+// IsTokenDenom returns whether the denom is namespaced by the token module
+func IsTokenDenom(denom string) bool {
+	return strings.HasPrefix(denom, ModuleName+DenomSeparator)
+}
+
+// ParseTokenDenom returns the creator and subdenom of the denom of an issued
+// token
+func ParseTokenDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	parts := strings.Split(denom, DenomSeparator)
+	if len(parts) != 3 || parts[0] != ModuleName {
+		return nil, "", fmt.Errorf("invalid token denom: %s", denom)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid token denom %s: %s", denom, err)
+	}
+	if err := ValidateSubdenom(parts[2]); err != nil {
+		return nil, "", err
+	}
+	return creator, parts[2], nil
+}
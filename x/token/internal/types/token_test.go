@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	creatorAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	adminAddr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestTokenDenom(t *testing.T) {
+	denom := GetTokenDenom(creatorAddr, "foo")
+	require.Equal(t, "token/"+creatorAddr.String()+"/foo", denom)
+	require.NoError(t, sdk.ValidateDenom(denom))
+	require.True(t, IsTokenDenom(denom))
+	require.False(t, IsTokenDenom(sdk.DefaultBondDenom))
+
+	creator, subdenom, err := ParseTokenDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creatorAddr, creator)
+	require.Equal(t, "foo", subdenom)
+
+	invalidDenoms := []string{
+		sdk.DefaultBondDenom,
+		"token/foo",
+		"token/" + creatorAddr.String() + "/f",
+		"token/" + creatorAddr.String() + "/foo/bar",
+		"other/" + creatorAddr.String() + "/foo",
+		"token/cosmos1invalid/foo",
+	}
+	for _, denom := range invalidDenoms {
+		_, _, err := ParseTokenDenom(denom)
+		require.Error(t, err, denom)
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	token := NewToken(GetTokenDenom(creatorAddr, "foo"), adminAddr)
+
+	tests := []struct {
+		name       string
+		genesis    GenesisState
+		expectPass bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid", NewGenesisState(DefaultParams(), Tokens{token}), true},
+		{"invalid fee", NewGenesisState(NewParams(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}), Tokens{}), false},
+		{"invalid denom", NewGenesisState(DefaultParams(), Tokens{NewToken("foo", adminAddr)}), false},
+		{"no admin", NewGenesisState(DefaultParams(), Tokens{NewToken(token.Denom, nil)}), false},
+		{"duplicate", NewGenesisState(DefaultParams(), Tokens{token, token}), false},
+	}
+
+	for _, tc := range tests {
+		err := ValidateGenesis(tc.genesis)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/token/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding token type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.TokenKeyPrefix):
+		var tokenA, tokenB types.Token
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &tokenA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &tokenB)
+		return fmt.Sprintf("%v\n%v", tokenA, tokenB)
+	default:
+		panic(fmt.Sprintf("invalid token key %X", kvA.Key))
+	}
+}
@@ -0,0 +1,154 @@
+package operations
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/token"
+)
+
+// SimulateMsgIssueToken generates a MsgIssueToken with random values.
+func SimulateMsgIssueToken(k token.Keeper) simulation.Operation {
+	handler := token.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		creatorAccount := simulation.RandomAcc(r, accs)
+		subdenom := strings.ToLower(simulation.RandStringOfLength(r, simulation.RandIntBetween(r, 3, 9)))
+		msg := token.NewMsgIssueToken(creatorAccount.Address, subdenom)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgMintTokens generates a MsgMintTokens with random values.
+func SimulateMsgMintTokens(k token.Keeper) simulation.Operation {
+	handler := token.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		tk, ok := randomToken(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		// mint to the admin half of the time so that there are tokens to burn
+		recipient := tk.Admin
+		if r.Intn(2) == 0 {
+			recipient = simulation.RandomAcc(r, accs).Address
+		}
+
+		amount := sdk.NewInt64Coin(tk.Denom, int64(simulation.RandIntBetween(r, 1, 1000000)))
+		msg := token.NewMsgMintTokens(tk.Admin, recipient, amount)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok = handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgBurnTokens generates a MsgBurnTokens with random values.
+func SimulateMsgBurnTokens(ak auth.AccountKeeper, k token.Keeper) simulation.Operation {
+	handler := token.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		tk, ok := randomToken(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		acc := ak.GetAccount(ctx, tk.Admin)
+		if acc == nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		balance := acc.GetCoins().AmountOf(tk.Denom)
+		if !balance.IsPositive() {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		amount, err := simulation.RandPositiveInt(r, balance)
+		if err != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, err
+		}
+
+		msg := token.NewMsgBurnTokens(tk.Admin, sdk.NewCoin(tk.Denom, amount))
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok = handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgTransferTokenAdmin generates a MsgTransferTokenAdmin with random values.
+func SimulateMsgTransferTokenAdmin(k token.Keeper) simulation.Operation {
+	handler := token.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		tk, ok := randomToken(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		newAdminAccount := simulation.RandomAcc(r, accs)
+		msg := token.NewMsgTransferTokenAdmin(tk.Admin, tk.Denom, newAdminAccount.Address)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok = handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// randomToken returns a random issued token
+func randomToken(r *rand.Rand, ctx sdk.Context, k token.Keeper) (token.Token, bool) {
+	tokens := k.GetAllTokens(ctx)
+	if len(tokens) == 0 {
+		return token.Token{}, false
+	}
+	return tokens[r.Intn(len(tokens))], true
+}